@@ -62,6 +62,12 @@ const (
 	// IngesterServiceMergeSpanProfileProcedure is the fully-qualified name of the IngesterService's
 	// MergeSpanProfile RPC.
 	IngesterServiceMergeSpanProfileProcedure = "/ingester.v1.IngesterService/MergeSpanProfile"
+	// IngesterServiceMergeProfilesTopFunctionsProcedure is the fully-qualified name of the
+	// IngesterService's MergeProfilesTopFunctions RPC.
+	IngesterServiceMergeProfilesTopFunctionsProcedure = "/ingester.v1.IngesterService/MergeProfilesTopFunctions"
+	// IngesterServiceSelectProfileTreesProcedure is the fully-qualified name of the IngesterService's
+	// SelectProfileTrees RPC.
+	IngesterServiceSelectProfileTreesProcedure = "/ingester.v1.IngesterService/SelectProfileTrees"
 	// IngesterServiceBlockMetadataProcedure is the fully-qualified name of the IngesterService's
 	// BlockMetadata RPC.
 	IngesterServiceBlockMetadataProcedure = "/ingester.v1.IngesterService/BlockMetadata"
@@ -87,6 +93,8 @@ type IngesterServiceClient interface {
 	MergeProfilesLabels(context.Context) *connect.BidiStreamForClient[v12.MergeProfilesLabelsRequest, v12.MergeProfilesLabelsResponse]
 	MergeProfilesPprof(context.Context) *connect.BidiStreamForClient[v12.MergeProfilesPprofRequest, v12.MergeProfilesPprofResponse]
 	MergeSpanProfile(context.Context) *connect.BidiStreamForClient[v12.MergeSpanProfileRequest, v12.MergeSpanProfileResponse]
+	MergeProfilesTopFunctions(context.Context) *connect.BidiStreamForClient[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse]
+	SelectProfileTrees(context.Context) *connect.BidiStreamForClient[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse]
 	BlockMetadata(context.Context, *connect.Request[v12.BlockMetadataRequest]) (*connect.Response[v12.BlockMetadataResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
 	GetProfileStats(context.Context, *connect.Request[v11.GetProfileStatsRequest]) (*connect.Response[v11.GetProfileStatsResponse], error)
@@ -164,6 +172,18 @@ func NewIngesterServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(ingesterServiceMethods.ByName("MergeSpanProfile")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeProfilesTopFunctions: connect.NewClient[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse](
+			httpClient,
+			baseURL+IngesterServiceMergeProfilesTopFunctionsProcedure,
+			connect.WithSchema(ingesterServiceMethods.ByName("MergeProfilesTopFunctions")),
+			connect.WithClientOptions(opts...),
+		),
+		selectProfileTrees: connect.NewClient[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse](
+			httpClient,
+			baseURL+IngesterServiceSelectProfileTreesProcedure,
+			connect.WithSchema(ingesterServiceMethods.ByName("SelectProfileTrees")),
+			connect.WithClientOptions(opts...),
+		),
 		blockMetadata: connect.NewClient[v12.BlockMetadataRequest, v12.BlockMetadataResponse](
 			httpClient,
 			baseURL+IngesterServiceBlockMetadataProcedure,
@@ -187,19 +207,21 @@ func NewIngesterServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 
 // ingesterServiceClient implements IngesterServiceClient.
 type ingesterServiceClient struct {
-	push                     *connect.Client[v1.PushRequest, v1.PushResponse]
-	labelValues              *connect.Client[v11.LabelValuesRequest, v11.LabelValuesResponse]
-	labelNames               *connect.Client[v11.LabelNamesRequest, v11.LabelNamesResponse]
-	profileTypes             *connect.Client[v12.ProfileTypesRequest, v12.ProfileTypesResponse]
-	series                   *connect.Client[v12.SeriesRequest, v12.SeriesResponse]
-	flush                    *connect.Client[v12.FlushRequest, v12.FlushResponse]
-	mergeProfilesStacktraces *connect.Client[v12.MergeProfilesStacktracesRequest, v12.MergeProfilesStacktracesResponse]
-	mergeProfilesLabels      *connect.Client[v12.MergeProfilesLabelsRequest, v12.MergeProfilesLabelsResponse]
-	mergeProfilesPprof       *connect.Client[v12.MergeProfilesPprofRequest, v12.MergeProfilesPprofResponse]
-	mergeSpanProfile         *connect.Client[v12.MergeSpanProfileRequest, v12.MergeSpanProfileResponse]
-	blockMetadata            *connect.Client[v12.BlockMetadataRequest, v12.BlockMetadataResponse]
-	getProfileStats          *connect.Client[v11.GetProfileStatsRequest, v11.GetProfileStatsResponse]
-	getBlockStats            *connect.Client[v12.GetBlockStatsRequest, v12.GetBlockStatsResponse]
+	push                      *connect.Client[v1.PushRequest, v1.PushResponse]
+	labelValues               *connect.Client[v11.LabelValuesRequest, v11.LabelValuesResponse]
+	labelNames                *connect.Client[v11.LabelNamesRequest, v11.LabelNamesResponse]
+	profileTypes              *connect.Client[v12.ProfileTypesRequest, v12.ProfileTypesResponse]
+	series                    *connect.Client[v12.SeriesRequest, v12.SeriesResponse]
+	flush                     *connect.Client[v12.FlushRequest, v12.FlushResponse]
+	mergeProfilesStacktraces  *connect.Client[v12.MergeProfilesStacktracesRequest, v12.MergeProfilesStacktracesResponse]
+	mergeProfilesLabels       *connect.Client[v12.MergeProfilesLabelsRequest, v12.MergeProfilesLabelsResponse]
+	mergeProfilesPprof        *connect.Client[v12.MergeProfilesPprofRequest, v12.MergeProfilesPprofResponse]
+	mergeSpanProfile          *connect.Client[v12.MergeSpanProfileRequest, v12.MergeSpanProfileResponse]
+	mergeProfilesTopFunctions *connect.Client[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse]
+	selectProfileTrees        *connect.Client[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse]
+	blockMetadata             *connect.Client[v12.BlockMetadataRequest, v12.BlockMetadataResponse]
+	getProfileStats           *connect.Client[v11.GetProfileStatsRequest, v11.GetProfileStatsResponse]
+	getBlockStats             *connect.Client[v12.GetBlockStatsRequest, v12.GetBlockStatsResponse]
 }
 
 // Push calls ingester.v1.IngesterService.Push.
@@ -252,6 +274,16 @@ func (c *ingesterServiceClient) MergeSpanProfile(ctx context.Context) *connect.B
 	return c.mergeSpanProfile.CallBidiStream(ctx)
 }
 
+// MergeProfilesTopFunctions calls ingester.v1.IngesterService.MergeProfilesTopFunctions.
+func (c *ingesterServiceClient) MergeProfilesTopFunctions(ctx context.Context) *connect.BidiStreamForClient[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse] {
+	return c.mergeProfilesTopFunctions.CallBidiStream(ctx)
+}
+
+// SelectProfileTrees calls ingester.v1.IngesterService.SelectProfileTrees.
+func (c *ingesterServiceClient) SelectProfileTrees(ctx context.Context) *connect.BidiStreamForClient[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse] {
+	return c.selectProfileTrees.CallBidiStream(ctx)
+}
+
 // BlockMetadata calls ingester.v1.IngesterService.BlockMetadata.
 func (c *ingesterServiceClient) BlockMetadata(ctx context.Context, req *connect.Request[v12.BlockMetadataRequest]) (*connect.Response[v12.BlockMetadataResponse], error) {
 	return c.blockMetadata.CallUnary(ctx, req)
@@ -281,6 +313,8 @@ type IngesterServiceHandler interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[v12.MergeProfilesLabelsRequest, v12.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[v12.MergeProfilesPprofRequest, v12.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[v12.MergeSpanProfileRequest, v12.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse]) error
+	SelectProfileTrees(context.Context, *connect.BidiStream[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse]) error
 	BlockMetadata(context.Context, *connect.Request[v12.BlockMetadataRequest]) (*connect.Response[v12.BlockMetadataResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
 	GetProfileStats(context.Context, *connect.Request[v11.GetProfileStatsRequest]) (*connect.Response[v11.GetProfileStatsResponse], error)
@@ -354,6 +388,18 @@ func NewIngesterServiceHandler(svc IngesterServiceHandler, opts ...connect.Handl
 		connect.WithSchema(ingesterServiceMethods.ByName("MergeSpanProfile")),
 		connect.WithHandlerOptions(opts...),
 	)
+	ingesterServiceMergeProfilesTopFunctionsHandler := connect.NewBidiStreamHandler(
+		IngesterServiceMergeProfilesTopFunctionsProcedure,
+		svc.MergeProfilesTopFunctions,
+		connect.WithSchema(ingesterServiceMethods.ByName("MergeProfilesTopFunctions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ingesterServiceSelectProfileTreesHandler := connect.NewBidiStreamHandler(
+		IngesterServiceSelectProfileTreesProcedure,
+		svc.SelectProfileTrees,
+		connect.WithSchema(ingesterServiceMethods.ByName("SelectProfileTrees")),
+		connect.WithHandlerOptions(opts...),
+	)
 	ingesterServiceBlockMetadataHandler := connect.NewUnaryHandler(
 		IngesterServiceBlockMetadataProcedure,
 		svc.BlockMetadata,
@@ -394,6 +440,10 @@ func NewIngesterServiceHandler(svc IngesterServiceHandler, opts ...connect.Handl
 			ingesterServiceMergeProfilesPprofHandler.ServeHTTP(w, r)
 		case IngesterServiceMergeSpanProfileProcedure:
 			ingesterServiceMergeSpanProfileHandler.ServeHTTP(w, r)
+		case IngesterServiceMergeProfilesTopFunctionsProcedure:
+			ingesterServiceMergeProfilesTopFunctionsHandler.ServeHTTP(w, r)
+		case IngesterServiceSelectProfileTreesProcedure:
+			ingesterServiceSelectProfileTreesHandler.ServeHTTP(w, r)
 		case IngesterServiceBlockMetadataProcedure:
 			ingesterServiceBlockMetadataHandler.ServeHTTP(w, r)
 		case IngesterServiceGetProfileStatsProcedure:
@@ -449,6 +499,14 @@ func (UnimplementedIngesterServiceHandler) MergeSpanProfile(context.Context, *co
 	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.MergeSpanProfile is not implemented"))
 }
 
+func (UnimplementedIngesterServiceHandler) MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v12.MergeProfilesTopFunctionsRequest, v12.MergeProfilesTopFunctionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.MergeProfilesTopFunctions is not implemented"))
+}
+
+func (UnimplementedIngesterServiceHandler) SelectProfileTrees(context.Context, *connect.BidiStream[v12.SelectProfileTreesRequest, v12.SelectProfileTreesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.SelectProfileTrees is not implemented"))
+}
+
 func (UnimplementedIngesterServiceHandler) BlockMetadata(context.Context, *connect.Request[v12.BlockMetadataRequest]) (*connect.Response[v12.BlockMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.BlockMetadata is not implemented"))
 }
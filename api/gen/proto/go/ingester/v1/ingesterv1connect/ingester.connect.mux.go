@@ -69,6 +69,16 @@ func RegisterIngesterServiceHandler(mux *mux.Router, svc IngesterServiceHandler,
 		svc.MergeSpanProfile,
 		opts...,
 	))
+	mux.Handle("/ingester.v1.IngesterService/MergeProfilesTopFunctions", connect.NewBidiStreamHandler(
+		"/ingester.v1.IngesterService/MergeProfilesTopFunctions",
+		svc.MergeProfilesTopFunctions,
+		opts...,
+	))
+	mux.Handle("/ingester.v1.IngesterService/SelectProfileTrees", connect.NewBidiStreamHandler(
+		"/ingester.v1.IngesterService/SelectProfileTrees",
+		svc.SelectProfileTrees,
+		opts...,
+	))
 	mux.Handle("/ingester.v1.IngesterService/BlockMetadata", connect.NewUnaryHandler(
 		"/ingester.v1.IngesterService/BlockMetadata",
 		svc.BlockMetadata,
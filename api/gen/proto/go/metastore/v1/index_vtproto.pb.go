@@ -85,6 +85,13 @@ func (m *GetBlockMetadataResponse) CloneVT() *GetBlockMetadataResponse {
 		}
 		r.Blocks = tmpContainer
 	}
+	if rhs := m.SeriesDeletions; rhs != nil {
+		tmpContainer := make([]*SeriesDeletion, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.SeriesDeletions = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -173,6 +180,23 @@ func (this *GetBlockMetadataResponse) EqualVT(that *GetBlockMetadataResponse) bo
 			}
 		}
 	}
+	if len(this.SeriesDeletions) != len(that.SeriesDeletions) {
+		return false
+	}
+	for i, vx := range this.SeriesDeletions {
+		vy := that.SeriesDeletions[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &SeriesDeletion{}
+			}
+			if q == nil {
+				q = &SeriesDeletion{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -460,6 +484,18 @@ func (m *GetBlockMetadataResponse) MarshalToSizedBufferVT(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.SeriesDeletions) > 0 {
+		for iNdEx := len(m.SeriesDeletions) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.SeriesDeletions[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Blocks) > 0 {
 		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
 			size, err := m.Blocks[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
@@ -525,6 +561,12 @@ func (m *GetBlockMetadataResponse) SizeVT() (n int) {
 			n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 		}
 	}
+	if len(m.SeriesDeletions) > 0 {
+		for _, e := range m.SeriesDeletions {
+			l = e.SizeVT()
+			n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -817,6 +859,40 @@ func (m *GetBlockMetadataResponse) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesDeletions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesDeletions = append(m.SeriesDeletions, &SeriesDeletion{})
+			if err := m.SeriesDeletions[len(m.SeriesDeletions)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -38,12 +38,16 @@ const (
 	// TenantServiceDeleteTenantProcedure is the fully-qualified name of the TenantService's
 	// DeleteTenant RPC.
 	TenantServiceDeleteTenantProcedure = "/metastore.v1.TenantService/DeleteTenant"
+	// TenantServiceDeleteSeriesProcedure is the fully-qualified name of the TenantService's
+	// DeleteSeries RPC.
+	TenantServiceDeleteSeriesProcedure = "/metastore.v1.TenantService/DeleteSeries"
 )
 
 // TenantServiceClient is a client for the metastore.v1.TenantService service.
 type TenantServiceClient interface {
 	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
 	DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error)
+	DeleteSeries(context.Context, *connect.Request[v1.DeleteSeriesRequest]) (*connect.Response[v1.DeleteSeriesResponse], error)
 }
 
 // NewTenantServiceClient constructs a client for the metastore.v1.TenantService service. By
@@ -69,6 +73,12 @@ func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(tenantServiceMethods.ByName("DeleteTenant")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteSeries: connect.NewClient[v1.DeleteSeriesRequest, v1.DeleteSeriesResponse](
+			httpClient,
+			baseURL+TenantServiceDeleteSeriesProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("DeleteSeries")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -76,6 +86,7 @@ func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type tenantServiceClient struct {
 	getTenant    *connect.Client[v1.GetTenantRequest, v1.GetTenantResponse]
 	deleteTenant *connect.Client[v1.DeleteTenantRequest, v1.DeleteTenantResponse]
+	deleteSeries *connect.Client[v1.DeleteSeriesRequest, v1.DeleteSeriesResponse]
 }
 
 // GetTenant calls metastore.v1.TenantService.GetTenant.
@@ -88,10 +99,16 @@ func (c *tenantServiceClient) DeleteTenant(ctx context.Context, req *connect.Req
 	return c.deleteTenant.CallUnary(ctx, req)
 }
 
+// DeleteSeries calls metastore.v1.TenantService.DeleteSeries.
+func (c *tenantServiceClient) DeleteSeries(ctx context.Context, req *connect.Request[v1.DeleteSeriesRequest]) (*connect.Response[v1.DeleteSeriesResponse], error) {
+	return c.deleteSeries.CallUnary(ctx, req)
+}
+
 // TenantServiceHandler is an implementation of the metastore.v1.TenantService service.
 type TenantServiceHandler interface {
 	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
 	DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error)
+	DeleteSeries(context.Context, *connect.Request[v1.DeleteSeriesRequest]) (*connect.Response[v1.DeleteSeriesResponse], error)
 }
 
 // NewTenantServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -113,12 +130,20 @@ func NewTenantServiceHandler(svc TenantServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(tenantServiceMethods.ByName("DeleteTenant")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tenantServiceDeleteSeriesHandler := connect.NewUnaryHandler(
+		TenantServiceDeleteSeriesProcedure,
+		svc.DeleteSeries,
+		connect.WithSchema(tenantServiceMethods.ByName("DeleteSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/metastore.v1.TenantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TenantServiceGetTenantProcedure:
 			tenantServiceGetTenantHandler.ServeHTTP(w, r)
 		case TenantServiceDeleteTenantProcedure:
 			tenantServiceDeleteTenantHandler.ServeHTTP(w, r)
+		case TenantServiceDeleteSeriesProcedure:
+			tenantServiceDeleteSeriesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -135,3 +160,7 @@ func (UnimplementedTenantServiceHandler) GetTenant(context.Context, *connect.Req
 func (UnimplementedTenantServiceHandler) DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("metastore.v1.TenantService.DeleteTenant is not implemented"))
 }
+
+func (UnimplementedTenantServiceHandler) DeleteSeries(context.Context, *connect.Request[v1.DeleteSeriesRequest]) (*connect.Response[v1.DeleteSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("metastore.v1.TenantService.DeleteSeries is not implemented"))
+}
@@ -58,6 +58,21 @@ const (
 	// QuerierServiceSelectSeriesProcedure is the fully-qualified name of the QuerierService's
 	// SelectSeries RPC.
 	QuerierServiceSelectSeriesProcedure = "/querier.v1.QuerierService/SelectSeries"
+	// QuerierServiceSelectHeatmapProcedure is the fully-qualified name of the QuerierService's
+	// SelectHeatmap RPC.
+	QuerierServiceSelectHeatmapProcedure = "/querier.v1.QuerierService/SelectHeatmap"
+	// QuerierServiceGetProfileProcedure is the fully-qualified name of the QuerierService's GetProfile
+	// RPC.
+	QuerierServiceGetProfileProcedure = "/querier.v1.QuerierService/GetProfile"
+	// QuerierServiceSelectTopFunctionsProcedure is the fully-qualified name of the QuerierService's
+	// SelectTopFunctions RPC.
+	QuerierServiceSelectTopFunctionsProcedure = "/querier.v1.QuerierService/SelectTopFunctions"
+	// QuerierServiceSelectCallersCalleesProcedure is the fully-qualified name of the QuerierService's
+	// SelectCallersCallees RPC.
+	QuerierServiceSelectCallersCalleesProcedure = "/querier.v1.QuerierService/SelectCallersCallees"
+	// QuerierServiceSelectProfileTreesProcedure is the fully-qualified name of the QuerierService's
+	// SelectProfileTrees RPC.
+	QuerierServiceSelectProfileTreesProcedure = "/querier.v1.QuerierService/SelectProfileTrees"
 	// QuerierServiceDiffProcedure is the fully-qualified name of the QuerierService's Diff RPC.
 	QuerierServiceDiffProcedure = "/querier.v1.QuerierService/Diff"
 	// QuerierServiceGetProfileStatsProcedure is the fully-qualified name of the QuerierService's
@@ -86,6 +101,16 @@ type QuerierServiceClient interface {
 	SelectMergeProfile(context.Context, *connect.Request[v1.SelectMergeProfileRequest]) (*connect.Response[v12.Profile], error)
 	// SelectSeries returns a time series for the total sum of the requested profiles.
 	SelectSeries(context.Context, *connect.Request[v1.SelectSeriesRequest]) (*connect.Response[v1.SelectSeriesResponse], error)
+	// SelectHeatmap returns the distribution of the total values of the requested profiles over time.
+	SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error)
+	// GetProfile returns a single profile, as referenced by a series exemplar.
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v12.Profile], error)
+	// SelectTopFunctions returns the functions with the highest self or total values of the requested profiles.
+	SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error)
+	// SelectCallersCallees returns the merged callers and callees of a function across all the stack traces of the requested profiles.
+	SelectCallersCallees(context.Context, *connect.Request[v1.SelectCallersCalleesRequest]) (*connect.Response[v1.SelectCallersCalleesResponse], error)
+	// SelectProfileTrees returns the individual profiles of a single series as call trees, ordered by time.
+	SelectProfileTrees(context.Context, *connect.Request[v1.SelectProfileTreesRequest]) (*connect.Response[v1.SelectProfileTreesResponse], error)
 	// Diff returns a diff of two profiles
 	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
@@ -152,6 +177,36 @@ func NewQuerierServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(querierServiceMethods.ByName("SelectSeries")),
 			connect.WithClientOptions(opts...),
 		),
+		selectHeatmap: connect.NewClient[v1.SelectHeatmapRequest, v1.SelectHeatmapResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectHeatmapProcedure,
+			connect.WithSchema(querierServiceMethods.ByName("SelectHeatmap")),
+			connect.WithClientOptions(opts...),
+		),
+		getProfile: connect.NewClient[v1.GetProfileRequest, v12.Profile](
+			httpClient,
+			baseURL+QuerierServiceGetProfileProcedure,
+			connect.WithSchema(querierServiceMethods.ByName("GetProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		selectTopFunctions: connect.NewClient[v1.SelectTopFunctionsRequest, v1.SelectTopFunctionsResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectTopFunctionsProcedure,
+			connect.WithSchema(querierServiceMethods.ByName("SelectTopFunctions")),
+			connect.WithClientOptions(opts...),
+		),
+		selectCallersCallees: connect.NewClient[v1.SelectCallersCalleesRequest, v1.SelectCallersCalleesResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectCallersCalleesProcedure,
+			connect.WithSchema(querierServiceMethods.ByName("SelectCallersCallees")),
+			connect.WithClientOptions(opts...),
+		),
+		selectProfileTrees: connect.NewClient[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectProfileTreesProcedure,
+			connect.WithSchema(querierServiceMethods.ByName("SelectProfileTrees")),
+			connect.WithClientOptions(opts...),
+		),
 		diff: connect.NewClient[v1.DiffRequest, v1.DiffResponse](
 			httpClient,
 			baseURL+QuerierServiceDiffProcedure,
@@ -183,6 +238,11 @@ type querierServiceClient struct {
 	selectMergeSpanProfile *connect.Client[v1.SelectMergeSpanProfileRequest, v1.SelectMergeSpanProfileResponse]
 	selectMergeProfile     *connect.Client[v1.SelectMergeProfileRequest, v12.Profile]
 	selectSeries           *connect.Client[v1.SelectSeriesRequest, v1.SelectSeriesResponse]
+	selectHeatmap          *connect.Client[v1.SelectHeatmapRequest, v1.SelectHeatmapResponse]
+	getProfile             *connect.Client[v1.GetProfileRequest, v12.Profile]
+	selectTopFunctions     *connect.Client[v1.SelectTopFunctionsRequest, v1.SelectTopFunctionsResponse]
+	selectCallersCallees   *connect.Client[v1.SelectCallersCalleesRequest, v1.SelectCallersCalleesResponse]
+	selectProfileTrees     *connect.Client[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse]
 	diff                   *connect.Client[v1.DiffRequest, v1.DiffResponse]
 	getProfileStats        *connect.Client[v11.GetProfileStatsRequest, v11.GetProfileStatsResponse]
 	analyzeQuery           *connect.Client[v1.AnalyzeQueryRequest, v1.AnalyzeQueryResponse]
@@ -228,6 +288,31 @@ func (c *querierServiceClient) SelectSeries(ctx context.Context, req *connect.Re
 	return c.selectSeries.CallUnary(ctx, req)
 }
 
+// SelectHeatmap calls querier.v1.QuerierService.SelectHeatmap.
+func (c *querierServiceClient) SelectHeatmap(ctx context.Context, req *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error) {
+	return c.selectHeatmap.CallUnary(ctx, req)
+}
+
+// GetProfile calls querier.v1.QuerierService.GetProfile.
+func (c *querierServiceClient) GetProfile(ctx context.Context, req *connect.Request[v1.GetProfileRequest]) (*connect.Response[v12.Profile], error) {
+	return c.getProfile.CallUnary(ctx, req)
+}
+
+// SelectTopFunctions calls querier.v1.QuerierService.SelectTopFunctions.
+func (c *querierServiceClient) SelectTopFunctions(ctx context.Context, req *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error) {
+	return c.selectTopFunctions.CallUnary(ctx, req)
+}
+
+// SelectCallersCallees calls querier.v1.QuerierService.SelectCallersCallees.
+func (c *querierServiceClient) SelectCallersCallees(ctx context.Context, req *connect.Request[v1.SelectCallersCalleesRequest]) (*connect.Response[v1.SelectCallersCalleesResponse], error) {
+	return c.selectCallersCallees.CallUnary(ctx, req)
+}
+
+// SelectProfileTrees calls querier.v1.QuerierService.SelectProfileTrees.
+func (c *querierServiceClient) SelectProfileTrees(ctx context.Context, req *connect.Request[v1.SelectProfileTreesRequest]) (*connect.Response[v1.SelectProfileTreesResponse], error) {
+	return c.selectProfileTrees.CallUnary(ctx, req)
+}
+
 // Diff calls querier.v1.QuerierService.Diff.
 func (c *querierServiceClient) Diff(ctx context.Context, req *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
 	return c.diff.CallUnary(ctx, req)
@@ -261,6 +346,16 @@ type QuerierServiceHandler interface {
 	SelectMergeProfile(context.Context, *connect.Request[v1.SelectMergeProfileRequest]) (*connect.Response[v12.Profile], error)
 	// SelectSeries returns a time series for the total sum of the requested profiles.
 	SelectSeries(context.Context, *connect.Request[v1.SelectSeriesRequest]) (*connect.Response[v1.SelectSeriesResponse], error)
+	// SelectHeatmap returns the distribution of the total values of the requested profiles over time.
+	SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error)
+	// GetProfile returns a single profile, as referenced by a series exemplar.
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v12.Profile], error)
+	// SelectTopFunctions returns the functions with the highest self or total values of the requested profiles.
+	SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error)
+	// SelectCallersCallees returns the merged callers and callees of a function across all the stack traces of the requested profiles.
+	SelectCallersCallees(context.Context, *connect.Request[v1.SelectCallersCalleesRequest]) (*connect.Response[v1.SelectCallersCalleesResponse], error)
+	// SelectProfileTrees returns the individual profiles of a single series as call trees, ordered by time.
+	SelectProfileTrees(context.Context, *connect.Request[v1.SelectProfileTreesRequest]) (*connect.Response[v1.SelectProfileTreesResponse], error)
 	// Diff returns a diff of two profiles
 	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
@@ -323,6 +418,36 @@ func NewQuerierServiceHandler(svc QuerierServiceHandler, opts ...connect.Handler
 		connect.WithSchema(querierServiceMethods.ByName("SelectSeries")),
 		connect.WithHandlerOptions(opts...),
 	)
+	querierServiceSelectHeatmapHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectHeatmapProcedure,
+		svc.SelectHeatmap,
+		connect.WithSchema(querierServiceMethods.ByName("SelectHeatmap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceGetProfileHandler := connect.NewUnaryHandler(
+		QuerierServiceGetProfileProcedure,
+		svc.GetProfile,
+		connect.WithSchema(querierServiceMethods.ByName("GetProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectTopFunctionsHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectTopFunctionsProcedure,
+		svc.SelectTopFunctions,
+		connect.WithSchema(querierServiceMethods.ByName("SelectTopFunctions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectCallersCalleesHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectCallersCalleesProcedure,
+		svc.SelectCallersCallees,
+		connect.WithSchema(querierServiceMethods.ByName("SelectCallersCallees")),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectProfileTreesHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectProfileTreesProcedure,
+		svc.SelectProfileTrees,
+		connect.WithSchema(querierServiceMethods.ByName("SelectProfileTrees")),
+		connect.WithHandlerOptions(opts...),
+	)
 	querierServiceDiffHandler := connect.NewUnaryHandler(
 		QuerierServiceDiffProcedure,
 		svc.Diff,
@@ -359,6 +484,16 @@ func NewQuerierServiceHandler(svc QuerierServiceHandler, opts ...connect.Handler
 			querierServiceSelectMergeProfileHandler.ServeHTTP(w, r)
 		case QuerierServiceSelectSeriesProcedure:
 			querierServiceSelectSeriesHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectHeatmapProcedure:
+			querierServiceSelectHeatmapHandler.ServeHTTP(w, r)
+		case QuerierServiceGetProfileProcedure:
+			querierServiceGetProfileHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectTopFunctionsProcedure:
+			querierServiceSelectTopFunctionsHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectCallersCalleesProcedure:
+			querierServiceSelectCallersCalleesHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectProfileTreesProcedure:
+			querierServiceSelectProfileTreesHandler.ServeHTTP(w, r)
 		case QuerierServiceDiffProcedure:
 			querierServiceDiffHandler.ServeHTTP(w, r)
 		case QuerierServiceGetProfileStatsProcedure:
@@ -406,6 +541,26 @@ func (UnimplementedQuerierServiceHandler) SelectSeries(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectSeries is not implemented"))
 }
 
+func (UnimplementedQuerierServiceHandler) SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectHeatmap is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v12.Profile], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.GetProfile is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectTopFunctions is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectCallersCallees(context.Context, *connect.Request[v1.SelectCallersCalleesRequest]) (*connect.Response[v1.SelectCallersCalleesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectCallersCallees is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectProfileTrees(context.Context, *connect.Request[v1.SelectProfileTreesRequest]) (*connect.Response[v1.SelectProfileTreesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectProfileTrees is not implemented"))
+}
+
 func (UnimplementedQuerierServiceHandler) Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.Diff is not implemented"))
 }
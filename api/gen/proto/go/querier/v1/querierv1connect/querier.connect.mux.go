@@ -59,6 +59,31 @@ func RegisterQuerierServiceHandler(mux *mux.Router, svc QuerierServiceHandler, o
 		svc.SelectSeries,
 		opts...,
 	))
+	mux.Handle("/querier.v1.QuerierService/SelectHeatmap", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectHeatmap",
+		svc.SelectHeatmap,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/GetProfile", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/GetProfile",
+		svc.GetProfile,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectTopFunctions", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectTopFunctions",
+		svc.SelectTopFunctions,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectCallersCallees", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectCallersCallees",
+		svc.SelectCallersCallees,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectProfileTrees", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectProfileTrees",
+		svc.SelectProfileTrees,
+		opts...,
+	))
 	mux.Handle("/querier.v1.QuerierService/Diff", connect.NewUnaryHandler(
 		"/querier.v1.QuerierService/Diff",
 		svc.Diff,
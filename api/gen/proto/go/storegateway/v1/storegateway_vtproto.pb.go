@@ -34,6 +34,8 @@ type StoreGatewayServiceClient interface {
 	MergeProfilesLabels(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesLabelsClient, error)
 	MergeProfilesPprof(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesPprofClient, error)
 	MergeSpanProfile(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeSpanProfileClient, error)
+	MergeProfilesTopFunctions(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesTopFunctionsClient, error)
+	SelectProfileTrees(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_SelectProfileTreesClient, error)
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(ctx context.Context, in *v1.ProfileTypesRequest, opts ...grpc.CallOption) (*v1.ProfileTypesResponse, error)
@@ -176,6 +178,68 @@ func (x *storeGatewayServiceMergeSpanProfileClient) Recv() (*v1.MergeSpanProfile
 	return m, nil
 }
 
+func (c *storeGatewayServiceClient) MergeProfilesTopFunctions(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesTopFunctionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreGatewayService_ServiceDesc.Streams[4], "/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeGatewayServiceMergeProfilesTopFunctionsClient{stream}
+	return x, nil
+}
+
+type StoreGatewayService_MergeProfilesTopFunctionsClient interface {
+	Send(*v1.MergeProfilesTopFunctionsRequest) error
+	Recv() (*v1.MergeProfilesTopFunctionsResponse, error)
+	grpc.ClientStream
+}
+
+type storeGatewayServiceMergeProfilesTopFunctionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsClient) Send(m *v1.MergeProfilesTopFunctionsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsClient) Recv() (*v1.MergeProfilesTopFunctionsResponse, error) {
+	m := new(v1.MergeProfilesTopFunctionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeGatewayServiceClient) SelectProfileTrees(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_SelectProfileTreesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreGatewayService_ServiceDesc.Streams[5], "/storegateway.v1.StoreGatewayService/SelectProfileTrees", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeGatewayServiceSelectProfileTreesClient{stream}
+	return x, nil
+}
+
+type StoreGatewayService_SelectProfileTreesClient interface {
+	Send(*v1.SelectProfileTreesRequest) error
+	Recv() (*v1.SelectProfileTreesResponse, error)
+	grpc.ClientStream
+}
+
+type storeGatewayServiceSelectProfileTreesClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeGatewayServiceSelectProfileTreesClient) Send(m *v1.SelectProfileTreesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceSelectProfileTreesClient) Recv() (*v1.SelectProfileTreesResponse, error) {
+	m := new(v1.SelectProfileTreesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *storeGatewayServiceClient) ProfileTypes(ctx context.Context, in *v1.ProfileTypesRequest, opts ...grpc.CallOption) (*v1.ProfileTypesResponse, error) {
 	out := new(v1.ProfileTypesResponse)
 	err := c.cc.Invoke(ctx, "/storegateway.v1.StoreGatewayService/ProfileTypes", in, out, opts...)
@@ -238,6 +302,8 @@ type StoreGatewayServiceServer interface {
 	MergeProfilesLabels(StoreGatewayService_MergeProfilesLabelsServer) error
 	MergeProfilesPprof(StoreGatewayService_MergeProfilesPprofServer) error
 	MergeSpanProfile(StoreGatewayService_MergeSpanProfileServer) error
+	MergeProfilesTopFunctions(StoreGatewayService_MergeProfilesTopFunctionsServer) error
+	SelectProfileTrees(StoreGatewayService_SelectProfileTreesServer) error
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *v1.ProfileTypesRequest) (*v1.ProfileTypesResponse, error)
@@ -265,6 +331,12 @@ func (UnimplementedStoreGatewayServiceServer) MergeProfilesPprof(StoreGatewaySer
 func (UnimplementedStoreGatewayServiceServer) MergeSpanProfile(StoreGatewayService_MergeSpanProfileServer) error {
 	return status.Errorf(codes.Unimplemented, "method MergeSpanProfile not implemented")
 }
+func (UnimplementedStoreGatewayServiceServer) MergeProfilesTopFunctions(StoreGatewayService_MergeProfilesTopFunctionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MergeProfilesTopFunctions not implemented")
+}
+func (UnimplementedStoreGatewayServiceServer) SelectProfileTrees(StoreGatewayService_SelectProfileTreesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SelectProfileTrees not implemented")
+}
 func (UnimplementedStoreGatewayServiceServer) ProfileTypes(context.Context, *v1.ProfileTypesRequest) (*v1.ProfileTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProfileTypes not implemented")
 }
@@ -400,6 +472,58 @@ func (x *storeGatewayServiceMergeSpanProfileServer) Recv() (*v1.MergeSpanProfile
 	return m, nil
 }
 
+func _StoreGatewayService_MergeProfilesTopFunctions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StoreGatewayServiceServer).MergeProfilesTopFunctions(&storeGatewayServiceMergeProfilesTopFunctionsServer{stream})
+}
+
+type StoreGatewayService_MergeProfilesTopFunctionsServer interface {
+	Send(*v1.MergeProfilesTopFunctionsResponse) error
+	Recv() (*v1.MergeProfilesTopFunctionsRequest, error)
+	grpc.ServerStream
+}
+
+type storeGatewayServiceMergeProfilesTopFunctionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsServer) Send(m *v1.MergeProfilesTopFunctionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsServer) Recv() (*v1.MergeProfilesTopFunctionsRequest, error) {
+	m := new(v1.MergeProfilesTopFunctionsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StoreGatewayService_SelectProfileTrees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StoreGatewayServiceServer).SelectProfileTrees(&storeGatewayServiceSelectProfileTreesServer{stream})
+}
+
+type StoreGatewayService_SelectProfileTreesServer interface {
+	Send(*v1.SelectProfileTreesResponse) error
+	Recv() (*v1.SelectProfileTreesRequest, error)
+	grpc.ServerStream
+}
+
+type storeGatewayServiceSelectProfileTreesServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeGatewayServiceSelectProfileTreesServer) Send(m *v1.SelectProfileTreesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceSelectProfileTreesServer) Recv() (*v1.SelectProfileTreesRequest, error) {
+	m := new(v1.SelectProfileTreesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _StoreGatewayService_ProfileTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.ProfileTypesRequest)
 	if err := dec(in); err != nil {
@@ -565,6 +689,18 @@ var StoreGatewayService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "MergeProfilesTopFunctions",
+			Handler:       _StoreGatewayService_MergeProfilesTopFunctions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SelectProfileTrees",
+			Handler:       _StoreGatewayService_SelectProfileTrees_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "storegateway/v1/storegateway.proto",
 }
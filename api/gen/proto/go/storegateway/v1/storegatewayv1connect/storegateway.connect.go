@@ -47,6 +47,12 @@ const (
 	// StoreGatewayServiceMergeSpanProfileProcedure is the fully-qualified name of the
 	// StoreGatewayService's MergeSpanProfile RPC.
 	StoreGatewayServiceMergeSpanProfileProcedure = "/storegateway.v1.StoreGatewayService/MergeSpanProfile"
+	// StoreGatewayServiceMergeProfilesTopFunctionsProcedure is the fully-qualified name of the
+	// StoreGatewayService's MergeProfilesTopFunctions RPC.
+	StoreGatewayServiceMergeProfilesTopFunctionsProcedure = "/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions"
+	// StoreGatewayServiceSelectProfileTreesProcedure is the fully-qualified name of the
+	// StoreGatewayService's SelectProfileTrees RPC.
+	StoreGatewayServiceSelectProfileTreesProcedure = "/storegateway.v1.StoreGatewayService/SelectProfileTrees"
 	// StoreGatewayServiceProfileTypesProcedure is the fully-qualified name of the StoreGatewayService's
 	// ProfileTypes RPC.
 	StoreGatewayServiceProfileTypesProcedure = "/storegateway.v1.StoreGatewayService/ProfileTypes"
@@ -73,6 +79,8 @@ type StoreGatewayServiceClient interface {
 	MergeProfilesLabels(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
 	MergeProfilesPprof(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
 	MergeSpanProfile(context.Context) *connect.BidiStreamForClient[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
+	MergeProfilesTopFunctions(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]
+	SelectProfileTrees(context.Context) *connect.BidiStreamForClient[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse]
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *connect.Request[v1.ProfileTypesRequest]) (*connect.Response[v1.ProfileTypesResponse], error)
@@ -118,6 +126,18 @@ func NewStoreGatewayServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(storeGatewayServiceMethods.ByName("MergeSpanProfile")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeProfilesTopFunctions: connect.NewClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse](
+			httpClient,
+			baseURL+StoreGatewayServiceMergeProfilesTopFunctionsProcedure,
+			connect.WithSchema(storeGatewayServiceMethods.ByName("MergeProfilesTopFunctions")),
+			connect.WithClientOptions(opts...),
+		),
+		selectProfileTrees: connect.NewClient[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse](
+			httpClient,
+			baseURL+StoreGatewayServiceSelectProfileTreesProcedure,
+			connect.WithSchema(storeGatewayServiceMethods.ByName("SelectProfileTrees")),
+			connect.WithClientOptions(opts...),
+		),
 		profileTypes: connect.NewClient[v1.ProfileTypesRequest, v1.ProfileTypesResponse](
 			httpClient,
 			baseURL+StoreGatewayServiceProfileTypesProcedure,
@@ -159,16 +179,18 @@ func NewStoreGatewayServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // storeGatewayServiceClient implements StoreGatewayServiceClient.
 type storeGatewayServiceClient struct {
-	mergeProfilesStacktraces *connect.Client[v1.MergeProfilesStacktracesRequest, v1.MergeProfilesStacktracesResponse]
-	mergeProfilesLabels      *connect.Client[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
-	mergeProfilesPprof       *connect.Client[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
-	mergeSpanProfile         *connect.Client[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
-	profileTypes             *connect.Client[v1.ProfileTypesRequest, v1.ProfileTypesResponse]
-	labelValues              *connect.Client[v11.LabelValuesRequest, v11.LabelValuesResponse]
-	labelNames               *connect.Client[v11.LabelNamesRequest, v11.LabelNamesResponse]
-	series                   *connect.Client[v1.SeriesRequest, v1.SeriesResponse]
-	blockMetadata            *connect.Client[v1.BlockMetadataRequest, v1.BlockMetadataResponse]
-	getBlockStats            *connect.Client[v1.GetBlockStatsRequest, v1.GetBlockStatsResponse]
+	mergeProfilesStacktraces  *connect.Client[v1.MergeProfilesStacktracesRequest, v1.MergeProfilesStacktracesResponse]
+	mergeProfilesLabels       *connect.Client[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
+	mergeProfilesPprof        *connect.Client[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
+	mergeSpanProfile          *connect.Client[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
+	mergeProfilesTopFunctions *connect.Client[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]
+	selectProfileTrees        *connect.Client[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse]
+	profileTypes              *connect.Client[v1.ProfileTypesRequest, v1.ProfileTypesResponse]
+	labelValues               *connect.Client[v11.LabelValuesRequest, v11.LabelValuesResponse]
+	labelNames                *connect.Client[v11.LabelNamesRequest, v11.LabelNamesResponse]
+	series                    *connect.Client[v1.SeriesRequest, v1.SeriesResponse]
+	blockMetadata             *connect.Client[v1.BlockMetadataRequest, v1.BlockMetadataResponse]
+	getBlockStats             *connect.Client[v1.GetBlockStatsRequest, v1.GetBlockStatsResponse]
 }
 
 // MergeProfilesStacktraces calls storegateway.v1.StoreGatewayService.MergeProfilesStacktraces.
@@ -191,6 +213,16 @@ func (c *storeGatewayServiceClient) MergeSpanProfile(ctx context.Context) *conne
 	return c.mergeSpanProfile.CallBidiStream(ctx)
 }
 
+// MergeProfilesTopFunctions calls storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions.
+func (c *storeGatewayServiceClient) MergeProfilesTopFunctions(ctx context.Context) *connect.BidiStreamForClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse] {
+	return c.mergeProfilesTopFunctions.CallBidiStream(ctx)
+}
+
+// SelectProfileTrees calls storegateway.v1.StoreGatewayService.SelectProfileTrees.
+func (c *storeGatewayServiceClient) SelectProfileTrees(ctx context.Context) *connect.BidiStreamForClient[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse] {
+	return c.selectProfileTrees.CallBidiStream(ctx)
+}
+
 // ProfileTypes calls storegateway.v1.StoreGatewayService.ProfileTypes.
 func (c *storeGatewayServiceClient) ProfileTypes(ctx context.Context, req *connect.Request[v1.ProfileTypesRequest]) (*connect.Response[v1.ProfileTypesResponse], error) {
 	return c.profileTypes.CallUnary(ctx, req)
@@ -228,6 +260,8 @@ type StoreGatewayServiceHandler interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]) error
+	SelectProfileTrees(context.Context, *connect.BidiStream[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse]) error
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *connect.Request[v1.ProfileTypesRequest]) (*connect.Response[v1.ProfileTypesResponse], error)
@@ -269,6 +303,18 @@ func NewStoreGatewayServiceHandler(svc StoreGatewayServiceHandler, opts ...conne
 		connect.WithSchema(storeGatewayServiceMethods.ByName("MergeSpanProfile")),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeGatewayServiceMergeProfilesTopFunctionsHandler := connect.NewBidiStreamHandler(
+		StoreGatewayServiceMergeProfilesTopFunctionsProcedure,
+		svc.MergeProfilesTopFunctions,
+		connect.WithSchema(storeGatewayServiceMethods.ByName("MergeProfilesTopFunctions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeGatewayServiceSelectProfileTreesHandler := connect.NewBidiStreamHandler(
+		StoreGatewayServiceSelectProfileTreesProcedure,
+		svc.SelectProfileTrees,
+		connect.WithSchema(storeGatewayServiceMethods.ByName("SelectProfileTrees")),
+		connect.WithHandlerOptions(opts...),
+	)
 	storeGatewayServiceProfileTypesHandler := connect.NewUnaryHandler(
 		StoreGatewayServiceProfileTypesProcedure,
 		svc.ProfileTypes,
@@ -315,6 +361,10 @@ func NewStoreGatewayServiceHandler(svc StoreGatewayServiceHandler, opts ...conne
 			storeGatewayServiceMergeProfilesPprofHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceMergeSpanProfileProcedure:
 			storeGatewayServiceMergeSpanProfileHandler.ServeHTTP(w, r)
+		case StoreGatewayServiceMergeProfilesTopFunctionsProcedure:
+			storeGatewayServiceMergeProfilesTopFunctionsHandler.ServeHTTP(w, r)
+		case StoreGatewayServiceSelectProfileTreesProcedure:
+			storeGatewayServiceSelectProfileTreesHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceProfileTypesProcedure:
 			storeGatewayServiceProfileTypesHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceLabelValuesProcedure:
@@ -352,6 +402,14 @@ func (UnimplementedStoreGatewayServiceHandler) MergeSpanProfile(context.Context,
 	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.MergeSpanProfile is not implemented"))
 }
 
+func (UnimplementedStoreGatewayServiceHandler) MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions is not implemented"))
+}
+
+func (UnimplementedStoreGatewayServiceHandler) SelectProfileTrees(context.Context, *connect.BidiStream[v1.SelectProfileTreesRequest, v1.SelectProfileTreesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.SelectProfileTrees is not implemented"))
+}
+
 func (UnimplementedStoreGatewayServiceHandler) ProfileTypes(context.Context, *connect.Request[v1.ProfileTypesRequest]) (*connect.Response[v1.ProfileTypesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.ProfileTypes is not implemented"))
 }
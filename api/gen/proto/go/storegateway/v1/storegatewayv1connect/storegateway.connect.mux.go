@@ -39,6 +39,16 @@ func RegisterStoreGatewayServiceHandler(mux *mux.Router, svc StoreGatewayService
 		svc.MergeSpanProfile,
 		opts...,
 	))
+	mux.Handle("/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions", connect.NewBidiStreamHandler(
+		"/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions",
+		svc.MergeProfilesTopFunctions,
+		opts...,
+	))
+	mux.Handle("/storegateway.v1.StoreGatewayService/SelectProfileTrees", connect.NewBidiStreamHandler(
+		"/storegateway.v1.StoreGatewayService/SelectProfileTrees",
+		svc.SelectProfileTrees,
+		opts...,
+	))
 	mux.Handle("/storegateway.v1.StoreGatewayService/ProfileTypes", connect.NewUnaryHandler(
 		"/storegateway.v1.StoreGatewayService/ProfileTypes",
 		svc.ProfileTypes,
@@ -72,7 +72,7 @@ func main() {
 
 	queryCmd := app.Command("query", "Query profile store.")
 	queryProfileCmd := queryCmd.Command("profile", "Request merged profile.").Alias("merge")
-	queryProfileOutput := queryProfileCmd.Flag("output", "How to output the result, examples: console, raw, pprof=./my.pprof").Default("console").String()
+	queryProfileOutput := queryProfileCmd.Flag("output", "How to output the result, examples: console, raw, pprof=./my.pprof, collapsed, speedscope=./my.speedscope.json, chrome=./my.trace.json").Default("console").String()
 	queryProfileParams := addQueryProfileParams(queryProfileCmd)
 	queryGoPGOCmd := queryCmd.Command("go-pgo", "Request profile for Go PGO.")
 	queryGoPGOOutput := queryGoPGOCmd.Flag("output", "How to output the result, examples: console, raw, pprof=./my.pprof").Default("pprof=./default.pgo").String()
@@ -88,6 +88,9 @@ func main() {
 	uploadCmd := app.Command("upload", "Upload profile(s).")
 	uploadParams := addUploadParams(uploadCmd)
 
+	uploadDebuginfoCmd := app.Command("upload-debuginfo", "Upload debug info (ELF file or lidia table) for symbolization.")
+	uploadDebuginfoParams := addUploadDebuginfoParams(uploadDebuginfoCmd)
+
 	canaryExporterCmd := app.Command("canary-exporter", "Run the canary exporter.")
 	canaryExporterParams := addCanaryExporterParams(canaryExporterCmd)
 
@@ -161,6 +164,10 @@ func main() {
 		if err := upload(ctx, uploadParams); err != nil {
 			os.Exit(checkError(err))
 		}
+	case uploadDebuginfoCmd.FullCommand():
+		if err := uploadDebuginfo(ctx, uploadDebuginfoParams); err != nil {
+			os.Exit(checkError(err))
+		}
 	case canaryExporterCmd.FullCommand():
 		if err := newCanaryExporter(canaryExporterParams).run(ctx); err != nil {
 			os.Exit(checkError(err))
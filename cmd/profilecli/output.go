@@ -18,12 +18,20 @@ import (
 
 	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/og/agent/spy"
+	"github.com/grafana/pyroscope/pkg/og/convert/chrome"
+	"github.com/grafana/pyroscope/pkg/og/convert/speedscope"
+	"github.com/grafana/pyroscope/pkg/og/storage/tree"
 )
 
 const (
 	outputConsole = "console"
 	outputRaw     = "raw"
 	outputPprof   = "pprof="
+
+	outputCollapsed  = "collapsed"
+	outputSpeedscope = "speedscope"
+	outputChrome     = "chrome"
 )
 
 func outputSeries(result []*typesv1.Labels) error {
@@ -94,5 +102,56 @@ func outputMergeProfile(ctx context.Context, outputFlag string, profile *googlev
 		return nil
 	}
 
+	switch format, filePath, _ := strings.Cut(outputFlag, "="); format {
+	case outputCollapsed, outputSpeedscope, outputChrome:
+		return outputExport(ctx, format, filePath, profile)
+	}
+
 	return errors.Errorf("unknown output %s", outputFlag)
 }
+
+// outputExport writes the profile in one of the export formats to the
+// file, or to the console output, if no file path is specified. Only
+// the first sample type of the profile is exported.
+func outputExport(ctx context.Context, format, filePath string, profile *googlev1.Profile) (err error) {
+	if len(profile.SampleType) == 0 {
+		return errors.New("profile has no sample types")
+	}
+	sampleType := profile.StringTable[profile.SampleType[0].Type]
+	sampleUnit := profile.StringTable[profile.SampleType[0].Unit]
+	t := tree.New()
+	if err = tree.Get(profile, sampleType, func(_ *spy.Labels, name []byte, val int) error {
+		t.Insert(name, uint64(val))
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to convert profile")
+	}
+
+	var data []byte
+	switch format {
+	case outputCollapsed:
+		data = []byte(t.Collapsed())
+	case outputSpeedscope:
+		data, err = speedscope.ExportTree(t, sampleType, sampleUnit)
+	case outputChrome:
+		data, err = chrome.ExportTrace(t, sampleUnit)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to export profile to %s", format)
+	}
+
+	if filePath == "" {
+		_, err = output(ctx).Write(data)
+		return err
+	}
+	// open new file, fail when the file already exists
+	f, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s file", format)
+	}
+	defer runutil.CloseWithErrCapture(&err, f, "failed to close %s file", format)
+	if _, err = f.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s file", format)
+	}
+	return nil
+}
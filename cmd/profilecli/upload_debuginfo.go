@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/go-kit/log/level"
+
+	"github.com/grafana/pyroscope/pkg/experiment/symbolizer"
+)
+
+type uploadDebuginfoParams struct {
+	*phlareClient
+	path    string
+	buildID string
+}
+
+func addUploadDebuginfoParams(cmd commander) *uploadDebuginfoParams {
+	params := &uploadDebuginfoParams{}
+	params.phlareClient = addPhlareClient(cmd)
+
+	cmd.Arg("path", "Path to the ELF file or lidia table to upload").Required().ExistingFileVar(&params.path)
+	cmd.Flag("build-id", "Build ID of the binary. Required for lidia tables and ELF files without a GNU build ID note.").StringVar(&params.buildID)
+	return params
+}
+
+func uploadDebuginfo(ctx context.Context, params *uploadDebuginfoParams) error {
+	data, err := os.ReadFile(params.path)
+	if err != nil {
+		return err
+	}
+
+	buildID := params.buildID
+	if buildID == "" {
+		if buildID, err = elfBuildID(data); err != nil {
+			return err
+		}
+		if buildID == "" {
+			return fmt.Errorf("build ID not found in %s, please specify it with --build-id", params.path)
+		}
+	}
+
+	u := fmt.Sprintf("%s/debuginfo/upload/%s", params.URL, url.PathEscape(buildID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	res, err := params.phlareClient.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to upload debug info: %s: %s", res.Status, bytes.TrimSpace(body))
+	}
+
+	level.Info(logger).Log("msg", "successfully uploaded debug info", "build_id", buildID, "path", params.path)
+	return nil
+}
+
+// elfBuildID returns the GNU build ID of the ELF file. If the
+// data is not an ELF file, an empty string is returned.
+func elfBuildID(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte(elf.ELFMAG)) {
+		return "", nil
+	}
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("parse ELF file: %w", err)
+	}
+	defer f.Close()
+	return symbolizer.GNUBuildID(f)
+}
@@ -0,0 +1,86 @@
+package lidia
+
+import (
+	"bytes"
+	"debug/elf"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// globalDebugDir is the directory where distributions install
+// separate debug files, mirroring the layout of the executables.
+const globalDebugDir = "/usr/lib/debug"
+
+// readDebugLink returns the name of the separate debug file and its
+// CRC32 checksum, as specified in the .gnu_debuglink section.
+func readDebugLink(f *elf.File) (string, uint32, bool) {
+	s := f.Section(".gnu_debuglink")
+	if s == nil {
+		return "", 0, false
+	}
+	data, err := s.Data()
+	if err != nil {
+		return "", 0, false
+	}
+	// The name is followed by zero to three bytes
+	// of padding and the 4-byte checksum.
+	n := bytes.IndexByte(data, 0)
+	if n <= 0 {
+		return "", 0, false
+	}
+	off := (n + 4) &^ 3
+	if off+4 > len(data) {
+		return "", 0, false
+	}
+	return string(data[:n]), f.ByteOrder.Uint32(data[off:]), true
+}
+
+// openDebugLink looks up the separate debug file referenced by the
+// executable in the given directories. For every directory, the file
+// is searched in the directory itself and in its .debug subdirectory.
+// The function returns nil if no file with a matching checksum is found.
+func openDebugLink(f *elf.File, dirs []string) *elf.File {
+	name, crc, ok := readDebugLink(f)
+	if !ok || len(dirs) == 0 {
+		return nil
+	}
+	for _, dir := range dirs {
+		for _, path := range []string{
+			filepath.Join(dir, name),
+			filepath.Join(dir, ".debug", name),
+		} {
+			if !checkFileCRC(path, crc) {
+				continue
+			}
+			if d, err := elf.Open(path); err == nil {
+				return d
+			}
+		}
+	}
+	return nil
+}
+
+func checkFileCRC(path string, expected uint32) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	crc := crc32.NewIEEE()
+	if _, err = io.Copy(crc, f); err != nil {
+		return false
+	}
+	return crc.Sum32() == expected
+}
+
+// debugDirs returns the directories searched for the
+// separate debug file of the executable at the given path.
+func debugDirs(executablePath string) []string {
+	dir, err := filepath.Abs(filepath.Dir(executablePath))
+	if err != nil {
+		return nil
+	}
+	return []string{dir, filepath.Join(globalDebugDir, dir)}
+}
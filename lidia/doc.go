@@ -70,6 +70,12 @@
 //   - WithCRC(): Enables CRC32C checksums for data integrity
 //   - WithFiles(): Includes source file information
 //   - WithLines(): Includes line number information
+//   - WithDebugDirs(): Directories searched for separate debug files
+//
+// Functions, inlined calls, and line numbers are read from the DWARF debug
+// information of the executable, or of the separate debug file referenced by
+// its .gnu_debuglink section. Symbols not covered by the debug information
+// are taken from the ELF symbol table.
 //
 // When creating a lidia file with WithCRC(), the same option must be used when
 // opening the file, or an error will be returned.
@@ -0,0 +1,382 @@
+package lidia
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"errors"
+	"io"
+	"math"
+	"sort"
+)
+
+// dwarfReader extracts function ranges, including inlined calls,
+// and line tables from the DWARF debug information.
+type dwarfReader struct {
+	data *dwarf.Data
+	opt  options
+
+	units     []*dwarf.Entry
+	entries   *dwarf.Reader
+	files     map[dwarf.Offset][]*dwarf.LineFile
+	functions map[dwarf.Offset]function
+	// Ranges of the functions (depth 0) visited.
+	covered [][2]uint64
+}
+
+func newDWARFReader(d *dwarf.Data, opt options) *dwarfReader {
+	return &dwarfReader{
+		data:      d,
+		opt:       opt,
+		entries:   d.Reader(),
+		files:     make(map[dwarf.Offset][]*dwarf.LineFile),
+		functions: make(map[dwarf.Offset]function),
+	}
+}
+
+// loadDWARF returns the DWARF data of the ELF file, or nil
+// if the file does not include debug information.
+func loadDWARF(f *elf.File) *dwarf.Data {
+	if f.Section(".debug_info") == nil && f.Section(".zdebug_info") == nil {
+		return nil
+	}
+	d, err := f.DWARF()
+	if err != nil {
+		return nil
+	}
+	return d
+}
+
+// visitRanges walks the compile units and calls the visitor for every
+// function and inlined call range found. Units that cannot be decoded
+// are skipped. If the list of units can't be read, no ranges are visited
+// and an error is returned.
+func (dr *dwarfReader) visitRanges(visit func(*Range)) error {
+	r := dr.data.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag == dwarf.TagCompileUnit || e.Tag == dwarf.TagPartialUnit {
+			dr.units = append(dr.units, e)
+		}
+		r.SkipChildren()
+	}
+	for _, cu := range dr.units {
+		ranges, err := dr.unitRanges(cu)
+		if err != nil {
+			continue
+		}
+		for i := range ranges {
+			visit(&ranges[i])
+		}
+	}
+	sort.Slice(dr.covered, func(i, j int) bool {
+		return dr.covered[i][0] < dr.covered[j][0]
+	})
+	return nil
+}
+
+// covers reports whether the address belongs to any of the
+// functions found in the debug information.
+func (dr *dwarfReader) covers(addr uint64) bool {
+	i := sort.Search(len(dr.covered), func(i int) bool {
+		return dr.covered[i][0] > addr
+	}) - 1
+	return i >= 0 && addr < dr.covered[i][1]
+}
+
+func (dr *dwarfReader) unitRanges(cu *dwarf.Entry) ([]Range, error) {
+	r := dr.data.Reader()
+	r.Seek(cu.Offset)
+	if _, err := r.Next(); err != nil {
+		return nil, err
+	}
+	var ranges []Range
+	if !cu.Children {
+		return ranges, nil
+	}
+	// Inline depth of the children of each open entry;
+	// -1 indicates that the entry is not within a function.
+	stack := []int{-1}
+	for len(stack) > 0 {
+		e, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		depth := stack[len(stack)-1]
+		switch e.Tag {
+		case dwarf.TagSubprogram:
+			// Declarations and abstract instances of inlined
+			// functions have no address ranges.
+			depth = -1
+			if n := len(ranges); dr.appendRanges(&ranges, e, 0) > n {
+				depth = 0
+			}
+		case dwarf.TagInlinedSubroutine:
+			if depth >= 0 {
+				depth++
+				dr.appendRanges(&ranges, e, depth)
+			}
+		}
+		if e.Children {
+			stack = append(stack, depth)
+		}
+	}
+	if dr.opt.lines || dr.opt.files {
+		if err := dr.addLineTables(cu, ranges); err != nil {
+			return nil, err
+		}
+	}
+	return ranges, nil
+}
+
+// appendRanges appends a range for every address range of the entry,
+// and returns the new number of ranges.
+func (dr *dwarfReader) appendRanges(ranges *[]Range, e *dwarf.Entry, depth int) int {
+	pcs, err := dr.data.Ranges(e)
+	if err != nil {
+		return len(*ranges)
+	}
+	fn := dr.function(e)
+	var callFile string
+	var callLine uint32
+	if depth > 0 {
+		if dr.opt.files {
+			if idx, ok := e.Val(dwarf.AttrCallFile).(int64); ok {
+				callFile = dr.fileName(e.Offset, idx)
+			}
+		}
+		if line, ok := e.Val(dwarf.AttrCallLine).(int64); ok {
+			callLine = uint32(line)
+		}
+	}
+	for _, pc := range pcs {
+		// Zero low PC marks code removed by the linker.
+		if pc[0] == 0 || pc[1] <= pc[0] || pc[1]-pc[0] > math.MaxUint32 {
+			continue
+		}
+		*ranges = append(*ranges, Range{
+			VA:       pc[0],
+			Length:   uint32(pc[1] - pc[0]),
+			Function: fn.name,
+			File:     fn.file,
+			CallFile: callFile,
+			CallLine: callLine,
+			Depth:    uint32(depth),
+		})
+		if depth == 0 {
+			dr.covered = append(dr.covered, pc)
+		}
+	}
+	return len(*ranges)
+}
+
+type function struct {
+	name string
+	file string
+}
+
+// function returns the name and the declaration file of the function the
+// entry refers to. The linkage name takes precedence, as it matches the
+// ELF symbol name.
+func (dr *dwarfReader) function(e *dwarf.Entry) function {
+	off := e.Offset
+	if origin, ok := e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset); ok {
+		// Inlined calls and out-of-line instances share the origin.
+		off = origin
+	}
+	if fn, ok := dr.functions[off]; ok {
+		return fn
+	}
+	var fn function
+	if o := dr.origin(off, dwarf.AttrLinkageName); o != nil {
+		fn.name, _ = o.Val(dwarf.AttrLinkageName).(string)
+	} else if o = dr.origin(off, dwarf.AttrName); o != nil {
+		fn.name, _ = o.Val(dwarf.AttrName).(string)
+	}
+	if dr.opt.files {
+		if o := dr.origin(off, dwarf.AttrDeclFile); o != nil {
+			idx, _ := o.Val(dwarf.AttrDeclFile).(int64)
+			fn.file = dr.fileName(o.Offset, idx)
+		}
+	}
+	dr.functions[off] = fn
+	return fn
+}
+
+// origin follows the abstract origin and specification references of
+// the entry, and returns the first entry that has the given attribute.
+func (dr *dwarfReader) origin(off dwarf.Offset, attr dwarf.Attr) *dwarf.Entry {
+	// Limit the number of references to follow.
+	for i := 0; i < 8; i++ {
+		dr.entries.Seek(off)
+		e, err := dr.entries.Next()
+		if err != nil || e == nil {
+			return nil
+		}
+		if e.Val(attr) != nil {
+			return e
+		}
+		var ok bool
+		if off, ok = e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset); ok {
+			continue
+		}
+		if off, ok = e.Val(dwarf.AttrSpecification).(dwarf.Offset); ok {
+			continue
+		}
+		return nil
+	}
+	return nil
+}
+
+// fileName returns the name of the file at the given index in the
+// line table of the unit that contains the entry.
+func (dr *dwarfReader) fileName(off dwarf.Offset, idx int64) string {
+	i := sort.Search(len(dr.units), func(i int) bool {
+		return dr.units[i].Offset > off
+	}) - 1
+	if i < 0 {
+		return ""
+	}
+	cu := dr.units[i]
+	files, ok := dr.files[cu.Offset]
+	if !ok {
+		if lr, err := dr.data.LineReader(cu); err == nil && lr != nil {
+			files = lr.Files()
+		}
+		dr.files[cu.Offset] = files
+	}
+	if idx < 0 || idx >= int64(len(files)) || files[idx] == nil {
+		return ""
+	}
+	return files[idx].Name
+}
+
+type lineRow struct {
+	addr uint64
+	line uint32
+	file string
+	end  bool
+}
+
+// addLineTables builds the line tables of the unit ranges. A line
+// entry is only added to the deepest range that covers the address,
+// as the line numbers of the callers are given by the call sites.
+func (dr *dwarfReader) addLineTables(cu *dwarf.Entry, ranges []Range) error {
+	lr, err := dr.data.LineReader(cu)
+	if err != nil || lr == nil {
+		return err
+	}
+	var rows []lineRow
+	var le dwarf.LineEntry
+	for {
+		if err = lr.Next(&le); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		row := lineRow{addr: le.Address, line: uint32(le.Line), end: le.EndSequence}
+		if le.File != nil {
+			row.file = le.File.Name
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 || len(ranges) == 0 {
+		return nil
+	}
+	// Sequences may overlap at the boundaries: the end of a sequence
+	// goes before the start of the next one.
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].addr == rows[j].addr {
+			return rows[i].end && !rows[j].end
+		}
+		return rows[i].addr < rows[j].addr
+	})
+	order := make([]int, len(ranges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := &ranges[order[i]], &ranges[order[j]]
+		if a.VA == b.VA {
+			return a.Depth < b.Depth
+		}
+		return a.VA < b.VA
+	})
+
+	bounds := make([]uint64, 0, len(rows)+2*len(ranges))
+	for _, row := range rows {
+		bounds = append(bounds, row.addr)
+	}
+	for i := range ranges {
+		bounds = append(bounds, ranges[i].VA, ranges[i].VA+uint64(ranges[i].Length))
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+
+	var active []*Range
+	next, row := 0, -1
+	for i, b := range bounds {
+		if i > 0 && bounds[i-1] == b {
+			continue
+		}
+		for row+1 < len(rows) && rows[row+1].addr <= b {
+			row++
+		}
+		current := row >= 0 && !rows[row].end
+		for ; next < len(order) && ranges[order[next]].VA <= b; next++ {
+			active = append(active, &ranges[order[next]])
+		}
+		var deepest *Range
+		n := 0
+		for _, r := range active {
+			if b >= r.VA+uint64(r.Length) {
+				continue
+			}
+			active[n] = r
+			n++
+			if deepest == nil || r.Depth >= deepest.Depth {
+				deepest = r
+			}
+		}
+		active = active[:n]
+		if deepest == nil || !current {
+			continue
+		}
+		if deepest.File == "" && dr.opt.files {
+			// The declaration file is not specified.
+			deepest.File = rows[row].file
+		}
+		if !dr.opt.lines {
+			continue
+		}
+		line := rows[row].line
+		k := len(deepest.LineTable)
+		if k > 0 && deepest.LineTable[k-1].LineNumber == line {
+			continue
+		}
+		var offset uint32
+		if k > 0 {
+			// Addresses preceding the first line entry of the range
+			// (e.g., function prologues in Go binaries) are attributed
+			// to the first line.
+			offset = uint32(b - deepest.VA)
+		}
+		deepest.LineTable = append(deepest.LineTable, LineTableEntry{
+			Offset:     offset,
+			LineNumber: line,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package lidia_test
+
+import (
+	"bufio"
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/lidia"
+)
+
+const testProgram = `#include <stdlib.h>
+
+static inline __attribute__((always_inline)) int square(int x) {
+	return x * x;
+}
+
+static inline __attribute__((always_inline)) int sum_squares(int n) {
+	int s = 0;
+	for (int i = 0; i < n; i++)
+		s += square(i) ^ n;
+	return s;
+}
+
+__attribute__((noinline)) int compute(int n) {
+	return sum_squares(n) + 1;
+}
+
+int main(int argc, char **argv) {
+	return compute(argc > 1 ? atoi(argv[1]) : 10);
+}
+`
+
+// buildTestProgram compiles the test program with optimizations
+// and debug information, and returns the path to the executable.
+func buildTestProgram(t *testing.T) string {
+	t.Helper()
+	for _, tool := range []string{"gcc", "addr2line", "objcopy"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s not found", tool)
+		}
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "prog.c")
+	require.NoError(t, os.WriteFile(src, []byte(testProgram), 0o644))
+	bin := filepath.Join(dir, "prog")
+	run(t, "gcc", "-O2", "-g", "-o", bin, src)
+	return bin
+}
+
+func run(t *testing.T, name string, args ...string) []byte {
+	t.Helper()
+	out, err := exec.Command(name, args...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return out
+}
+
+// addr2line returns the frames of the addresses, as reported by addr2line.
+func addr2line(t *testing.T, bin string, addrs []uint64) map[uint64][]lidia.SourceInfoFrame {
+	t.Helper()
+	args := []string{"-a", "-i", "-f", "-e", bin}
+	for _, addr := range addrs {
+		args = append(args, fmt.Sprintf("%#x", addr))
+	}
+	frames := make(map[uint64][]lidia.SourceInfoFrame)
+	var addr uint64
+	s := bufio.NewScanner(bytes.NewReader(run(t, "addr2line", args...)))
+	for s.Scan() {
+		if strings.HasPrefix(s.Text(), "0x") {
+			v, err := strconv.ParseUint(s.Text()[2:], 16, 64)
+			require.NoError(t, err)
+			addr = v
+			continue
+		}
+		name := s.Text()
+		require.True(t, s.Scan())
+		location, _, _ := strings.Cut(s.Text(), " ")
+		file, line, _ := strings.Cut(location, ":")
+		n, _ := strconv.ParseUint(line, 10, 64)
+		frames[addr] = append(frames[addr], lidia.SourceInfoFrame{
+			FunctionName: name,
+			FilePath:     filepath.Base(file),
+			LineNumber:   n,
+		})
+	}
+	return frames
+}
+
+func openTable(t *testing.T, path string) *lidia.Table {
+	t.Helper()
+	bs, err := os.ReadFile(path)
+	require.NoError(t, err)
+	table, err := lidia.OpenReader(&bufferCloser{bs, 0}, lidia.WithCRC())
+	require.NoError(t, err)
+	t.Cleanup(table.Close)
+	return table
+}
+
+func functionAddresses(t *testing.T, bin, name string) []uint64 {
+	t.Helper()
+	f, err := elf.Open(bin)
+	require.NoError(t, err)
+	defer f.Close()
+	symbols, err := f.Symbols()
+	require.NoError(t, err)
+	for _, s := range symbols {
+		if s.Name == name {
+			addrs := make([]uint64, 0, s.Size)
+			for addr := s.Value; addr < s.Value+s.Size; addr++ {
+				addrs = append(addrs, addr)
+			}
+			return addrs
+		}
+	}
+	t.Fatalf("symbol %s not found", name)
+	return nil
+}
+
+func requireInlinedFrames(t *testing.T, bin string, table *lidia.Table) {
+	t.Helper()
+	addrs := functionAddresses(t, bin, "compute")
+	expected := addr2line(t, bin, addrs)
+	var frames []lidia.SourceInfoFrame
+	var inlined int
+	for _, addr := range addrs {
+		var err error
+		frames, err = table.Lookup(frames, addr)
+		require.NoError(t, err)
+		for i := range frames {
+			frames[i].FilePath = filepath.Base(frames[i].FilePath)
+		}
+		require.Equal(t, expected[addr], frames, "address %#x", addr)
+		if len(frames) > 2 {
+			inlined++
+		}
+	}
+	require.NotZero(t, inlined, "expected nested inlined frames")
+}
+
+func TestCreateLidia_InlinedFrames(t *testing.T) {
+	bin := buildTestProgram(t)
+	lidiaPath := filepath.Join(t.TempDir(), "prog.lidia")
+	require.NoError(t, lidia.CreateLidia(bin, lidiaPath,
+		lidia.WithCRC(), lidia.WithFiles(), lidia.WithLines()))
+	requireInlinedFrames(t, bin, openTable(t, lidiaPath))
+}
+
+func TestCreateLidia_DebugLink(t *testing.T) {
+	bin := buildTestProgram(t)
+	debugDir := t.TempDir()
+	debugFile := filepath.Join(debugDir, "prog.debug")
+	run(t, "objcopy", "--only-keep-debug", bin, debugFile)
+	stripped := filepath.Join(filepath.Dir(bin), "prog.stripped")
+	run(t, "objcopy", "--strip-debug", "--add-gnu-debuglink="+debugFile, bin, stripped)
+
+	f, err := elf.Open(stripped)
+	require.NoError(t, err)
+	defer f.Close()
+	require.Nil(t, f.Section(".debug_info"))
+
+	lidiaPath := filepath.Join(t.TempDir(), "prog.lidia")
+	out, err := os.Create(lidiaPath)
+	require.NoError(t, err)
+	defer out.Close()
+	require.NoError(t, lidia.CreateLidiaFromELF(f, out,
+		lidia.WithCRC(), lidia.WithFiles(), lidia.WithLines(),
+		lidia.WithDebugDirs(debugDir)))
+	requireInlinedFrames(t, bin, openTable(t, lidiaPath))
+
+	// The debug file is found next to the executable.
+	localDebugDir := filepath.Join(filepath.Dir(bin), ".debug")
+	require.NoError(t, os.MkdirAll(localDebugDir, 0o755))
+	require.NoError(t, os.Rename(debugFile, filepath.Join(localDebugDir, "prog.debug")))
+	require.NoError(t, lidia.CreateLidia(stripped, lidiaPath,
+		lidia.WithCRC(), lidia.WithFiles(), lidia.WithLines()))
+	requireInlinedFrames(t, bin, openTable(t, lidiaPath))
+}
@@ -7,6 +7,7 @@ package lidia
 
 import (
 	"debug/elf"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -100,6 +101,11 @@ func OpenReader(f ReaderAtCloser, opt ...Option) (*Table, error) {
 		return nil, fmt.Errorf("invalid fieldSize: %d, expected 4 or 8", hdr.rangeTableHeader.fieldSize)
 	}
 
+	if hdr.lineTablesHeader.fieldSize != 2 && hdr.lineTablesHeader.fieldSize != 4 {
+		res.Close()
+		return nil, fmt.Errorf("invalid line table fieldSize: %d, expected 2 or 4", hdr.lineTablesHeader.fieldSize)
+	}
+
 	if hdr.rangeTableHeader.count != hdr.vaTableHeader.count {
 		res.Close()
 		return nil, fmt.Errorf("count mismatch: range table count (%d) != VA table count (%d)",
@@ -129,6 +135,9 @@ func OpenReader(f ReaderAtCloser, opt ...Option) (*Table, error) {
 
 // CreateLidia generates a lidia format file from an ELF executable.
 // It extracts symbol information and writes it to the output file.
+// The separate debug file referenced by the executable is searched in
+// the executable directory and in the global debug directory, in
+// addition to the directories specified with WithDebugDirs.
 func CreateLidia(executablePath, outputPath string, opts ...Option) error {
 	executable, err := os.Open(executablePath)
 	if err != nil {
@@ -147,11 +156,18 @@ func CreateLidia(executablePath, outputPath string, opts ...Option) error {
 		return fmt.Errorf("failed to parse ELF file: %w", err)
 	}
 
+	opts = append([]Option{WithDebugDirs(debugDirs(executablePath)...)}, opts...)
 	return CreateLidiaFromELF(e, output, opts...)
 }
 
 // CreateLidiaFromELF generates a lidia format file from an already opened ELF file.
 // This allows more control over the ELF file handling.
+//
+// Functions, inlined calls and line numbers are read from the DWARF debug
+// information, if available; either in the ELF file itself, or in the
+// separate debug file referenced by the .gnu_debuglink section, see
+// WithDebugDirs. Symbols not covered by the debug information are taken
+// from the ELF symbol table.
 func CreateLidiaFromELF(elfFile *elf.File, output io.WriteSeeker, opts ...Option) error {
 	sb := newStringBuilder()
 	rb := newRangesBuilder()
@@ -162,12 +178,38 @@ func CreateLidiaFromELF(elfFile *elf.File, output io.WriteSeeker, opts ...Option
 		o(&rc.opt)
 	}
 
+	debugFile := elfFile
+	d := loadDWARF(elfFile)
+	if d == nil {
+		if f := openDebugLink(elfFile, rc.opt.debugDirs); f != nil {
+			defer f.Close()
+			debugFile = f
+			d = loadDWARF(f)
+		}
+	}
+
+	var dr *dwarfReader
+	if d != nil {
+		dr = newDWARFReader(d, rc.opt)
+		if err := dr.visitRanges(rc.VisitRange); err != nil {
+			// The debug information is malformed:
+			// fall back to the symbol table.
+			dr = nil
+		}
+	}
+
 	symbols, err := elfFile.Symbols()
-	if err != nil {
+	if errors.Is(err, elf.ErrNoSymbols) && debugFile != elfFile {
+		symbols, err = debugFile.Symbols()
+	}
+	if err != nil && (dr == nil || !errors.Is(err, elf.ErrNoSymbols)) {
 		return fmt.Errorf("failed to read symbols from ELF file: %w", err)
 	}
 
 	for _, symbol := range symbols {
+		if dr != nil && dr.covers(symbol.Value) {
+			continue
+		}
 		rc.VisitRange(&Range{
 			VA:        symbol.Value,
 			Length:    uint32(symbol.Size),
@@ -196,6 +238,10 @@ func CreateLidiaFromELF(elfFile *elf.File, output io.WriteSeeker, opts ...Option
 // symbolization result for the given address. The returned slice may be the same as
 // the input slice 'dst' with updated contents, or a new slice if 'dst' needed to grow.
 // If 'dst' is nil, a new slice will be allocated.
+//
+// If the address belongs to an inlined function, the frames are ordered from
+// the innermost inlined function to the outermost one, and the line number of
+// each caller frame is the line of the call site.
 func (st *Table) Lookup(dst []SourceInfoFrame, addr uint64) ([]SourceInfoFrame, error) {
 	dst = dst[:0]
 
@@ -204,6 +250,9 @@ func (st *Table) Lookup(dst []SourceInfoFrame, addr uint64) ([]SourceInfoFrame,
 	})
 	idx--
 
+	// The entry of the previously added frame, if any: the
+	// covered entries are visited from the innermost one.
+	var callee *entry
 	for idx >= 0 {
 		it, err := st.getEntry(idx)
 		if err != nil {
@@ -211,21 +260,24 @@ func (st *Table) Lookup(dst []SourceInfoFrame, addr uint64) ([]SourceInfoFrame,
 		}
 
 		covered := it.va <= addr && addr < it.va+it.length
-		if covered {
-			name := st.str(it.funcOffset)
-			file := st.str(it.fileOffset)
-
+		if covered && (callee == nil || it.depth < callee.depth) {
 			res := SourceInfoFrame{
-				FunctionName: name,
-				FilePath:     file,
+				FunctionName: st.str(it.funcOffset),
+				FilePath:     st.str(it.fileOffset),
+			}
+			if callee == nil {
+				if res.LineNumber, err = st.lineNumber(it, addr); err != nil {
+					return dst, fmt.Errorf("failed to read line table at index %d: %w", idx, err)
+				}
+			} else {
+				// The function is the caller of the inlined one.
+				res.LineNumber = callee.callLine
+				if res.FilePath == "" {
+					res.FilePath = st.str(callee.callFile)
+				}
 			}
-
-			// Add line number information if available
-			//if it.lineTable.count > 0 {
-			// Line number could be extracted here if implemented
-			//}
-
 			dst = append(dst, res)
+			callee = &it
 		}
 
 		if it.depth == 0 {
@@ -237,6 +289,28 @@ func (st *Table) Lookup(dst []SourceInfoFrame, addr uint64) ([]SourceInfoFrame,
 	return dst, nil
 }
 
+// lineNumber returns the line number of the address within the entry range.
+func (st *Table) lineNumber(e entry, addr uint64) (uint64, error) {
+	if e.lineTable.count == 0 {
+		return 0, nil
+	}
+	offset := addr - e.va
+	var err error
+	i := sort.Search(int(e.lineTable.count), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var lte LineTableEntry
+		lte, err = st.getLineTableEntry(e.lineTable.idx + uint64(i))
+		return uint64(lte.Offset) > offset
+	})
+	if err != nil || i == 0 {
+		return 0, err
+	}
+	lte, err := st.getLineTableEntry(e.lineTable.idx + uint64(i-1))
+	return uint64(lte.LineNumber), err
+}
+
 // Close releases resources associated with the Table.
 func (st *Table) Close() {
 	if st.file != nil {
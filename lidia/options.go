@@ -9,6 +9,8 @@ type options struct {
 	crc   bool // Enable CRC checking
 	lines bool // Include line number information
 	files bool // Include file path information
+
+	debugDirs []string // Directories to search for separate debug files
 }
 
 // WithCRC enables CRC checking when opening lidia files.
@@ -31,3 +33,12 @@ func WithFiles() Option {
 		o.files = true
 	}
 }
+
+// WithDebugDirs sets the directories searched for the separate debug file
+// referenced by the .gnu_debuglink section of the executable, when the
+// executable itself does not include DWARF debug information.
+func WithDebugDirs(dirs ...string) Option {
+	return func(o *options) {
+		o.debugDirs = append(o.debugDirs, dirs...)
+	}
+}
@@ -36,6 +36,28 @@ func (st *Table) getEntry(i int) (entry, error) {
 	return e, nil
 }
 
+func (st *Table) getLineTableEntry(i uint64) (LineTableEntry, error) {
+	if i >= st.hdr.lineTablesHeader.count {
+		return LineTableEntry{}, errors.New("line table index out of bounds")
+	}
+	fieldSize := st.hdr.lineTablesHeader.fieldSize
+	buf := st.fieldsBuffer[:fieldSize*lineTableFieldsCount]
+	offset := int64(st.hdr.lineTablesHeader.offset + i*uint64(len(buf)))
+	if _, err := st.file.ReadAt(buf, offset); err != nil {
+		return LineTableEntry{}, err
+	}
+	if fieldSize == 2 {
+		return LineTableEntry{
+			Offset:     uint32(binary.LittleEndian.Uint16(buf[0:])),
+			LineNumber: uint32(binary.LittleEndian.Uint16(buf[2:])),
+		}, nil
+	}
+	return LineTableEntry{
+		Offset:     binary.LittleEndian.Uint32(buf[0:]),
+		LineNumber: binary.LittleEndian.Uint32(buf[4:]),
+	}, nil
+}
+
 func (st *Table) CheckCRCVA() error {
 	crc := crc32.New(castagnoli)
 	_, _ = crc.Write(st.vaTable)
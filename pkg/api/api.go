@@ -35,6 +35,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/adhocprofiles"
 	"github.com/grafana/pyroscope/pkg/compactor"
 	"github.com/grafana/pyroscope/pkg/distributor"
+	"github.com/grafana/pyroscope/pkg/experiment/symbolizer"
 	"github.com/grafana/pyroscope/pkg/frontend"
 	"github.com/grafana/pyroscope/pkg/frontend/frontendpb/frontendpbconnect"
 	"github.com/grafana/pyroscope/pkg/ingester"
@@ -205,8 +206,7 @@ func (a *API) RegisterDistributor(d *distributor.Distributor, limits delayhandle
 	})
 
 	a.RegisterRoute("/opentelemetry.proto.collector.profiles.v1development.ProfilesService/Export", otlpHandler, writePathOpts...)
-	// TODO(@petethepig): implement http/protobuf and http/json support
-	// a.RegisterRoute("/v1/profiles", otlpHandler, true, true, "POST")
+	a.RegisterRoute("/v1/profiles", otlpHandler, writePathOpts...)
 }
 
 // RegisterMemberlistKV registers the endpoints associated with the memberlist KV store.
@@ -305,6 +305,11 @@ func (a *API) RegisterAdmin(ad *operations.Admin) {
 	})
 }
 
+// RegisterSymbolizer registers the debug info upload endpoint of the symbolizer.
+func (a *API) RegisterSymbolizer(s *symbolizer.Symbolizer) {
+	a.RegisterRoute("/debuginfo/upload/{build_id}", s.UploadHandler(), a.WithAuthMiddleware(), WithMethod("POST"))
+}
+
 func (a *API) RegisterAdHocProfiles(ahp *adhocprofiles.AdHocProfiles) {
 	adhocprofilesv1connect.RegisterAdHocProfileServiceHandler(a.server.HTTP, ahp, a.connectOptionsAuthRecovery()...)
 }
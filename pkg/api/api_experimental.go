@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/metastorev1connect"
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
 	segmentwriterv1 "github.com/grafana/pyroscope/api/gen/proto/go/segmentwriter/v1"
 	segmentwriter "github.com/grafana/pyroscope/pkg/experiment/ingester"
@@ -31,6 +32,7 @@ func (a *API) RegisterQueryBackend(svc *querybackend.QueryBackend) {
 func (a *API) RegisterMetastoreAdmin(adm *metastoreadmin.Admin) {
 	a.RegisterRoute("/metastore-nodes", adm.NodeListHandler(), a.registerOptionsRingPage()...)
 	a.RegisterRoute("/metastore-client-test", adm.ClientTestHandler(), a.registerOptionsRingPage()...)
+	metastorev1connect.RegisterTenantServiceHandler(a.server.HTTP, adm.TenantServiceHandler(), a.connectOptionsRecovery()...)
 	a.indexPage.AddLinks(defaultWeight, "Metastore", []IndexPageLink{
 		{Desc: "Nodes", Path: "/metastore-nodes"},
 		{Desc: "Client Test", Path: "/metastore-client-test"},
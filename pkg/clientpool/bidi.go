@@ -29,3 +29,17 @@ type BidiClientMergeSpanProfile interface {
 	CloseRequest() error
 	CloseResponse() error
 }
+
+type BidiClientMergeProfilesTopFunctions interface {
+	Send(*ingestv1.MergeProfilesTopFunctionsRequest) error
+	Receive() (*ingestv1.MergeProfilesTopFunctionsResponse, error)
+	CloseRequest() error
+	CloseResponse() error
+}
+
+type BidiClientSelectProfileTrees interface {
+	Send(*ingestv1.SelectProfileTreesRequest) error
+	Receive() (*ingestv1.SelectProfileTreesResponse, error)
+	CloseRequest() error
+	CloseResponse() error
+}
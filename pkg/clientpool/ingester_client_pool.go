@@ -89,3 +89,11 @@ func (c *ingesterPoolClient) MergeProfilesPprof(ctx context.Context) BidiClientM
 func (c *ingesterPoolClient) MergeSpanProfile(ctx context.Context) BidiClientMergeSpanProfile {
 	return c.IngesterServiceClient.MergeSpanProfile(ctx)
 }
+
+func (c *ingesterPoolClient) MergeProfilesTopFunctions(ctx context.Context) BidiClientMergeProfilesTopFunctions {
+	return c.IngesterServiceClient.MergeProfilesTopFunctions(ctx)
+}
+
+func (c *ingesterPoolClient) SelectProfileTrees(ctx context.Context) BidiClientSelectProfileTrees {
+	return c.IngesterServiceClient.SelectProfileTrees(ctx)
+}
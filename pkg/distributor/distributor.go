@@ -139,6 +139,7 @@ type Limits interface {
 	MaxSessionsPerSeries(tenantID string) int
 	EnforceLabelsOrder(tenantID string) bool
 	IngestionRelabelingRules(tenantID string) []*relabel.Config
+	IngestionStackFrameRules(tenantID string) []*pprof.StackFrameRule
 	DistributorUsageGroups(tenantID string) *validation.UsageGroupConfig
 	validation.ProfileValidationLimits
 	aggregator.Limits
@@ -391,11 +392,13 @@ func (d *Distributor) PushParsed(ctx context.Context, req *distributormodel.Push
 
 	// Normalisation is quite an expensive operation,
 	// therefore it should be done after the rate limit check.
+	stackFrameRules := d.limits.IngestionStackFrameRules(tenantID)
 	for _, series := range req.Series {
 		for _, sample := range series.Samples {
 			if series.Language == "go" {
 				sample.Profile.Profile = pprof.FixGoProfile(sample.Profile.Profile)
 			}
+			sample.Profile.Profile = pprof.RewriteStackFrames(sample.Profile.Profile, stackFrameRules)
 			sample.Profile.Normalize()
 		}
 	}
@@ -2558,6 +2558,66 @@ func TestPush_LabelRewrites(t *testing.T) {
 	}
 }
 
+func TestPush_StackFrameRules(t *testing.T) {
+	ing := newFakeIngester(t, false)
+	var rules validation.StackFrameRules
+	require.NoError(t, rules.Set(`[
+		{regex: "(.*)_[0-9]+"},
+		{action: drop, regex: "runtime\\..*"},
+		{action: truncate, regex: "handler"}
+	]`))
+	overrides := validation.MockOverrides(func(defaults *validation.Limits, tenantLimits map[string]*validation.Limits) {
+		l := validation.MockDefaultLimits()
+		l.IngestionStackFrameRules = rules
+		tenantLimits["user-1"] = l
+	})
+	d, err := New(Config{
+		DistributorRing: ringConfig,
+	}, testhelper.NewMockRing([]ring.InstanceDesc{
+		{Addr: "mock"},
+		{Addr: "mock"},
+		{Addr: "mock"},
+	}, 3), &poolFactory{f: func(addr string) (client.PoolClient, error) {
+		return ing, nil
+	}}, overrides, nil, log.NewLogfmtLogger(os.Stdout), nil)
+	require.NoError(t, err)
+
+	p := pproftesthelper.NewProfileBuilder(1000).CPUProfile()
+	p.ForStacktraceString("lambda_1", "runtime.call", "main").AddSamples(1)
+	p.ForStacktraceString("lambda_2", "runtime.call", "main").AddSamples(2)
+	p.ForStacktraceString("parse", "handler", "main").AddSamples(3)
+
+	ctx := tenant.InjectTenantID(context.Background(), "user-1")
+	_, err = d.PushParsed(ctx, &distributormodel.PushRequest{
+		Series: []*distributormodel.ProfileSeries{{
+			Labels:  p.Labels,
+			Samples: []*distributormodel.ProfileSample{{Profile: pprof2.RawFromProto(p.Profile)}},
+		}},
+	})
+	require.NoError(t, err)
+
+	ing.mtx.Lock()
+	defer ing.mtx.Unlock()
+	require.Len(t, ing.requests, 1)
+	var actual profilev1.Profile
+	require.NoError(t, pprof2.Unmarshal(ing.requests[0].Series[0].Samples[0].RawProfile, &actual))
+
+	stacks := make(map[string]int64)
+	for _, s := range actual.Sample {
+		var names []string
+		for _, id := range s.LocationId {
+			for _, line := range actual.Location[id-1].Line {
+				names = append(names, actual.StringTable[actual.Function[line.FunctionId-1].Name])
+			}
+		}
+		stacks[strings.Join(names, ";")] += s.Value[0]
+	}
+	assert.Equal(t, map[string]int64{
+		"lambda;main":  3,
+		"handler;main": 3,
+	}, stacks)
+}
+
 func TestDistributor_shouldSample(t *testing.T) {
 	tests := []struct {
 		name           string
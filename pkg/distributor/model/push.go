@@ -14,6 +14,9 @@ type RawProfileType string
 const RawProfileTypePPROF = RawProfileType("pprof")
 const RawProfileTypeJFR = RawProfileType("jfr")
 const RawProfileTypeOTEL = RawProfileType("otel")
+const RawProfileTypePerfScript = RawProfileType("perf_script")
+const RawProfileTypeChrome = RawProfileType("cpuprofile")
+const RawProfileTypeFirefox = RawProfileType("firefox")
 
 type PushRequest struct {
 	TenantID       string
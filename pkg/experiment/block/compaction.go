@@ -58,17 +58,28 @@ func WithSampleObserver(observer SampleObserver) CompactionOption {
 	}
 }
 
+// WithSeriesDeletions instructs the compactor to exclude
+// profiles of the deleted series from the output blocks.
+func WithSeriesDeletions(deletions *SeriesDeletions) CompactionOption {
+	return func(p *compactionConfig) {
+		p.seriesDeletions = deletions
+	}
+}
+
 type compactionConfig struct {
-	objectOptions  []ObjectOption
-	source         objstore.BucketReader
-	destination    objstore.Bucket
-	tempdir        string
-	sampleObserver SampleObserver
+	objectOptions   []ObjectOption
+	source          objstore.BucketReader
+	destination     objstore.Bucket
+	tempdir         string
+	sampleObserver  SampleObserver
+	seriesDeletions *SeriesDeletions
 }
 
 type SampleObserver interface {
-	// Observe is called before the compactor appends the entry
-	// to the output block. This method must not modify the entry.
+	// Observe is called before the compactor rewrites and appends
+	// the entry to the output block: stack trace identifiers refer
+	// to the source dataset symbols. This method must not modify
+	// the entry.
 	Observe(ProfileEntry)
 }
 
@@ -102,6 +113,7 @@ func Compact(
 
 	compacted := make([]*metastorev1.BlockMeta, 0, len(plan))
 	for _, p := range plan {
+		p.seriesDeletions = c.seriesDeletions
 		md, compactionErr := p.Compact(ctx, c.destination, c.tempdir, c.sampleObserver)
 		if compactionErr != nil {
 			return nil, compactionErr
@@ -175,6 +187,8 @@ type CompactionPlan struct {
 	meta         *metastorev1.BlockMeta
 	strings      *metadata.StringTable
 	datasetIndex *datasetIndexWriter
+
+	seriesDeletions *SeriesDeletions
 }
 
 func newBlockCompaction(
@@ -416,6 +430,10 @@ func (m *datasetCompaction) merge(ctx context.Context) (err error) {
 	defer func() {
 		err = multierror.New(err, rows.Close()).Err()
 	}()
+	var deletions *seriesDeletionFilter
+	if m.parent.seriesDeletions != nil {
+		deletions = newSeriesDeletionFilter(m.parent.seriesDeletions)
+	}
 	var i int
 	for rows.Next() {
 		if i++; i%1000 == 0 {
@@ -423,6 +441,9 @@ func (m *datasetCompaction) merge(ctx context.Context) (err error) {
 				return err
 			}
 		}
+		if deletions != nil && deletions.deleted(rows.At()) {
+			continue
+		}
 		if err = m.writeRow(rows.At()); err != nil {
 			return err
 		}
@@ -431,6 +452,11 @@ func (m *datasetCompaction) merge(ctx context.Context) (err error) {
 }
 
 func (m *datasetCompaction) writeRow(r ProfileEntry) (err error) {
+	if m.observer != nil {
+		// The entry is observed before rewriting, as the
+		// observer may need to resolve its stack traces.
+		m.observer.Observe(r)
+	}
 	if err = m.parent.datasetIndex.writeRow(r); err != nil {
 		return err
 	}
@@ -440,9 +466,6 @@ func (m *datasetCompaction) writeRow(r ProfileEntry) (err error) {
 	if err = m.symbolsRewriter.rewriteRow(r); err != nil {
 		return err
 	}
-	if m.observer != nil {
-		m.observer.Observe(r)
-	}
 	return m.profilesWriter.writeRow(r)
 }
 
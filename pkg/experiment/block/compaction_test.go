@@ -60,3 +60,50 @@ func Test_CompactBlocks(t *testing.T) {
 		require.Len(t, compactedBlocks[0].Datasets, 4)
 	})
 }
+
+type serviceNameObserver map[string]int
+
+func (o serviceNameObserver) Observe(e ProfileEntry) { o[e.Labels.Get("service_name")]++ }
+
+func Test_CompactBlocks_SeriesDeletions(t *testing.T) {
+	ctx := context.Background()
+	bucket, _ := testutil.NewFilesystemBucket(t, ctx, "testdata")
+
+	var resp metastorev1.GetBlockMetadataResponse
+	raw, err := os.ReadFile("testdata/block-metas.json")
+	require.NoError(t, err)
+	err = protojson.Unmarshal(raw, &resp)
+	require.NoError(t, err)
+
+	deletions, err := NewSeriesDeletions([]*metastorev1.SeriesDeletion{{
+		Id:            "1",
+		Tenant:        "anonymous",
+		LabelSelector: `{service_name="pyroscope-test/ingester"}`,
+		StartTime:     0,
+		EndTime:       1721060040000,
+		CreatedAt:     1721060040000,
+	}})
+	require.NoError(t, err)
+
+	compact := func(options ...CompactionOption) serviceNameObserver {
+		observer := make(serviceNameObserver)
+		dst, tempdir := testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+		options = append(options,
+			WithCompactionDestination(dst),
+			WithCompactionTempDir(tempdir),
+			WithSampleObserver(observer),
+		)
+		compacted, err := Compact(ctx, resp.Blocks, bucket, options...)
+		require.NoError(t, err)
+		require.Len(t, compacted, 1)
+		return observer
+	}
+
+	all := compact()
+	require.NotZero(t, all["pyroscope-test/ingester"])
+
+	filtered := compact(WithSeriesDeletions(deletions))
+	assert.Zero(t, filtered["pyroscope-test/ingester"])
+	delete(all, "pyroscope-test/ingester")
+	assert.Equal(t, all, filtered)
+}
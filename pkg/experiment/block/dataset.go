@@ -224,6 +224,12 @@ func (s *Dataset) section(sc Section) sectionDesc {
 	return f[sc]
 }
 
+// sectionStorage returns the storage the section is read from:
+// the objects storage may treat the sections differently.
+func (s *Dataset) sectionStorage(sc Section) objstore.BucketReader {
+	return objstore.ReaderWithSection(s.obj.storage, s.section(sc).name)
+}
+
 func (s *Dataset) sectionOffset(sc Section) int64 {
 	return int64(s.meta.TableOfContents[s.section(sc).index])
 }
@@ -34,6 +34,21 @@ func Sanitize(md *metastorev1.BlockMeta) error {
 	return err
 }
 
+// SeriesDeletionApplies reports whether the series deletion affects the
+// dataset of the block. Only blocks created before the deletion request
+// are affected: the block timestamp is preserved by compaction, therefore
+// the check holds for blocks rewritten without the deleted series.
+func SeriesDeletionApplies(d *metastorev1.SeriesDeletion, md *metastorev1.BlockMeta, ds *metastorev1.Dataset) bool {
+	if int(ds.Tenant) >= len(md.StringTable) || md.StringTable[ds.Tenant] != d.Tenant {
+		return false
+	}
+	if ds.MaxTime < d.StartTime || ds.MinTime > d.EndTime {
+		return false
+	}
+	id, err := ulid.Parse(md.Id)
+	return err == nil && int64(id.Time()) < d.CreatedAt
+}
+
 var stringTablePool = sync.Pool{
 	New: func() any { return NewStringTable() },
 }
@@ -240,3 +240,46 @@ func TestMetadata_EncodeDecode(t *testing.T) {
 	require.NoError(t, Decode(raw, &d))
 	assert.Equal(t, md, &d)
 }
+
+func TestMetadata_SeriesDeletionApplies(t *testing.T) {
+	md := &metastorev1.BlockMeta{
+		Id: ulid.MustNew(1000, bytes.NewReader(make([]byte, 16))).String(),
+		Datasets: []*metastorev1.Dataset{
+			{Tenant: 1, Name: 2, MinTime: 100, MaxTime: 200},
+		},
+		StringTable: []string{"", "tenant-a", "service_a"},
+	}
+	ds := md.Datasets[0]
+
+	for _, tc := range []struct {
+		name     string
+		deletion *metastorev1.SeriesDeletion
+		expected bool
+	}{
+		{
+			name:     "overlapping",
+			deletion: &metastorev1.SeriesDeletion{Tenant: "tenant-a", StartTime: 150, EndTime: 300, CreatedAt: 1001},
+			expected: true,
+		},
+		{
+			name:     "another tenant",
+			deletion: &metastorev1.SeriesDeletion{Tenant: "tenant-b", StartTime: 150, EndTime: 300, CreatedAt: 1001},
+		},
+		{
+			name:     "before the dataset",
+			deletion: &metastorev1.SeriesDeletion{Tenant: "tenant-a", StartTime: 0, EndTime: 99, CreatedAt: 1001},
+		},
+		{
+			name:     "after the dataset",
+			deletion: &metastorev1.SeriesDeletion{Tenant: "tenant-a", StartTime: 201, EndTime: 300, CreatedAt: 1001},
+		},
+		{
+			name:     "block created after the deletion",
+			deletion: &metastorev1.SeriesDeletion{Tenant: "tenant-a", StartTime: 150, EndTime: 300, CreatedAt: 1000},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SeriesDeletionApplies(tc.deletion, md, ds))
+		})
+	}
+}
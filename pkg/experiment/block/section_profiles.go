@@ -37,7 +37,7 @@ func openProfileTable(_ context.Context, s *Dataset) (err error) {
 			parquet.ReadBufferSize(4<<10))
 	} else {
 		s.profiles, err = openParquetFile(
-			s.obj.storage, s.obj.path, offset, size,
+			s.sectionStorage(SectionProfiles), s.obj.path, offset, size,
 			estimateFooterSize(size),
 			parquet.SkipBloomFilters(true),
 			parquet.FileReadMode(parquet.ReadModeAsync),
@@ -14,7 +14,7 @@ func openSymbols(ctx context.Context, s *Dataset) (err error) {
 		offset -= int64(s.offset())
 		s.symbols, err = symdb.OpenObject(ctx, s.inMemoryBucket(buf), s.obj.path, offset, size)
 	} else {
-		s.symbols, err = symdb.OpenObject(ctx, s.obj.storage, s.obj.path, offset, size,
+		s.symbols, err = symdb.OpenObject(ctx, s.sectionStorage(SectionSymbols), s.obj.path, offset, size,
 			symdb.WithPrefetchSize(symbolsPrefetchSize))
 	}
 	if err != nil {
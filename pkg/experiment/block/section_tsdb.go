@@ -27,7 +27,7 @@ func openTSDB(ctx context.Context, s *Dataset) (err error) {
 		s.tsdb.index, err = index.NewReader(index.RealByteSlice(buf[offset : offset+size]))
 	} else {
 		s.tsdb.buf = bufferpool.GetBuffer(int(size))
-		if err = objstore.ReadRange(ctx, s.tsdb.buf, s.obj.path, s.obj.storage, offset, size); err == nil {
+		if err = objstore.ReadRange(ctx, s.tsdb.buf, s.obj.path, s.sectionStorage(SectionTSDB), offset, size); err == nil {
 			s.tsdb.index, err = index.NewReader(index.RealByteSlice(s.tsdb.buf.B))
 		}
 	}
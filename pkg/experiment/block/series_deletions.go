@@ -0,0 +1,126 @@
+package block
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block/metadata"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+// SeriesDeletion is a parsed metastorev1.SeriesDeletion.
+type SeriesDeletion struct {
+	Matchers  []*labels.Matcher
+	StartTime int64 // Unix nano.
+	EndTime   int64 // Unix nano.
+
+	deletion *metastorev1.SeriesDeletion
+}
+
+// Contains reports whether the timestamp (Unix nano) is within
+// the deletion time range.
+func (d *SeriesDeletion) Contains(ts int64) bool {
+	return d.StartTime <= ts && ts <= d.EndTime
+}
+
+func (d *SeriesDeletion) Matches(ls phlaremodel.Labels) bool {
+	for _, m := range d.Matchers {
+		if !m.Matches(ls.Get(m.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// SeriesDeletions is a set of series deletions
+// that may affect the datasets being read.
+type SeriesDeletions struct {
+	deletions []*SeriesDeletion
+}
+
+// NewSeriesDeletions parses the series deletions.
+// If no deletions are provided, nil is returned.
+func NewSeriesDeletions(deletions []*metastorev1.SeriesDeletion) (*SeriesDeletions, error) {
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+	s := &SeriesDeletions{deletions: make([]*SeriesDeletion, 0, len(deletions))}
+	for _, d := range deletions {
+		matchers, err := parser.ParseMetricSelector(d.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid series deletion %s label selector: %w", d.Id, err)
+		}
+		s.deletions = append(s.deletions, &SeriesDeletion{
+			Matchers:  matchers,
+			StartTime: model.Time(d.StartTime).UnixNano(),
+			EndTime:   model.Time(d.EndTime).UnixNano(),
+			deletion:  d,
+		})
+	}
+	return s, nil
+}
+
+// Dataset returns the series deletions that apply to the dataset.
+func (s *SeriesDeletions) Dataset(ds *Dataset) []*SeriesDeletion {
+	if s == nil {
+		return nil
+	}
+	var applicable []*SeriesDeletion
+	for _, d := range s.deletions {
+		if metadata.SeriesDeletionApplies(d.deletion, ds.obj.meta, ds.meta) {
+			applicable = append(applicable, d)
+		}
+	}
+	return applicable
+}
+
+// seriesDeletionFilter reports whether the profile entry was deleted.
+// Matches are cached by the series fingerprint.
+type seriesDeletionFilter struct {
+	deletions *SeriesDeletions
+	datasets  map[*Dataset][]*SeriesDeletion
+	series    map[model.Fingerprint][]*SeriesDeletion
+}
+
+func newSeriesDeletionFilter(deletions *SeriesDeletions) *seriesDeletionFilter {
+	return &seriesDeletionFilter{
+		deletions: deletions,
+		datasets:  make(map[*Dataset][]*SeriesDeletion),
+		series:    make(map[model.Fingerprint][]*SeriesDeletion),
+	}
+}
+
+func (f *seriesDeletionFilter) deleted(e ProfileEntry) bool {
+	applicable, ok := f.datasets[e.Dataset]
+	if !ok {
+		applicable = f.deletions.Dataset(e.Dataset)
+		f.datasets[e.Dataset] = applicable
+	}
+	if len(applicable) == 0 {
+		return false
+	}
+	// A series may be deleted in one of the source
+	// datasets but not in another one, therefore we
+	// only cache label matches, which do not depend
+	// on the dataset.
+	matched, ok := f.series[e.Fingerprint]
+	if !ok {
+		for _, d := range f.deletions.deletions {
+			if d.Matches(e.Labels) {
+				matched = append(matched, d)
+			}
+		}
+		f.series[e.Fingerprint] = matched
+	}
+	for _, d := range matched {
+		if d.Contains(e.Timestamp) && slices.Contains(applicable, d) {
+			return true
+		}
+	}
+	return false
+}
@@ -83,6 +83,7 @@ type compactionJob struct {
 	done   atomic.Bool
 
 	blocks     []*metastorev1.BlockMeta
+	deletions  *block.SeriesDeletions
 	assignment *metastorev1.CompactionJobAssignment
 	compacted  *metastorev1.CompactedBlocks
 }
@@ -421,6 +422,10 @@ func (w *Worker) runCompaction(job *compactionJob) {
 		),
 	}
 
+	if job.deletions != nil {
+		options = append(options, block.WithSeriesDeletions(job.deletions))
+	}
+
 	if observer := w.buildSampleObserver(job.blocks[0]); observer != nil {
 		defer observer.Close()
 		options = append(options, block.WithSampleObserver(observer))
@@ -520,6 +525,11 @@ func (w *Worker) getBlockMetadata(logger log.Logger, job *compactionJob) error {
 		return err
 	}
 
+	if job.deletions, err = block.NewSeriesDeletions(resp.GetSeriesDeletions()); err != nil {
+		level.Error(logger).Log("msg", "invalid series deletions", "err", err)
+		return err
+	}
+
 	job.blocks = resp.GetBlocks()
 	// Update the plan to reflect the actual compaction job state.
 	job.SourceBlocks = job.SourceBlocks[:0]
@@ -54,6 +54,14 @@ func (i *IngesterHandlerPhlareDB) MergeSpanProfile(ctx context.Context, stream *
 	return phlaredb.MergeSpanProfile(ctx, stream, i.ForTimeRange)
 }
 
+func (i *IngesterHandlerPhlareDB) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return phlaredb.MergeProfilesTopFunctions(ctx, stream, i.ForTimeRange)
+}
+
+func (i *IngesterHandlerPhlareDB) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	return phlaredb.SelectProfileTrees(ctx, stream, i.ForTimeRange)
+}
+
 func (i *IngesterHandlerPhlareDB) Push(context.Context, *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.PushResponse], error) {
 	return nil, errors.New("not implemented")
 }
@@ -54,6 +54,9 @@ type segmentsWriter struct {
 	metrics      *segmentMetrics
 	headMetrics  *memdb.HeadMetrics
 	retryLimiter *retry.RateLimiter
+
+	spool   *segmentSpool
+	spoolWG sync.WaitGroup
 }
 
 type shard struct {
@@ -144,6 +147,22 @@ func newSegmentWriter(l log.Logger, metrics *segmentMetrics, hm *memdb.HeadMetri
 		flushWorkers = int(config.FlushConcurrency)
 	}
 	sw.pool.run(max(minFlushConcurrency, flushWorkers))
+	if config.SpoolDir != "" {
+		maxSize := config.SpoolMaxSize
+		if maxSize == 0 {
+			maxSize = defaultSpoolMaxSize
+		}
+		var err error
+		if sw.spool, err = openSegmentSpool(l, metrics, config.SpoolDir, maxSize); err != nil {
+			level.Error(l).Log("msg", "failed to open segment spool, spooling is disabled", "err", err)
+		} else {
+			sw.spoolWG.Add(1)
+			go func() {
+				defer sw.spoolWG.Done()
+				sw.runSpool(sw.ctx)
+			}()
+		}
+	}
 	return sw
 }
 
@@ -177,6 +196,7 @@ func (sw *segmentsWriter) stop() {
 		s.wg.Wait()
 	}
 	sw.pool.stop()
+	sw.spoolWG.Wait()
 	sw.logger.Log("msg", "segments writer stopped")
 }
 
@@ -243,10 +263,12 @@ func (s *segment) flush(ctx context.Context) (err error) {
 		return fmt.Errorf("failed to flush block %s: %w", s.ulid.String(), err)
 	}
 	if err = s.sw.uploadBlock(ctx, blockData, blockMeta, s); err != nil {
-		return fmt.Errorf("failed to upload block %s: %w", s.ulid.String(), err)
+		err = fmt.Errorf("failed to upload block %s: %w", s.ulid.String(), err)
+		return s.sw.spoolSegment(s, blockData, blockMeta, err)
 	}
 	if err = s.sw.storeMetadata(ctx, blockMeta, s); err != nil {
-		return fmt.Errorf("failed to store meta %s: %w", s.ulid.String(), err)
+		err = fmt.Errorf("failed to store meta %s: %w", s.ulid.String(), err)
+		return s.sw.spoolSegment(s, blockData, blockMeta, err)
 	}
 
 	return nil
@@ -20,6 +20,9 @@ type segmentMetrics struct {
 	flushHeadsDuration          *prometheus.HistogramVec
 	flushServiceHeadDuration    *prometheus.HistogramVec
 	flushServiceHeadError       *prometheus.CounterVec
+	spooledSegments             *prometheus.CounterVec
+	spoolUploads                *prometheus.CounterVec
+	spoolSizeBytes              prometheus.Gauge
 }
 
 var (
@@ -130,6 +133,25 @@ func newSegmentMetrics(reg prometheus.Registerer) *segmentMetrics {
 				Name:      "segment_head_size_bytes",
 				Buckets:   prometheus.ExponentialBucketsRange(10*1024, 100*1024*1024, 30),
 			}, []string{"shard", "tenant"}),
+
+		spooledSegments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "pyroscope",
+			Subsystem: "segment_writer",
+			Name:      "spooled_segments_total",
+			Help:      "Number of segments that failed to upload and were stored in the local spool.",
+		}, []string{"status"}),
+		spoolUploads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "pyroscope",
+			Subsystem: "segment_writer",
+			Name:      "spool_uploads_total",
+			Help:      "Number of attempts to upload segments from the local spool.",
+		}, []string{"status"}),
+		spoolSizeBytes: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "pyroscope",
+			Subsystem: "segment_writer",
+			Name:      "spool_size_bytes",
+			Help:      "Size of the segments stored in the local spool.",
+		}),
 	}
 
 	if reg != nil {
@@ -146,6 +168,9 @@ func newSegmentMetrics(reg prometheus.Registerer) *segmentMetrics {
 		reg.MustRegister(m.flushServiceHeadError)
 		reg.MustRegister(m.flushSegmentDuration)
 		reg.MustRegister(m.headSizeBytes)
+		reg.MustRegister(m.spooledSegments)
+		reg.MustRegister(m.spoolUploads)
+		reg.MustRegister(m.spoolSizeBytes)
 	}
 	return m
 }
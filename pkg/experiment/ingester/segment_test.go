@@ -27,6 +27,7 @@ import (
 	ingesterv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
 	"github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1/ingesterv1connect"
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block"
 	"github.com/grafana/pyroscope/pkg/experiment/ingester/memdb"
@@ -34,7 +35,10 @@ import (
 	"github.com/grafana/pyroscope/pkg/experiment/metastore"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/dlq"
 	metastoretest "github.com/grafana/pyroscope/pkg/experiment/metastore/test"
+	"github.com/grafana/pyroscope/pkg/experiment/query_backend"
+	"github.com/grafana/pyroscope/pkg/experiment/query_backend/query_plan"
 	"github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore"
 	"github.com/grafana/pyroscope/pkg/objstore/providers/filesystem"
 	"github.com/grafana/pyroscope/pkg/objstore/providers/memory"
 	"github.com/grafana/pyroscope/pkg/og/convert/pprof/bench"
@@ -365,6 +369,121 @@ func TestQueryMultipleSeriesSingleTenant(t *testing.T) {
 	require.Equal(t, expectedCollapsed, actualCollapsed)
 }
 
+func TestSelectMergeSpanProfileParity(t *testing.T) {
+	metas := make(chan *metastorev1.BlockMeta, 1)
+
+	sw := newTestSegmentWriter(t, defaultTestConfig())
+	defer sw.stop()
+	sw.client.On("AddBlock", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			metas <- args.Get(1).(*metastorev1.AddBlockRequest).Block
+		}).Return(new(metastorev1.AddBlockResponse), nil)
+
+	const (
+		spanA = "0000000000000001"
+		spanB = "0000000000000002"
+		spanC = "0000000000000003"
+	)
+	withSpan := func(p *pprofth.ProfileBuilder, span string) *pprofth.ProfileBuilder {
+		s := p.Sample[len(p.Sample)-1]
+		s.Label = append(s.Label, &profilev1.Label{Key: p.AddString("span_id"), Str: p.AddString(span)})
+		return p
+	}
+	p1 := withSpan(cpuProfile(10, 239, "svc1", "foo", "bar"), spanA)
+	withSpan(p1.ForStacktraceString("foo", "baz").AddSamples(20), spanB)
+	p1.ForStacktraceString("foo", "qux").AddSamples(30)
+	p2 := withSpan(cpuProfile(40, 420, "svc1", "foo", "bar"), spanB)
+	withSpan(p2.ForStacktraceString("foo", "baz").AddSamples(50), spanC)
+	p3 := withSpan(cpuProfile(60, 421, "svc2", "foo", "bar"), spanA)
+
+	sw.ingestChunk(t, inputChunk([]input{
+		{shard: 1, tenant: "tb", profile: p1},
+		{shard: 1, tenant: "tb", profile: p2},
+		{shard: 1, tenant: "tb", profile: p3},
+	}), false)
+	meta := <-metas
+
+	clients := sw.createBlocksFromMetas([]*metastorev1.BlockMeta{meta})
+	defer func() {
+		for _, tc := range clients {
+			tc.f()
+		}
+	}()
+
+	const profileType = "process_cpu:cpu:nanoseconds:cpu:nanoseconds"
+	reader := query_backend.NewBlockReader(test.NewTestingLogger(t), &objstore.ReaderAtBucket{Bucket: sw.bucket}, nil)
+	plan := query_plan.Build([]*metastorev1.BlockMeta{meta}, 10, 10)
+
+	for _, tc := range []struct {
+		name     string
+		selector string
+		spans    []string
+		expected string
+	}{
+		{
+			name:     "single span",
+			selector: `{service_name="svc1"}`,
+			spans:    []string{spanA},
+			expected: ";bar;foo 10\n",
+		},
+		{
+			name:     "multiple spans",
+			selector: `{service_name="svc1"}`,
+			spans:    []string{spanB, spanC},
+			expected: ";bar;foo 40\n;baz;foo 70\n",
+		},
+		{
+			name:     "multiple services",
+			selector: `{}`,
+			spans:    []string{spanA},
+			expected: ";bar;foo 70\n",
+		},
+		{
+			name:     "no matches",
+			selector: `{service_name="svc2"}`,
+			spans:    []string{spanC},
+			expected: "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v1Tree := sw.querySpans(clients["tb"], &ingesterv1.SelectSpanProfileRequest{
+				LabelSelector: tc.selector,
+				Type:          mustParseProfileSelector(t, profileType),
+				Start:         0,
+				End:           1000,
+				SpanSelector:  tc.spans,
+			})
+
+			labelSelector := strings.TrimSuffix(tc.selector, "}")
+			if labelSelector != "{" {
+				labelSelector += ","
+			}
+			labelSelector += fmt.Sprintf("%s=%q}", model.LabelNameProfileType, profileType)
+			resp, err := reader.Invoke(context.Background(), &queryv1.InvokeRequest{
+				Tenant:        []string{"tb"},
+				StartTime:     0,
+				EndTime:       1000,
+				LabelSelector: labelSelector,
+				QueryPlan:     plan,
+				Query: []*queryv1.Query{{
+					QueryType: queryv1.QueryType_QUERY_TREE,
+					Tree:      &queryv1.TreeQuery{SpanSelector: tc.spans},
+				}},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.Reports, 1)
+			v2Tree, err := model.UnmarshalTree(resp.Reports[0].Tree.Tree)
+			require.NoError(t, err)
+
+			var v1Collapsed, v2Collapsed strings.Builder
+			v1Tree.WriteCollapsed(&v1Collapsed)
+			v2Tree.WriteCollapsed(&v2Collapsed)
+			assert.Equal(t, tc.expected, v1Collapsed.String())
+			assert.Equal(t, v1Collapsed.String(), v2Collapsed.String())
+		})
+	}
+}
+
 func TestDLQRecoveryMock(t *testing.T) {
 	chunk := inputChunk([]input{
 		{shard: 1, tenant: "tb", profile: cpuProfile(42, 239, "svc1", "kek", "foo", "bar")},
@@ -677,6 +796,32 @@ func (sw *sw) query(tc tenantClient, q *ingesterv1.SelectProfilesRequest) *profi
 	return actualMerged
 }
 
+func (sw *sw) querySpans(tc tenantClient, q *ingesterv1.SelectSpanProfileRequest) *model.Tree {
+	t := sw.t
+	bidi := tc.client.MergeSpanProfile(context.Background())
+	require.NoError(t, bidi.Send(&ingesterv1.MergeSpanProfileRequest{Request: q}))
+
+	resp, err := bidi.Receive()
+	require.NoError(t, err)
+	require.NotNil(t, resp.SelectedProfiles)
+	require.NoError(t, bidi.Send(&ingesterv1.MergeSpanProfileRequest{
+		Profiles: slices.Repeat([]bool{true}, len(resp.SelectedProfiles.Profiles)),
+	}))
+
+	// expect empty resp to signal it is finished
+	resp, err = bidi.Receive()
+	require.NoError(t, err)
+	require.Nil(t, resp.SelectedProfiles)
+
+	resp, err = bidi.Receive()
+	require.NoError(t, err)
+	require.NotNil(t, resp.Result)
+
+	tree, err := model.UnmarshalTree(resp.Result.TreeBytes)
+	require.NoError(t, err)
+	return tree
+}
+
 // millis
 func getStartEndTime(profiles []*pprofth.ProfileBuilder) (int64, int64) {
 	start := profiles[0].TimeNanos
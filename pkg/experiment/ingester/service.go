@@ -54,6 +54,9 @@ type Config struct {
 	UploadHedgeRateBurst  uint                  `yaml:"upload-hedge_rate_burst,omitempty" category:"advanced"`
 	MetadataDLQEnabled    bool                  `yaml:"metadata_dlq_enabled,omitempty" category:"advanced"`
 	MetadataUpdateTimeout time.Duration         `yaml:"metadata_update_timeout,omitempty" category:"advanced"`
+	SpoolDir              string                `yaml:"spool_dir,omitempty" category:"advanced"`
+	SpoolMaxSize          uint64                `yaml:"spool_max_size_bytes,omitempty" category:"advanced"`
+	SpoolRetryInterval    time.Duration         `yaml:"spool_retry_interval,omitempty" category:"advanced"`
 }
 
 func (cfg *Config) Validate() error {
@@ -79,6 +82,9 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	f.UintVar(&cfg.UploadHedgeRateBurst, prefix+".upload-hedge-rate-burst", defaultHedgedRequestBurst, "Maximum number of hedged requests in a burst.")
 	f.BoolVar(&cfg.MetadataDLQEnabled, prefix+".metadata-dlq-enabled", true, "Enables dead letter queue (DLQ) for metadata. If the metadata update fails, it will be stored and updated asynchronously.")
 	f.DurationVar(&cfg.MetadataUpdateTimeout, prefix+".metadata-update-timeout", 2*time.Second, "Timeout for metadata update requests.")
+	f.StringVar(&cfg.SpoolDir, prefix+".spool-dir", "", "Directory where segments that failed to upload are stored, to be uploaded asynchronously, including after restart. If empty, such segments are discarded.")
+	f.Uint64Var(&cfg.SpoolMaxSize, prefix+".spool-max-size-bytes", defaultSpoolMaxSize, "Maximum size of the segments stored in the spool directory. Segments that do not fit are discarded.")
+	f.DurationVar(&cfg.SpoolRetryInterval, prefix+".spool-retry-interval", defaultSpoolRetryInterval, "How often to retry uploading the spooled segments.")
 }
 
 type Limits interface {
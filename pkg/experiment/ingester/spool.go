@@ -0,0 +1,295 @@
+package ingester
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+)
+
+// The spool persists segments that could not be uploaded to the object
+// store or registered in the metastore on the local disk, so that they
+// are re-uploaded later, including after restart.
+//
+// A spooled segment is stored as two files named after the segment ID:
+// the block data and the block metadata. The metadata file is written
+// last: a segment without metadata is incomplete and is removed when
+// the spool is opened.
+
+const (
+	spoolBlockSuffix = ".block"
+	spoolMetaSuffix  = ".meta"
+	spoolTempSuffix  = ".tmp"
+
+	defaultSpoolMaxSize       = 1 << 30
+	defaultSpoolRetryInterval = 30 * time.Second
+)
+
+var errSpoolFull = errors.New("segment spool is full")
+
+type segmentSpool struct {
+	dir     string
+	maxSize uint64
+	logger  log.Logger
+	metrics *segmentMetrics
+
+	mu   sync.Mutex
+	size uint64
+}
+
+func openSegmentSpool(logger log.Logger, metrics *segmentMetrics, dir string, maxSize uint64) (*segmentSpool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &segmentSpool{
+		dir:     dir,
+		maxSize: maxSize,
+		logger:  logger,
+		metrics: metrics,
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	complete := make(map[string]struct{})
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), spoolMetaSuffix); ok {
+			complete[id] = struct{}{}
+		}
+	}
+	for _, e := range entries {
+		name := e.Name()
+		id := strings.TrimSuffix(strings.TrimSuffix(name, spoolBlockSuffix), spoolMetaSuffix)
+		if _, ok := complete[id]; !ok || strings.HasSuffix(name, spoolTempSuffix) {
+			level.Warn(logger).Log("msg", "removing incomplete spooled segment", "file", name)
+			if err = os.Remove(filepath.Join(dir, name)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		s.size += uint64(info.Size())
+	}
+	s.metrics.spoolSizeBytes.Set(float64(s.size))
+	return s, nil
+}
+
+// store persists the segment. The call fails with errSpoolFull,
+// if the segment does not fit into the spool size limit.
+func (s *segmentSpool) store(data []byte, meta *metastorev1.BlockMeta) error {
+	metaBytes, err := meta.MarshalVT()
+	if err != nil {
+		return err
+	}
+	size := uint64(len(data) + len(metaBytes))
+	s.mu.Lock()
+	if s.size+size > s.maxSize {
+		s.mu.Unlock()
+		return errSpoolFull
+	}
+	s.size += size
+	s.metrics.spoolSizeBytes.Set(float64(s.size))
+	s.mu.Unlock()
+
+	if err = s.writeFile(meta.Id+spoolBlockSuffix, data); err == nil {
+		err = s.writeFile(meta.Id+spoolMetaSuffix, metaBytes)
+	}
+	if err != nil {
+		_ = os.Remove(filepath.Join(s.dir, meta.Id+spoolBlockSuffix))
+		s.release(size)
+		return err
+	}
+	return nil
+}
+
+func (s *segmentSpool) writeFile(name string, data []byte) error {
+	tmp := filepath.Join(s.dir, name+spoolTempSuffix)
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.dir, name))
+}
+
+// list returns the IDs of the spooled segments, ordered by time.
+func (s *segmentSpool) list() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), spoolMetaSuffix); ok {
+			ids = append(ids, id)
+		}
+	}
+	// Segment IDs are ULIDs.
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func (s *segmentSpool) load(id string) ([]byte, *metastorev1.BlockMeta, error) {
+	metaBytes, err := os.ReadFile(filepath.Join(s.dir, id+spoolMetaSuffix))
+	if err != nil {
+		return nil, nil, err
+	}
+	var meta metastorev1.BlockMeta
+	if err = meta.UnmarshalVT(metaBytes); err != nil {
+		return nil, nil, fmt.Errorf("decoding metadata: %w", err)
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, id+spoolBlockSuffix))
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, &meta, nil
+}
+
+// remove deletes the segment from the spool.
+// The metadata file is removed first.
+func (s *segmentSpool) remove(id string) error {
+	var size uint64
+	for _, name := range []string{id + spoolMetaSuffix, id + spoolBlockSuffix} {
+		path := filepath.Join(s.dir, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if err = os.Remove(path); err != nil {
+			return err
+		}
+		size += uint64(info.Size())
+	}
+	s.release(size)
+	return nil
+}
+
+func (s *segmentSpool) release(size uint64) {
+	s.mu.Lock()
+	s.size -= min(size, s.size)
+	s.metrics.spoolSizeBytes.Set(float64(s.size))
+	s.mu.Unlock()
+}
+
+// spoolSegment persists the segment that failed to upload or to be
+// registered. If the segment has been spooled, the error is discarded:
+// the segment is to be uploaded asynchronously.
+func (sw *segmentsWriter) spoolSegment(s *segment, data []byte, meta *metastorev1.BlockMeta, err error) error {
+	if sw.spool == nil {
+		return err
+	}
+	spoolErr := sw.spool.store(data, meta)
+	sw.metrics.spooledSegments.WithLabelValues(statusLabelValue(spoolErr)).Inc()
+	if spoolErr != nil {
+		level.Error(s.logger).Log("msg", "failed to spool segment", "err", spoolErr)
+		return err
+	}
+	level.Warn(s.logger).Log("msg", "segment spooled", "reason", err)
+	return nil
+}
+
+func (sw *segmentsWriter) runSpool(ctx context.Context) {
+	interval := sw.config.SpoolRetryInterval
+	if interval <= 0 {
+		interval = defaultSpoolRetryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		sw.uploadSpooled(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// uploadSpooled uploads and registers the spooled segments in order.
+// The first failure interrupts the call: the rest is to be uploaded
+// on the next attempt.
+func (sw *segmentsWriter) uploadSpooled(ctx context.Context) {
+	ids, err := sw.spool.list()
+	if err != nil {
+		level.Error(sw.logger).Log("msg", "failed to list spooled segments", "err", err)
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		err = sw.uploadSpooledSegment(ctx, id)
+		sw.metrics.spoolUploads.WithLabelValues(statusLabelValue(err)).Inc()
+		if err != nil {
+			level.Warn(sw.logger).Log("msg", "failed to upload spooled segment", "segment-id", id, "err", err)
+			return
+		}
+		level.Info(sw.logger).Log("msg", "uploaded spooled segment", "segment-id", id)
+	}
+}
+
+func (sw *segmentsWriter) uploadSpooledSegment(ctx context.Context, id string) error {
+	data, meta, err := sw.spool.load(id)
+	if err != nil {
+		// The segment can't be uploaded: there is no
+		// reason to keep it.
+		level.Error(sw.logger).Log("msg", "failed to load spooled segment", "segment-id", id, "err", err)
+		return sw.spool.remove(id)
+	}
+
+	uploadCtx := ctx
+	if sw.config.UploadTimeout > 0 {
+		var cancel context.CancelFunc
+		uploadCtx, cancel = context.WithTimeout(ctx, sw.config.UploadTimeout)
+		defer cancel()
+	}
+	if err = sw.bucket.Upload(uploadCtx, block.ObjectPath(meta), bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to upload block: %w", err)
+	}
+
+	mdCtx := ctx
+	if sw.config.MetadataUpdateTimeout > 0 {
+		var cancel context.CancelFunc
+		mdCtx, cancel = context.WithTimeout(ctx, sw.config.MetadataUpdateTimeout)
+		defer cancel()
+	}
+	if _, err = sw.metastore.AddBlock(mdCtx, &metastorev1.AddBlockRequest{Block: meta}); err != nil {
+		if !sw.config.MetadataDLQEnabled {
+			return fmt.Errorf("failed to store meta in metastore: %w", err)
+		}
+		err = sw.storeMetadataDLQ(ctx, meta)
+		sw.metrics.storeMetadataDLQ.WithLabelValues(statusLabelValue(err)).Inc()
+		if err != nil {
+			return fmt.Errorf("failed to store meta in DLQ: %w", err)
+		}
+	}
+
+	return sw.spool.remove(id)
+}
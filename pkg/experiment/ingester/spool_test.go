@@ -0,0 +1,146 @@
+package ingester
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+	"github.com/grafana/pyroscope/pkg/experiment/ingester/memdb"
+	"github.com/grafana/pyroscope/pkg/objstore/providers/memory"
+	"github.com/grafana/pyroscope/pkg/test"
+	"github.com/grafana/pyroscope/pkg/test/mocks/mockmetastorev1"
+	"github.com/grafana/pyroscope/pkg/test/mocks/mockobjstore"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func spoolTestConfig(dir string) Config {
+	cfg := defaultTestConfig()
+	cfg.UploadTimeout = 100 * time.Millisecond
+	cfg.SpoolDir = dir
+	cfg.SpoolRetryInterval = 50 * time.Millisecond
+	return cfg
+}
+
+func ingestSpoolTestProfile(sw *segmentsWriter) segmentWaitFlushed {
+	return sw.ingest(0, func(head segmentIngest) {
+		p := cpuProfile(42, 480, "svc1", "foo", "bar")
+		head.ingest("t1", p.Profile, p.UUID, p.Labels, p.Annotations)
+	})
+}
+
+func TestSegmentSpool(t *testing.T) {
+	dir := t.TempDir()
+	l := test.NewTestingLogger(t)
+
+	// The object store is not available: the segment is spooled,
+	// and the push succeeds.
+	unavailable := mockobjstore.NewMockBucket(t)
+	unavailable.On("Upload", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("mock upload error"))
+	sw := newSegmentWriter(
+		l,
+		newSegmentMetrics(nil),
+		memdb.NewHeadMetricsWithPrefix(nil, ""),
+		spoolTestConfig(dir),
+		validation.MockDefaultOverrides(),
+		unavailable,
+		mockmetastorev1.NewMockIndexServiceClient(t),
+	)
+	require.NoError(t, ingestSpoolTestProfile(sw).waitFlushed(context.Background()))
+	sw.stop()
+
+	spool, err := openSegmentSpool(l, newSegmentMetrics(nil), dir, defaultSpoolMaxSize)
+	require.NoError(t, err)
+	ids, err := spool.list()
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	assert.NotZero(t, spool.size)
+
+	// After restart, the segment is uploaded and registered.
+	bucket := memory.NewInMemBucket()
+	metas := make(chan *metastorev1.BlockMeta, 1)
+	client := mockmetastorev1.NewMockIndexServiceClient(t)
+	client.On("AddBlock", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			metas <- args.Get(1).(*metastorev1.AddBlockRequest).Block
+		}).Return(new(metastorev1.AddBlockResponse), nil)
+	sw = newSegmentWriter(
+		l,
+		newSegmentMetrics(nil),
+		memdb.NewHeadMetricsWithPrefix(nil, ""),
+		spoolTestConfig(dir),
+		validation.MockDefaultOverrides(),
+		bucket,
+		client,
+	)
+	defer sw.stop()
+
+	meta := <-metas
+	assert.Equal(t, ids[0], meta.Id)
+	assert.Len(t, meta.Datasets, 1)
+	_, err = bucket.Get(context.Background(), block.ObjectPath(meta))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		ids, err = sw.spool.list()
+		return err == nil && len(ids) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Zero(t, sw.spool.size)
+}
+
+func TestSegmentSpool_Full(t *testing.T) {
+	bucket := mockobjstore.NewMockBucket(t)
+	bucket.On("Upload", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("mock upload error"))
+	cfg := spoolTestConfig(t.TempDir())
+	cfg.SpoolMaxSize = 1
+	sw := newSegmentWriter(
+		test.NewTestingLogger(t),
+		newSegmentMetrics(nil),
+		memdb.NewHeadMetricsWithPrefix(nil, ""),
+		cfg,
+		validation.MockDefaultOverrides(),
+		bucket,
+		mockmetastorev1.NewMockIndexServiceClient(t),
+	)
+	defer sw.stop()
+
+	require.Error(t, ingestSpoolTestProfile(sw).waitFlushed(context.Background()))
+	ids, err := sw.spool.list()
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestSegmentSpool_RemovesIncomplete(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01J00000000000000000000001.block":     "block",
+		"01J00000000000000000000001.meta":      "",
+		"01J00000000000000000000002.block":     "incomplete",
+		"01J00000000000000000000003.block":     "block",
+		"01J00000000000000000000003.meta.tmp":  "incomplete",
+		"01J00000000000000000000004.block.tmp": "incomplete",
+	}
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644))
+	}
+
+	spool, err := openSegmentSpool(test.NewTestingLogger(t), newSegmentMetrics(nil), dir, defaultSpoolMaxSize)
+	require.NoError(t, err)
+	ids, err := spool.list()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01J00000000000000000000001"}, ids)
+	assert.Equal(t, uint64(len("block")), spool.size)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/status"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/metastorev1connect"
+)
+
+// TenantServiceHandler exposes the metastore tenant service over HTTP:
+// the service is otherwise only available via the metastore gRPC API.
+func (a *Admin) TenantServiceHandler() metastorev1connect.TenantServiceHandler {
+	return &tenantServiceHandler{client: a.metastoreClient}
+}
+
+type tenantServiceHandler struct {
+	client metastorev1.TenantServiceClient
+}
+
+func (h *tenantServiceHandler) GetTenant(
+	ctx context.Context,
+	req *connect.Request[metastorev1.GetTenantRequest],
+) (*connect.Response[metastorev1.GetTenantResponse], error) {
+	resp, err := h.client.GetTenant(ctx, req.Msg)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *tenantServiceHandler) DeleteTenant(
+	ctx context.Context,
+	req *connect.Request[metastorev1.DeleteTenantRequest],
+) (*connect.Response[metastorev1.DeleteTenantResponse], error) {
+	resp, err := h.client.DeleteTenant(ctx, req.Msg)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *tenantServiceHandler) DeleteSeries(
+	ctx context.Context,
+	req *connect.Request[metastorev1.DeleteSeriesRequest],
+) (*connect.Response[metastorev1.DeleteSeriesResponse], error) {
+	resp, err := h.client.DeleteSeries(ctx, req.Msg)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// connectError preserves the gRPC status code of the metastore response.
+func connectError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return connect.NewError(connect.Code(s.Code()), errors.New(s.Message()))
+	}
+	return err
+}
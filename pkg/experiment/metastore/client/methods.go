@@ -164,6 +164,12 @@ func (c *Client) DeleteTenant(ctx context.Context, in *metastorev1.DeleteTenantR
 	})
 }
 
+func (c *Client) DeleteSeries(ctx context.Context, in *metastorev1.DeleteSeriesRequest, opts ...grpc.CallOption) (*metastorev1.DeleteSeriesResponse, error) {
+	return invoke(ctx, c, func(ctx context.Context, instance instance) (*metastorev1.DeleteSeriesResponse, error) {
+		return instance.DeleteSeries(ctx, in, opts...)
+	})
+}
+
 func (c *Client) ReadIndex(ctx context.Context, in *raftnodepb.ReadIndexRequest, opts ...grpc.CallOption) (*raftnodepb.ReadIndexResponse, error) {
 	return invoke(ctx, c, func(ctx context.Context, instance instance) (*raftnodepb.ReadIndexResponse, error) {
 		return instance.ReadIndex(ctx, in, opts...)
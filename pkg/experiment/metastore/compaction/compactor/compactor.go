@@ -92,20 +92,45 @@ func (c *Compactor) UpdatePlan(tx *bbolt.Tx, plan *raft_log.CompactionPlanUpdate
 			level:  job.Plan.CompactionLevel,
 		}
 		staged := c.queue.blockQueue(k.level).stagedBlocks(k)
-		for _, b := range job.Plan.SourceBlocks {
-			e := staged.delete(b)
-			if e == zeroBlockEntry {
-				continue
-			}
-			if err := c.store.DeleteEntry(tx, e.index, e.id); err != nil {
-				return err
-			}
+		if err := c.deleteStaged(tx, staged, job.Plan.SourceBlocks...); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// DeleteBlocks removes the blocks from the compaction queue.
+// Blocks that are not present in the queue are ignored.
+func (c *Compactor) DeleteBlocks(tx *bbolt.Tx, tenant string, shard, level uint32, blocks ...string) error {
+	if int(level) >= len(c.queue.levels) || c.queue.levels[level] == nil {
+		return nil
+	}
+	k := compactionKey{
+		tenant: tenant,
+		shard:  shard,
+		level:  level,
+	}
+	staged, ok := c.queue.levels[level].staged[k]
+	if !ok {
+		return nil
+	}
+	return c.deleteStaged(tx, staged, blocks...)
+}
+
+func (c *Compactor) deleteStaged(tx *bbolt.Tx, staged *stagedBlocks, blocks ...string) error {
+	for _, b := range blocks {
+		e := staged.delete(b)
+		if e == zeroBlockEntry {
+			continue
+		}
+		if err := c.store.DeleteEntry(tx, e.index, e.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *Compactor) Init(tx *bbolt.Tx) error {
 	return c.store.CreateBuckets(tx)
 }
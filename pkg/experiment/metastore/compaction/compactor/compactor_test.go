@@ -126,3 +126,43 @@ func TestCompactor_Restore(t *testing.T) {
 	queueStore.AssertExpectations(t)
 	tombstones.AssertExpectations(t)
 }
+
+func TestCompactor_DeleteBlocks(t *testing.T) {
+	const N = 10
+
+	tombstones := new(mockcompactor.MockTombstones)
+	tombstones.On("ListTombstones", mock.Anything).
+		Return(iter.NewEmptyIterator[*metastorev1.Tombstones](), nil)
+	queueStore := new(mockcompactor.MockBlockQueueStore)
+	queueStore.On("StoreEntry", mock.Anything, mock.Anything).
+		Return(nil).Times(N)
+	queueStore.On("DeleteEntry", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Times(N)
+
+	compactor := NewCompactor(testConfig, queueStore, tombstones, nil)
+	now := time.Unix(0, 0)
+	blocks := make([]string, N)
+	for i := range blocks {
+		blocks[i] = strconv.Itoa(i)
+		err := compactor.Compact(nil, compaction.BlockEntry{
+			Index:      1,
+			AppendedAt: now.UnixNano(),
+			ID:         blocks[i],
+			Tenant:     "A",
+		})
+		require.NoError(t, err)
+	}
+
+	test.AssertIdempotent(t, func(t *testing.T) {
+		require.NoError(t, compactor.DeleteBlocks(nil, "A", 0, 0, blocks...))
+		require.NoError(t, compactor.DeleteBlocks(nil, "B", 0, 0, blocks...))
+		require.NoError(t, compactor.DeleteBlocks(nil, "A", 0, 5, blocks...))
+
+		planner := compactor.NewPlan(&raft.Log{Index: uint64(2), AppendedAt: now})
+		job, err := planner.CreateJob()
+		require.NoError(t, err)
+		require.Nil(t, job)
+	})
+
+	queueStore.AssertExpectations(t)
+}
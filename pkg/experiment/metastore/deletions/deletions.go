@@ -0,0 +1,124 @@
+package deletions
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/raft"
+	"go.etcd.io/bbolt"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block/metadata"
+)
+
+var ErrInvalidSeriesDeletion = errors.New("invalid series deletion")
+
+var seriesDeletionsBucketName = []byte("series_deletions")
+
+// SeriesDeletions keeps track of the series deletion requests.
+//
+// A deletion only affects blocks created before the deletion request:
+// the query backend filters out the deleted series, while compaction
+// physically removes them from the blocks. As compacted blocks inherit
+// the timestamp of the source blocks, we can't tell whether a block has
+// been rewritten; therefore, deletions remain effective until the tenant
+// is deleted. Filtering of the rewritten blocks is a no-op.
+//
+// The state is not cached in memory: all the methods read the store
+// directly, therefore it is safe to call the read methods in read-only
+// transactions concurrently with the FSM updates.
+//
+// Entries are stored in the tenant bucket, ordered by the raft index
+// of the command that created the deletion.
+type SeriesDeletions struct{}
+
+func NewSeriesDeletions() *SeriesDeletions { return new(SeriesDeletions) }
+
+func (x *SeriesDeletions) Init(tx *bbolt.Tx) error {
+	_, err := tx.CreateBucketIfNotExists(seriesDeletionsBucketName)
+	return err
+}
+
+func (x *SeriesDeletions) Restore(*bbolt.Tx) error { return nil }
+
+// AddDeletion stores the series deletion. The identifier and
+// the creation time are derived from the raft command.
+func (x *SeriesDeletions) AddDeletion(tx *bbolt.Tx, cmd *raft.Log, d *metastorev1.SeriesDeletion) error {
+	d.Id = strconv.FormatUint(cmd.Index, 10)
+	d.CreatedAt = cmd.AppendedAt.UnixMilli()
+	bucket, err := tx.Bucket(seriesDeletionsBucketName).CreateBucketIfNotExists([]byte(d.Tenant))
+	if err != nil {
+		return err
+	}
+	b, err := d.MarshalVT()
+	if err != nil {
+		return err
+	}
+	return bucket.Put(binary.BigEndian.AppendUint64(nil, cmd.Index), b)
+}
+
+// ListTenantDeletions returns all the series deletions of the tenant.
+func (x *SeriesDeletions) ListTenantDeletions(tx *bbolt.Tx, tenant string) ([]*metastorev1.SeriesDeletion, error) {
+	if tenant == "" {
+		return nil, nil
+	}
+	bucket := x.tenantBucket(tx, tenant)
+	if bucket == nil {
+		return nil, nil
+	}
+	var deletions []*metastorev1.SeriesDeletion
+	err := bucket.ForEach(func(k, v []byte) error {
+		d := new(metastorev1.SeriesDeletion)
+		if err := d.UnmarshalVT(v); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSeriesDeletion, err)
+		}
+		deletions = append(deletions, d)
+		return nil
+	})
+	return deletions, err
+}
+
+// ListBlockDeletions returns the series deletions that
+// apply to at least one dataset of the given blocks.
+func (x *SeriesDeletions) ListBlockDeletions(tx *bbolt.Tx, blocks ...*metastorev1.BlockMeta) ([]*metastorev1.SeriesDeletion, error) {
+	tenants := make(map[string][]*metastorev1.SeriesDeletion)
+	var applicable []*metastorev1.SeriesDeletion
+	seen := make(map[string]struct{})
+	for _, md := range blocks {
+		for _, ds := range md.Datasets {
+			tenant := md.StringTable[ds.Tenant]
+			deletions, ok := tenants[tenant]
+			if !ok {
+				var err error
+				if deletions, err = x.ListTenantDeletions(tx, tenant); err != nil {
+					return nil, err
+				}
+				tenants[tenant] = deletions
+			}
+			for _, d := range deletions {
+				if _, ok = seen[d.Id]; ok {
+					continue
+				}
+				if metadata.SeriesDeletionApplies(d, md, ds) {
+					seen[d.Id] = struct{}{}
+					applicable = append(applicable, d)
+				}
+			}
+		}
+	}
+	return applicable, nil
+}
+
+// DeleteTenant removes all the series deletions of the tenant.
+func (x *SeriesDeletions) DeleteTenant(tx *bbolt.Tx, tenant string) error {
+	if tenant == "" || x.tenantBucket(tx, tenant) == nil {
+		return nil
+	}
+	return tx.Bucket(seriesDeletionsBucketName).DeleteBucket([]byte(tenant))
+}
+
+func (x *SeriesDeletions) tenantBucket(tx *bbolt.Tx, tenant string) *bbolt.Bucket {
+	return tx.Bucket(seriesDeletionsBucketName).Bucket([]byte(tenant))
+}
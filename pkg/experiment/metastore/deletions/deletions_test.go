@@ -0,0 +1,85 @@
+package deletions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/test"
+)
+
+func TestSeriesDeletions(t *testing.T) {
+	db := test.BoltDB(t)
+	deletions := NewSeriesDeletions()
+	require.NoError(t, db.Update(deletions.Init))
+
+	createdAt := test.Time("2024-09-23T12:00:00.000Z")
+	add := func(index uint64, tenant string, start, end int64) {
+		cmd := &raft.Log{Index: index, AppendedAt: createdAt}
+		require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+			return deletions.AddDeletion(tx, cmd, &metastorev1.SeriesDeletion{
+				Tenant:        tenant,
+				LabelSelector: `{service_name="service-a"}`,
+				StartTime:     start,
+				EndTime:       end,
+			})
+		}))
+	}
+
+	list := func(tenant string) (ids []string) {
+		require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+			found, err := deletions.ListTenantDeletions(tx, tenant)
+			for _, d := range found {
+				ids = append(ids, d.Id)
+				assert.Equal(t, createdAt.UnixMilli(), d.CreatedAt)
+			}
+			return err
+		}))
+		return ids
+	}
+
+	minT := test.UnixMilli("2024-09-23T08:00:00.000Z")
+	maxT := test.UnixMilli("2024-09-23T09:00:00.000Z")
+	add(1, "tenant-a", minT, maxT)
+	add(2, "tenant-b", minT, maxT)
+	add(3, "tenant-a", maxT+1, maxT+2)
+	assert.Equal(t, []string{"1", "3"}, list("tenant-a"))
+	assert.Equal(t, []string{"2"}, list("tenant-b"))
+	assert.Empty(t, list("tenant-c"))
+
+	t.Run("ListBlockDeletions", func(t *testing.T) {
+		newBlock := func(id string, minT, maxT int64) *metastorev1.BlockMeta {
+			return &metastorev1.BlockMeta{
+				Id: id,
+				Datasets: []*metastorev1.Dataset{
+					{Tenant: 1, Name: 3, MinTime: minT, MaxTime: maxT},
+					{Tenant: 2, Name: 3, MinTime: minT, MaxTime: maxT},
+				},
+				StringTable: []string{"", "tenant-a", "tenant-c", "service-a"},
+			}
+		}
+		blocks := []*metastorev1.BlockMeta{
+			newBlock(test.ULID("2024-09-23T09:00:00.000Z"), minT, maxT),
+			newBlock(test.ULID("2024-09-23T09:00:00.001Z"), minT, maxT),
+			// Created after the deletion request.
+			newBlock(test.ULID("2024-09-23T12:00:00.001Z"), maxT, maxT+2),
+		}
+		require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+			found, err := deletions.ListBlockDeletions(tx, blocks...)
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+			assert.Equal(t, "1", found[0].Id)
+			return nil
+		}))
+	})
+
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		return deletions.DeleteTenant(tx, "tenant-a")
+	}))
+	assert.Empty(t, list("tenant-a"))
+	assert.Equal(t, []string{"2"}, list("tenant-b"))
+}
@@ -67,6 +67,7 @@ type Store interface {
 	CreateBuckets(*bbolt.Tx) error
 	ListPartitions(*bbolt.Tx) ([]*store.Partition, error)
 	LoadShard(*bbolt.Tx, store.PartitionKey, string, uint32) (*store.Shard, error)
+	DeleteShard(*bbolt.Tx, store.PartitionKey, string, uint32) error
 }
 
 type Index struct {
@@ -192,6 +193,105 @@ func (i *Index) ReplaceBlocks(tx *bbolt.Tx, compacted *metastorev1.CompactedBloc
 	return nil
 }
 
+// DeleteTenant removes all the tenant blocks from the oldest index
+// partition that includes the tenant. The function returns metadata
+// of the removed blocks, and whether there are more partitions that
+// include the tenant: the call should be repeated until there are none.
+func (i *Index) DeleteTenant(tx *bbolt.Tx, tenant string) ([]*metastorev1.BlockMeta, bool, error) {
+	return i.deleteTenantPartition(tx, tenant, func(*store.Partition) bool { return true })
+}
+
+// TruncateTenant removes all the tenant blocks from the oldest index
+// partition that includes the tenant and ends before the given time.
+// The function returns metadata of the removed blocks, and whether
+// there are more such partitions.
+func (i *Index) TruncateTenant(tx *bbolt.Tx, tenant string, before time.Time) ([]*metastorev1.BlockMeta, bool, error) {
+	return i.deleteTenantPartition(tx, tenant, func(p *store.Partition) bool {
+		return !p.EndTime().After(before)
+	})
+}
+
+func (i *Index) deleteTenantPartition(
+	tx *bbolt.Tx,
+	tenant string,
+	match func(*store.Partition) bool,
+) ([]*metastorev1.BlockMeta, bool, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var p *store.Partition
+	var more bool
+	for _, x := range i.partitions {
+		if !x.HasTenant(tenant) || !match(x) {
+			continue
+		}
+		if p != nil {
+			more = true
+			break
+		}
+		p = x
+	}
+	if p == nil {
+		return nil, false, nil
+	}
+
+	// The order must be deterministic, as the function
+	// is called on all the replicas as part of the FSM.
+	shards := maps.Keys(p.TenantShards[tenant])
+	slices.Sort(shards)
+
+	var deleted []*metastorev1.BlockMeta
+	for _, shard := range shards {
+		s, err := i.store.LoadShard(tx, p.Key, tenant, shard)
+		if err != nil {
+			return nil, false, err
+		}
+		if s != nil {
+			blocks, err := listShardBlocks(tx, s)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, b := range blocks {
+				i.blocks.delete(s, b.Id)
+			}
+			deleted = append(deleted, blocks...)
+		}
+		if err = i.store.DeleteShard(tx, p.Key, tenant, shard); err != nil {
+			return nil, false, err
+		}
+		i.shards.delete(p.Key, tenant, shard)
+	}
+
+	delete(p.TenantShards, tenant)
+	if len(p.TenantShards) == 0 {
+		i.partitions = slices.DeleteFunc(i.partitions, func(x *store.Partition) bool {
+			return x == p
+		})
+	}
+
+	return deleted, more, nil
+}
+
+func listShardBlocks(tx *bbolt.Tx, s *store.Shard) ([]*metastorev1.BlockMeta, error) {
+	blocks := s.Blocks(tx)
+	if blocks == nil {
+		return nil, nil
+	}
+	defer func() {
+		_ = blocks.Close()
+	}()
+	var metas []*metastorev1.BlockMeta
+	for blocks.Next() {
+		var md metastorev1.BlockMeta
+		if err := md.UnmarshalVT(blocks.At().Value); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal block %q: %w", blocks.At().Key, err)
+		}
+		s.StringTable.Export(&md)
+		metas = append(metas, &md)
+	}
+	return metas, blocks.Err()
+}
+
 func (i *Index) GetBlocks(tx *bbolt.Tx, list *metastorev1.BlockList) ([]*metastorev1.BlockMeta, error) {
 	metas := make([]*metastorev1.BlockMeta, 0, len(list.Blocks))
 	for k, partitioned := range i.partitionedList(list) {
@@ -243,6 +343,25 @@ func (i *Index) GetTenantStats(tenant string) *metastorev1.TenantStats {
 	return stats
 }
 
+// ListTenants returns the sorted list of tenants that have blocks in
+// the index. Anonymous tenant (blocks shared by multiple tenants, such
+// as segments) is not included.
+func (i *Index) ListTenants() []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	tenants := make(map[string]struct{})
+	for _, p := range i.partitions {
+		for t := range p.TenantShards {
+			if t != "" {
+				tenants[t] = struct{}{}
+			}
+		}
+	}
+	list := maps.Keys(tenants)
+	slices.Sort(list)
+	return list
+}
+
 func (i *Index) QueryMetadata(tx *bbolt.Tx, query MetadataQuery) ([]*metastorev1.BlockMeta, error) {
 	q, err := newMetadataQuery(i, query)
 	if err != nil {
@@ -0,0 +1,170 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/pkg/test"
+)
+
+func TestIndex_DeleteTenant(t *testing.T) {
+	db := test.BoltDB(t)
+
+	minT := test.UnixMilli("2024-09-23T08:00:00.000Z")
+	maxT := test.UnixMilli("2024-09-23T09:00:00.000Z")
+	newBlock := func(id string, tenant string, shard, level uint32) *metastorev1.BlockMeta {
+		return &metastorev1.BlockMeta{
+			Id:              id,
+			Tenant:          1,
+			Shard:           shard,
+			CompactionLevel: level,
+			MinTime:         minT,
+			MaxTime:         maxT,
+			CreatedBy:       2,
+			Datasets: []*metastorev1.Dataset{
+				{Tenant: 1, Name: 3, MinTime: minT, MaxTime: maxT, Labels: []int32{1, 4, 3}},
+			},
+			StringTable: []string{"", tenant, "ingester", "dataset-a", "service_name"},
+		}
+	}
+
+	blocks := []*metastorev1.BlockMeta{
+		newBlock(test.ULID("2024-09-23T08:00:00.001Z"), "tenant-a", 1, 1),
+		newBlock(test.ULID("2024-09-23T08:00:00.002Z"), "tenant-a", 2, 1),
+		newBlock(test.ULID("2024-09-23T08:00:00.003Z"), "tenant-b", 1, 1),
+		newBlock(test.ULID("2024-09-23T20:00:00.001Z"), "tenant-a", 1, 2),
+	}
+
+	index := NewIndex(log.NewNopLogger(), NewStore(), DefaultConfig)
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		if err := index.Init(tx); err != nil {
+			return err
+		}
+		for _, b := range blocks {
+			if err := index.InsertBlock(tx, b.CloneVT()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	deleteTenant := func(tenant string) (ids []string, more bool) {
+		require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+			deleted, m, err := index.DeleteTenant(tx, tenant)
+			for _, b := range deleted {
+				ids = append(ids, b.Id)
+			}
+			more = m
+			return err
+		}))
+		return ids, more
+	}
+
+	deleted, more := deleteTenant("tenant-a")
+	assert.Equal(t, []string{blocks[0].Id, blocks[1].Id}, deleted)
+	assert.True(t, more)
+
+	deleted, more = deleteTenant("tenant-a")
+	assert.Equal(t, []string{blocks[3].Id}, deleted)
+	assert.False(t, more)
+
+	deleted, more = deleteTenant("tenant-a")
+	assert.Empty(t, deleted)
+	assert.False(t, more)
+
+	assert.Equal(t, new(metastorev1.TenantStats), index.GetTenantStats("tenant-a"))
+	assert.True(t, index.GetTenantStats("tenant-b").DataIngested)
+
+	// The state must survive restoration.
+	restored := NewIndex(log.NewNopLogger(), NewStore(), DefaultConfig)
+	require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+		if err := restored.Restore(tx); err != nil {
+			return err
+		}
+		found, err := restored.QueryMetadata(tx, MetadataQuery{
+			Expr:      `{}`,
+			StartTime: time.UnixMilli(minT),
+			EndTime:   time.UnixMilli(maxT),
+			Tenant:    []string{"tenant-a", "tenant-b"},
+		})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, blocks[2].Id, found[0].Id)
+		return nil
+	}))
+	assert.Equal(t, new(metastorev1.TenantStats), restored.GetTenantStats("tenant-a"))
+}
+
+func TestIndex_TruncateTenant(t *testing.T) {
+	db := test.BoltDB(t)
+
+	newBlock := func(id string, size uint64) *metastorev1.BlockMeta {
+		return &metastorev1.BlockMeta{
+			Id:              id,
+			Tenant:          1,
+			CompactionLevel: 1,
+			Size:            size,
+			StringTable:     []string{"", "tenant-a"},
+		}
+	}
+
+	blocks := []*metastorev1.BlockMeta{
+		newBlock(test.ULID("2024-09-23T01:00:00.001Z"), 1),
+		newBlock(test.ULID("2024-09-23T07:00:00.001Z"), 2),
+		newBlock(test.ULID("2024-09-23T13:00:00.001Z"), 3),
+	}
+
+	index := NewIndex(log.NewNopLogger(), NewStore(), DefaultConfig)
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		if err := index.Init(tx); err != nil {
+			return err
+		}
+		for _, b := range blocks {
+			if err := index.InsertBlock(tx, b.CloneVT()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	assert.Equal(t, []string{"tenant-a"}, index.ListTenants())
+
+	truncate := func(before time.Time) (ids []string, more bool) {
+		require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+			deleted, m, err := index.TruncateTenant(tx, "tenant-a", before)
+			for _, b := range deleted {
+				ids = append(ids, b.Id)
+			}
+			more = m
+			return err
+		}))
+		return ids, more
+	}
+
+	// The second partition (06:00-12:00) ends after the retention start.
+	before := test.Time("2024-09-23T11:00:00.000Z")
+	deleted, more := truncate(before)
+	assert.Equal(t, []string{blocks[0].Id}, deleted)
+	assert.False(t, more)
+
+	deleted, more = truncate(before)
+	assert.Empty(t, deleted)
+	assert.False(t, more)
+
+	before = test.Time("2024-09-24T00:00:00.000Z")
+	deleted, more = truncate(before)
+	assert.Equal(t, []string{blocks[1].Id}, deleted)
+	assert.True(t, more)
+
+	deleted, more = truncate(before)
+	assert.Equal(t, []string{blocks[2].Id}, deleted)
+	assert.False(t, more)
+
+	assert.Empty(t, index.ListTenants())
+}
@@ -189,6 +189,47 @@ func getOrCreateTenantShard(tx *bbolt.Tx, p PartitionKey, tenant string, shard u
 	return tenantShard, nil
 }
 
+// DeleteShard removes the tenant shard from the partition, including
+// all the blocks and the string table. Tenant and partition buckets
+// are removed as well, if they become empty.
+func (m *IndexStore) DeleteShard(tx *bbolt.Tx, p PartitionKey, tenant string, shard uint32) error {
+	partitions := getPartitionsBucket(tx)
+	partitionKey := p.Bytes()
+	partition := partitions.Bucket(partitionKey)
+	if partition == nil {
+		return nil
+	}
+	tenantKey := tenantBucketName(tenant)
+	shards := partition.Bucket(tenantKey)
+	if shards == nil {
+		return nil
+	}
+	shardKey := binary.BigEndian.AppendUint32(nil, shard)
+	if shards.Bucket(shardKey) != nil {
+		if err := shards.DeleteBucket(shardKey); err != nil {
+			return fmt.Errorf("error deleting shard %d of tenant %s in partition %s: %w", shard, tenant, p, err)
+		}
+	}
+	if !isEmptyBucket(shards) {
+		return nil
+	}
+	if err := partition.DeleteBucket(tenantKey); err != nil {
+		return fmt.Errorf("error deleting tenant %s bucket in partition %s: %w", tenant, p, err)
+	}
+	if !isEmptyBucket(partition) {
+		return nil
+	}
+	if err := partitions.DeleteBucket(partitionKey); err != nil {
+		return fmt.Errorf("error deleting partition %s: %w", p, err)
+	}
+	return nil
+}
+
+func isEmptyBucket(b *bbolt.Bucket) bool {
+	k, _ := b.Cursor().First()
+	return k == nil
+}
+
 func (s *Shard) Store(tx *bbolt.Tx, md *metastorev1.BlockMeta) error {
 	bucket, err := getOrCreateTenantShard(tx, s.Partition, s.Tenant, s.Shard)
 	if err != nil {
@@ -32,24 +32,27 @@ func NewIndexService(
 	state State,
 	index IndexBlockFinder,
 	stats PlacementStats,
+	deletions SeriesDeletionFinder,
 ) *IndexService {
 	return &IndexService{
-		logger: logger,
-		raft:   raft,
-		state:  state,
-		index:  index,
-		stats:  stats,
+		logger:    logger,
+		raft:      raft,
+		state:     state,
+		index:     index,
+		stats:     stats,
+		deletions: deletions,
 	}
 }
 
 type IndexService struct {
 	metastorev1.IndexServiceServer
 
-	logger log.Logger
-	raft   Raft
-	state  State
-	index  IndexBlockFinder
-	stats  PlacementStats
+	logger    log.Logger
+	raft      Raft
+	state     State
+	index     IndexBlockFinder
+	stats     PlacementStats
+	deletions SeriesDeletionFinder
 }
 
 func (svc *IndexService) AddBlock(
@@ -108,7 +111,12 @@ func (svc *IndexService) getBlockMetadata(tx *bbolt.Tx, list *metastorev1.BlockL
 	if err != nil {
 		return nil, err
 	}
-	return &metastorev1.GetBlockMetadataResponse{Blocks: found}, nil
+	// Compaction removes the deleted series from the blocks.
+	deletions, err := svc.deletions.ListBlockDeletions(tx, found...)
+	if err != nil {
+		return nil, err
+	}
+	return &metastorev1.GetBlockMetadataResponse{Blocks: found, SeriesDeletions: deletions}, nil
 }
 
 func statsFromMetadata(md *metastorev1.BlockMeta) iter.Iterator[placement.Sample] {
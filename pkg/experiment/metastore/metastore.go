@@ -21,11 +21,13 @@ import (
 	placement "github.com/grafana/pyroscope/pkg/experiment/distributor/placement/adaptive_placement"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/compaction/compactor"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/compaction/scheduler"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/deletions"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/dlq"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/fsm"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/index"
 	raft "github.com/grafana/pyroscope/pkg/experiment/metastore/raftnode"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/raftnode/raftnodepb"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/retention"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/tombstones"
 	"github.com/grafana/pyroscope/pkg/util/health"
 )
@@ -40,6 +42,7 @@ type Config struct {
 	DLQRecovery      dlq.RecoveryConfig `yaml:",inline" category:"advanced"`
 	Compactor        compactor.Config   `yaml:",inline" category:"advanced"`
 	Scheduler        scheduler.Config   `yaml:",inline" category:"advanced"`
+	Retention        retention.Config   `yaml:",inline" category:"advanced"`
 }
 
 func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
@@ -53,6 +56,7 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	cfg.Scheduler.RegisterFlagsWithPrefix(prefix, f)
 	cfg.Index.RegisterFlagsWithPrefix(prefix+"index.", f)
 	cfg.DLQRecovery.RegisterFlagsWithPrefix(prefix, f)
+	cfg.Retention.RegisterFlagsWithPrefix(prefix, f)
 }
 
 func (cfg *Config) Validate() error {
@@ -79,6 +83,8 @@ type Metastore struct {
 	bucket      objstore.Bucket
 	placement   *placement.Manager
 	dlqRecovery *dlq.Recovery
+	retention   *retention.Enforcer
+	overrides   retention.Overrides
 
 	index        *index.Index
 	indexHandler *IndexCommandHandler
@@ -90,6 +96,9 @@ type Metastore struct {
 	compactionHandler *CompactionCommandHandler
 	compactionService *CompactionService
 
+	deletions     *deletions.SeriesDeletions
+	tenantHandler *TenantCommandHandler
+
 	followerRead    *raft.StateReader[*bbolt.Tx]
 	tenantService   *TenantService
 	metadataService *MetadataQueryService
@@ -106,6 +115,7 @@ func New(
 	client raftnodepb.RaftNodeServiceClient,
 	bucket objstore.Bucket,
 	placementMgr *placement.Manager,
+	overrides retention.Overrides,
 ) (*Metastore, error) {
 	m := &Metastore{
 		config:    config,
@@ -114,6 +124,7 @@ func New(
 		health:    healthService,
 		bucket:    bucket,
 		placement: placementMgr,
+		overrides: overrides,
 	}
 
 	var err error
@@ -126,6 +137,7 @@ func New(
 	m.tombstones = tombstones.NewTombstones(tombstones.NewStore())
 	m.compactor = compactor.NewCompactor(config.Compactor, compactor.NewStore(), m.tombstones, m.reg)
 	m.scheduler = scheduler.NewScheduler(config.Scheduler, scheduler.NewStore(), m.reg)
+	m.deletions = deletions.NewSeriesDeletions()
 
 	// FSM handlers that utilize the components.
 	m.indexHandler = NewIndexCommandHandler(m.logger, m.index, m.tombstones, m.compactor)
@@ -141,10 +153,22 @@ func New(
 		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_UPDATE_COMPACTION_PLAN),
 		m.compactionHandler.UpdateCompactionPlan)
 
+	m.tenantHandler = NewTenantCommandHandler(m.logger, m.index, m.compactor, m.tombstones, m.deletions)
+	fsm.RegisterRaftCommandHandler(m.fsm,
+		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_DELETE_TENANT),
+		m.tenantHandler.DeleteTenant)
+	fsm.RegisterRaftCommandHandler(m.fsm,
+		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_TRUNCATE_INDEX),
+		m.tenantHandler.TruncateIndex)
+	fsm.RegisterRaftCommandHandler(m.fsm,
+		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_DELETE_SERIES),
+		m.tenantHandler.DeleteSeries)
+
 	m.fsm.RegisterRestorer(m.tombstones)
 	m.fsm.RegisterRestorer(m.compactor)
 	m.fsm.RegisterRestorer(m.scheduler)
 	m.fsm.RegisterRestorer(m.index)
+	m.fsm.RegisterRestorer(m.deletions)
 
 	// We are ready to start raft as our FSM is fully configured.
 	if err = m.buildRaftNode(); err != nil {
@@ -160,16 +184,18 @@ func New(
 	// Services should be registered after FSM and Raft have been initialized.
 	// Services provide an interface to interact with the metastore.
 	m.compactionService = NewCompactionService(m.logger, m.raft)
-	m.indexService = NewIndexService(m.logger, m.raft, m.followerRead, m.index, m.placement)
-	m.tenantService = NewTenantService(m.logger, m.followerRead, m.index)
-	m.metadataService = NewMetadataQueryService(m.logger, m.followerRead, m.index)
+	m.indexService = NewIndexService(m.logger, m.raft, m.followerRead, m.index, m.placement, m.deletions)
+	m.tenantService = NewTenantService(m.logger, m.raft, m.followerRead, m.index, m.tombstones)
+	m.metadataService = NewMetadataQueryService(m.logger, m.followerRead, m.index, m.deletions)
 	m.dlqRecovery = dlq.NewRecovery(logger, config.DLQRecovery, m.indexService, bucket)
+	m.retention = retention.NewEnforcer(logger, config.Retention, m.reg, m.overrides, m.index, m.raft)
 
 	// These are the services that only run on the raft leader.
 	// Keep in mind that the node may not be the leader at the moment the
 	// service is starting, so it should be able to handle conflicts.
 	m.raft.RunOnLeader(m.dlqRecovery)
 	m.raft.RunOnLeader(m.placement)
+	m.raft.RunOnLeader(m.retention)
 
 	m.service = services.NewBasicService(m.starting, m.running, m.stopping)
 	return m, nil
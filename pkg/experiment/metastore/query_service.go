@@ -22,23 +22,30 @@ type IndexQuerier interface {
 	QueryMetadataLabels(*bbolt.Tx, index.MetadataQuery) ([]*typesv1.Labels, error)
 }
 
+type SeriesDeletionFinder interface {
+	ListBlockDeletions(*bbolt.Tx, ...*metastorev1.BlockMeta) ([]*metastorev1.SeriesDeletion, error)
+}
+
 type MetadataQueryService struct {
 	metastorev1.MetadataQueryServiceServer
 
-	logger log.Logger
-	state  State
-	index  IndexQuerier
+	logger    log.Logger
+	state     State
+	index     IndexQuerier
+	deletions SeriesDeletionFinder
 }
 
 func NewMetadataQueryService(
 	logger log.Logger,
 	state State,
 	index IndexQuerier,
+	deletions SeriesDeletionFinder,
 ) *MetadataQueryService {
 	return &MetadataQueryService{
-		logger: logger,
-		state:  state,
-		index:  index,
+		logger:    logger,
+		state:     state,
+		index:     index,
+		deletions: deletions,
 	}
 }
 
@@ -68,7 +75,10 @@ func (svc *MetadataQueryService) queryMetadata(
 		Labels:    req.Labels,
 	})
 	if err == nil {
-		return &metastorev1.QueryMetadataResponse{Blocks: metas}, nil
+		var deletions []*metastorev1.SeriesDeletion
+		if deletions, err = svc.deletions.ListBlockDeletions(tx, metas...); err == nil {
+			return &metastorev1.QueryMetadataResponse{Blocks: metas, SeriesDeletions: deletions}, nil
+		}
 	}
 	var invalid *index.InvalidQueryError
 	if errors.As(err, &invalid) {
@@ -0,0 +1,31 @@
+package retention
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/pyroscope/pkg/util"
+)
+
+type metrics struct {
+	deletedBlocks *prometheus.CounterVec
+	deletedBytes  *prometheus.CounterVec
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	m := &metrics{
+		deletedBlocks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "retention_deleted_blocks_total",
+			Help: "The total number of blocks removed from the index due to the retention policy.",
+		}, []string{"tenant"}),
+
+		deletedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "retention_deleted_bytes_total",
+			Help: "The total size of blocks removed from the index due to the retention policy.",
+		}, []string{"tenant"}),
+	}
+	if reg != nil {
+		m.deletedBlocks = util.RegisterOrGet(reg, m.deletedBlocks)
+		m.deletedBytes = util.RegisterOrGet(reg, m.deletedBytes)
+	}
+	return m
+}
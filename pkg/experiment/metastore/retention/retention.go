@@ -0,0 +1,168 @@
+package retention
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/raft_log"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/fsm"
+)
+
+type Config struct {
+	CheckInterval time.Duration `yaml:"retention_check_interval"`
+}
+
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
+	f.DurationVar(&c.CheckInterval, prefix+"retention-check-interval", time.Hour, "Interval between retention policy checks. Blocks that are beyond the tenant retention period are removed from the index and deleted from the storage. 0 to disable.")
+}
+
+// Overrides provides the per-tenant retention period.
+// Zero retention period means that the data is kept forever.
+type Overrides interface {
+	CompactorBlocksRetentionPeriod(tenant string) time.Duration
+}
+
+type Index interface {
+	ListTenants() []string
+}
+
+type Raft interface {
+	Propose(fsm.RaftLogEntryType, proto.Message) (proto.Message, error)
+}
+
+// Enforcer periodically proposes the index truncation according to the
+// tenant retention policy. Blocks removed from the index are deleted from
+// the storage by the compaction workers.
+//
+// Enforcer must only run on the raft leader.
+type Enforcer struct {
+	config    Config
+	logger    log.Logger
+	overrides Overrides
+	index     Index
+	raft      Raft
+	metrics   *metrics
+	now       func() time.Time
+
+	m       sync.Mutex
+	started bool
+	cancel  func()
+	wg      sync.WaitGroup
+}
+
+func NewEnforcer(
+	logger log.Logger,
+	config Config,
+	reg prometheus.Registerer,
+	overrides Overrides,
+	index Index,
+	raft Raft,
+) *Enforcer {
+	return &Enforcer{
+		config:    config,
+		logger:    logger,
+		overrides: overrides,
+		index:     index,
+		raft:      raft,
+		metrics:   newMetrics(reg),
+		now:       time.Now,
+	}
+}
+
+func (e *Enforcer) Start() {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if e.started {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	e.started = true
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.loop(ctx)
+	}()
+	level.Info(e.logger).Log("msg", "retention enforcer started")
+}
+
+func (e *Enforcer) Stop() {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if !e.started {
+		return
+	}
+	e.cancel()
+	e.wg.Wait()
+	e.started = false
+	level.Info(e.logger).Log("msg", "retention enforcer stopped")
+}
+
+func (e *Enforcer) loop(ctx context.Context) {
+	if e.config.CheckInterval == 0 {
+		return
+	}
+	ticker := time.NewTicker(e.config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.enforce(ctx); err != nil {
+				level.Error(e.logger).Log("msg", "failed to enforce retention policy", "err", err)
+			}
+		}
+	}
+}
+
+func (e *Enforcer) enforce(ctx context.Context) error {
+	req := e.truncateRequest()
+	if len(req.Tenants) == 0 {
+		return nil
+	}
+	// Each command only removes a single index partition per tenant,
+	// therefore we repeat the request until no expired partitions left.
+	for more := true; more; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		resp, err := e.raft.Propose(
+			fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_TRUNCATE_INDEX),
+			req,
+		)
+		if err != nil {
+			return err
+		}
+		truncated := resp.(*raft_log.TruncateIndexResponse)
+		for _, t := range truncated.Tenants {
+			e.metrics.deletedBlocks.WithLabelValues(t.TenantId).Add(float64(t.DeletedBlocks))
+			e.metrics.deletedBytes.WithLabelValues(t.TenantId).Add(float64(t.DeletedBytes))
+		}
+		more = truncated.More
+	}
+	return nil
+}
+
+func (e *Enforcer) truncateRequest() *raft_log.TruncateIndexRequest {
+	req := new(raft_log.TruncateIndexRequest)
+	now := e.now()
+	for _, tenant := range e.index.ListTenants() {
+		period := e.overrides.CompactorBlocksRetentionPeriod(tenant)
+		if period <= 0 {
+			continue
+		}
+		req.Tenants = append(req.Tenants, &raft_log.TenantRetention{
+			TenantId:       tenant,
+			RetentionStart: now.Add(-period).UnixMilli(),
+		})
+	}
+	return req
+}
@@ -0,0 +1,95 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/raft_log"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/fsm"
+	"github.com/grafana/pyroscope/pkg/test"
+)
+
+type overrides map[string]time.Duration
+
+func (o overrides) CompactorBlocksRetentionPeriod(tenant string) time.Duration { return o[tenant] }
+
+type index []string
+
+func (x index) ListTenants() []string { return x }
+
+type raftFunc func(fsm.RaftLogEntryType, proto.Message) (proto.Message, error)
+
+func (f raftFunc) Propose(t fsm.RaftLogEntryType, m proto.Message) (proto.Message, error) {
+	return f(t, m)
+}
+
+func TestEnforcer_Enforce(t *testing.T) {
+	now := test.Time("2024-09-23T08:00:00.000Z")
+	var requests []*raft_log.TruncateIndexRequest
+	responses := []*raft_log.TruncateIndexResponse{
+		{
+			Tenants: []*raft_log.TruncatedTenant{
+				{TenantId: "tenant-a", DeletedBlocks: 2, DeletedBytes: 100},
+				{TenantId: "tenant-c", DeletedBlocks: 1, DeletedBytes: 10},
+			},
+			More: true,
+		},
+		{
+			Tenants: []*raft_log.TruncatedTenant{
+				{TenantId: "tenant-a", DeletedBlocks: 1, DeletedBytes: 50},
+			},
+		},
+	}
+
+	raft := raftFunc(func(typ fsm.RaftLogEntryType, m proto.Message) (proto.Message, error) {
+		assert.Equal(t, fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_TRUNCATE_INDEX), typ)
+		requests = append(requests, m.(*raft_log.TruncateIndexRequest))
+		resp := responses[0]
+		responses = responses[1:]
+		return resp, nil
+	})
+
+	reg := prometheus.NewRegistry()
+	e := NewEnforcer(
+		test.NewTestingLogger(t),
+		Config{},
+		reg,
+		overrides{"tenant-a": time.Hour, "tenant-c": 24 * time.Hour},
+		index{"tenant-a", "tenant-b", "tenant-c"},
+		raft,
+	)
+	e.now = func() time.Time { return now }
+
+	require.NoError(t, e.enforce(context.Background()))
+	require.Len(t, requests, 2)
+	expected := &raft_log.TruncateIndexRequest{
+		Tenants: []*raft_log.TenantRetention{
+			{TenantId: "tenant-a", RetentionStart: now.Add(-time.Hour).UnixMilli()},
+			{TenantId: "tenant-c", RetentionStart: now.Add(-24 * time.Hour).UnixMilli()},
+		},
+	}
+	for _, r := range requests {
+		assert.True(t, proto.Equal(expected, r))
+	}
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(e.metrics.deletedBlocks.WithLabelValues("tenant-a")))
+	assert.Equal(t, float64(150), testutil.ToFloat64(e.metrics.deletedBytes.WithLabelValues("tenant-a")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(e.metrics.deletedBlocks.WithLabelValues("tenant-c")))
+	assert.Equal(t, float64(10), testutil.ToFloat64(e.metrics.deletedBytes.WithLabelValues("tenant-c")))
+}
+
+func TestEnforcer_NoRetentionPolicy(t *testing.T) {
+	raft := raftFunc(func(fsm.RaftLogEntryType, proto.Message) (proto.Message, error) {
+		t.Fatal("unexpected proposal")
+		return nil, nil
+	})
+	e := NewEnforcer(test.NewTestingLogger(t), Config{}, nil, overrides{}, index{"tenant-a"}, raft)
+	require.NoError(t, e.enforce(context.Background()))
+}
@@ -30,13 +30,13 @@ type CursorIterator struct {
 }
 
 func (c *CursorIterator) Next() bool {
-	if !c.seek {
-		c.k, c.v = c.cursor.Seek(c.Prefix)
-		c.seek = true
-		return c.valid()
-	}
 	for {
-		c.k, c.v = c.cursor.Next()
+		if !c.seek {
+			c.k, c.v = c.cursor.Seek(c.Prefix)
+			c.seek = true
+		} else {
+			c.k, c.v = c.cursor.Next()
+		}
 		if !c.valid() {
 			return false
 		}
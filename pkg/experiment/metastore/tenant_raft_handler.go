@@ -0,0 +1,193 @@
+package metastore
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/hashicorp/raft"
+	"go.etcd.io/bbolt"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/raft_log"
+)
+
+type TenantIndexDeleter interface {
+	DeleteTenant(*bbolt.Tx, string) ([]*metastorev1.BlockMeta, bool, error)
+	TruncateTenant(*bbolt.Tx, string, time.Time) ([]*metastorev1.BlockMeta, bool, error)
+}
+
+type CompactionQueueDeleter interface {
+	DeleteBlocks(tx *bbolt.Tx, tenant string, shard, level uint32, blocks ...string) error
+}
+
+type TombstoneAdder interface {
+	AddTombstones(*bbolt.Tx, *raft.Log, *metastorev1.Tombstones) error
+}
+
+type SeriesDeletionWriter interface {
+	AddDeletion(*bbolt.Tx, *raft.Log, *metastorev1.SeriesDeletion) error
+	DeleteTenant(*bbolt.Tx, string) error
+}
+
+type TenantCommandHandler struct {
+	logger     log.Logger
+	index      TenantIndexDeleter
+	compactor  CompactionQueueDeleter
+	tombstones TombstoneAdder
+	deletions  SeriesDeletionWriter
+}
+
+func NewTenantCommandHandler(
+	logger log.Logger,
+	index TenantIndexDeleter,
+	compactor CompactionQueueDeleter,
+	tombstones TombstoneAdder,
+	deletions SeriesDeletionWriter,
+) *TenantCommandHandler {
+	return &TenantCommandHandler{
+		logger:     logger,
+		index:      index,
+		compactor:  compactor,
+		tombstones: tombstones,
+		deletions:  deletions,
+	}
+}
+
+func (h *TenantCommandHandler) DeleteTenant(
+	tx *bbolt.Tx, cmd *raft.Log, req *raft_log.DeleteTenantRequest,
+) (*raft_log.DeleteTenantResponse, error) {
+	blocks, more, err := h.index.DeleteTenant(tx, req.TenantId)
+	if err != nil {
+		level.Error(h.logger).Log("msg", "failed to delete tenant blocks from index", "tenant", req.TenantId, "err", err)
+		return nil, err
+	}
+	if err = h.deleteBlocks(tx, cmd, req.TenantId, blocks); err != nil {
+		return nil, err
+	}
+	if !more {
+		// No data left: series deletions are not needed anymore.
+		if err = h.deletions.DeleteTenant(tx, req.TenantId); err != nil {
+			level.Error(h.logger).Log("msg", "failed to delete tenant series deletions", "tenant", req.TenantId, "err", err)
+			return nil, err
+		}
+	}
+	level.Info(h.logger).Log("msg", "deleted tenant blocks from index", "tenant", req.TenantId, "blocks", len(blocks), "more", more)
+	return &raft_log.DeleteTenantResponse{
+		DeletedBlocks: uint64(len(blocks)),
+		More:          more,
+	}, nil
+}
+
+func (h *TenantCommandHandler) TruncateIndex(
+	tx *bbolt.Tx, cmd *raft.Log, req *raft_log.TruncateIndexRequest,
+) (*raft_log.TruncateIndexResponse, error) {
+	resp := new(raft_log.TruncateIndexResponse)
+	for _, t := range req.Tenants {
+		before := time.UnixMilli(t.RetentionStart)
+		blocks, more, err := h.index.TruncateTenant(tx, t.TenantId, before)
+		if err != nil {
+			level.Error(h.logger).Log("msg", "failed to truncate tenant index", "tenant", t.TenantId, "err", err)
+			return nil, err
+		}
+		// The tenant may have more partitions to truncate even if
+		// none of the blocks in this one were deleted.
+		resp.More = resp.More || more
+		if len(blocks) == 0 {
+			continue
+		}
+		if err = h.deleteBlocks(tx, cmd, t.TenantId, blocks); err != nil {
+			return nil, err
+		}
+		truncated := &raft_log.TruncatedTenant{
+			TenantId:      t.TenantId,
+			DeletedBlocks: uint64(len(blocks)),
+		}
+		for _, b := range blocks {
+			truncated.DeletedBytes += b.Size
+		}
+		resp.Tenants = append(resp.Tenants, truncated)
+		level.Info(h.logger).Log(
+			"msg", "deleted expired tenant blocks from index",
+			"tenant", t.TenantId,
+			"before", before.UTC().Format(time.RFC3339),
+			"blocks", truncated.DeletedBlocks,
+			"bytes", truncated.DeletedBytes,
+		)
+	}
+	return resp, nil
+}
+
+func (h *TenantCommandHandler) DeleteSeries(
+	tx *bbolt.Tx, cmd *raft.Log, req *raft_log.DeleteSeriesRequest,
+) (*raft_log.DeleteSeriesResponse, error) {
+	if err := h.deletions.AddDeletion(tx, cmd, req.Deletion); err != nil {
+		level.Error(h.logger).Log("msg", "failed to add series deletion", "tenant", req.Deletion.Tenant, "err", err)
+		return nil, err
+	}
+	level.Info(h.logger).Log(
+		"msg", "series deletion added",
+		"tenant", req.Deletion.Tenant,
+		"id", req.Deletion.Id,
+		"selector", req.Deletion.LabelSelector,
+		"start", time.UnixMilli(req.Deletion.StartTime).UTC().Format(time.RFC3339),
+		"end", time.UnixMilli(req.Deletion.EndTime).UTC().Format(time.RFC3339),
+	)
+	return &raft_log.DeleteSeriesResponse{Deletion: req.Deletion}, nil
+}
+
+// deleteBlocks creates tombstones for the blocks removed from the index.
+// Blocks are grouped by shard and compaction level, as the object path
+// depends on both. The blocks might be still in the compaction queue: we
+// remove them from there, so they are not compacted.
+func (h *TenantCommandHandler) deleteBlocks(tx *bbolt.Tx, cmd *raft.Log, tenant string, blocks []*metastorev1.BlockMeta) error {
+	for _, t := range tenantBlockTombstones(cmd, tenant, blocks) {
+		if err := h.compactor.DeleteBlocks(tx, t.Tenant, t.Shard, t.CompactionLevel, t.Blocks...); err != nil {
+			level.Error(h.logger).Log("msg", "failed to delete blocks from compaction queue", "tenant", tenant, "err", err)
+			return err
+		}
+		if err := h.tombstones.AddTombstones(tx, cmd, &metastorev1.Tombstones{Blocks: t}); err != nil {
+			level.Error(h.logger).Log("msg", "failed to add tombstones", "tenant", tenant, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func tenantBlockTombstones(cmd *raft.Log, tenant string, blocks []*metastorev1.BlockMeta) []*metastorev1.BlockTombstones {
+	type key struct{ shard, level uint32 }
+	groups := make(map[key]*metastorev1.BlockTombstones)
+	tombstones := make([]*metastorev1.BlockTombstones, 0, 1)
+	for _, b := range blocks {
+		k := key{shard: b.Shard, level: b.CompactionLevel}
+		t, ok := groups[k]
+		if !ok {
+			// The name must be unique and deterministic:
+			// it identifies the tombstones in the raft log.
+			t = &metastorev1.BlockTombstones{
+				Name:            tenantTombstonesName(cmd, tenant, k.shard, k.level),
+				Shard:           k.shard,
+				Tenant:          tenant,
+				CompactionLevel: k.level,
+			}
+			groups[k] = t
+			tombstones = append(tombstones, t)
+		}
+		t.Blocks = append(t.Blocks, b.Id)
+	}
+	return tombstones
+}
+
+func tenantTombstonesName(cmd *raft.Log, tenant string, shard, level uint32) string {
+	b := make([]byte, 0, 64)
+	b = append(b, "tenant-blocks-"...)
+	b = strconv.AppendUint(b, cmd.Index, 10)
+	b = append(b, '-')
+	b = append(b, tenant...)
+	b = append(b, '-')
+	b = strconv.AppendUint(b, uint64(shard), 10)
+	b = append(b, '-')
+	b = strconv.AppendUint(b, uint64(level), 10)
+	return string(b)
+}
@@ -2,13 +2,18 @@ package metastore
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/prometheus/promql/parser"
 	"go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/raft_log"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/fsm"
 	"github.com/grafana/pyroscope/pkg/experiment/metastore/raftnode"
 )
 
@@ -16,23 +21,33 @@ type TenantIndex interface {
 	GetTenantStats(tenant string) *metastorev1.TenantStats
 }
 
+type TenantTombstones interface {
+	CountTenantBlocks(tx *bbolt.Tx, tenant string) (uint64, error)
+}
+
 type TenantService struct {
 	metastorev1.TenantServiceServer
 
-	logger log.Logger
-	state  State
-	index  TenantIndex
+	logger     log.Logger
+	raft       Raft
+	state      State
+	index      TenantIndex
+	tombstones TenantTombstones
 }
 
 func NewTenantService(
 	logger log.Logger,
+	raft Raft,
 	state State,
 	index TenantIndex,
+	tombstones TenantTombstones,
 ) *TenantService {
 	return &TenantService{
-		logger: logger,
-		state:  state,
-		index:  index,
+		logger:     logger,
+		raft:       raft,
+		state:      state,
+		index:      index,
+		tombstones: tombstones,
 	}
 }
 
@@ -40,8 +55,12 @@ func (svc *TenantService) GetTenant(
 	ctx context.Context,
 	req *metastorev1.GetTenantRequest,
 ) (resp *metastorev1.GetTenantResponse, err error) {
-	read := func(*bbolt.Tx, raftnode.ReadIndex) {
-		resp = &metastorev1.GetTenantResponse{Stats: svc.index.GetTenantStats(req.TenantId)}
+	read := func(tx *bbolt.Tx, _ raftnode.ReadIndex) {
+		stats := svc.index.GetTenantStats(req.TenantId)
+		if stats.BlocksPendingDeletion, err = svc.tombstones.CountTenantBlocks(tx, req.TenantId); err != nil {
+			return
+		}
+		resp = &metastorev1.GetTenantResponse{Stats: stats}
 	}
 	if readErr := svc.state.ConsistentRead(ctx, read); readErr != nil {
 		return nil, status.Error(codes.Unavailable, readErr.Error())
@@ -49,10 +68,74 @@ func (svc *TenantService) GetTenant(
 	return resp, err
 }
 
+// DeleteTenant removes all the tenant blocks from the index. The block
+// objects are deleted from the storage asynchronously by the compaction
+// workers. The call is idempotent and can be safely retried.
 func (svc *TenantService) DeleteTenant(
-	context.Context,
-	*metastorev1.DeleteTenantRequest,
+	ctx context.Context,
+	req *metastorev1.DeleteTenantRequest,
 ) (*metastorev1.DeleteTenantResponse, error) {
-	// TODO(kolesnikovae): Implement.
-	return new(metastorev1.DeleteTenantResponse), nil
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	resp := new(metastorev1.DeleteTenantResponse)
+	// Each command only affects a single index partition, therefore
+	// we repeat the request until no partitions left for the tenant.
+	for more := true; more; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r, err := svc.raft.Propose(
+			fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_DELETE_TENANT),
+			&raft_log.DeleteTenantRequest{TenantId: req.TenantId},
+		)
+		if err != nil {
+			level.Error(svc.logger).Log("msg", "failed to delete tenant", "tenant", req.TenantId, "err", err)
+			return nil, err
+		}
+		deleted := r.(*raft_log.DeleteTenantResponse)
+		resp.DeletedBlocks += deleted.DeletedBlocks
+		more = deleted.More
+	}
+	level.Info(svc.logger).Log("msg", "tenant deleted", "tenant", req.TenantId, "blocks", resp.DeletedBlocks)
+	return resp, nil
+}
+
+// DeleteSeries records the series deletion request. The deleted series
+// are filtered out at query time until compaction removes them from the
+// blocks. Only data ingested before the request is affected.
+func (svc *TenantService) DeleteSeries(
+	_ context.Context,
+	req *metastorev1.DeleteSeriesRequest,
+) (*metastorev1.DeleteSeriesResponse, error) {
+	if err := validateDeleteSeriesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r, err := svc.raft.Propose(
+		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_DELETE_SERIES),
+		&raft_log.DeleteSeriesRequest{Deletion: &metastorev1.SeriesDeletion{
+			Tenant:        req.TenantId,
+			LabelSelector: req.LabelSelector,
+			StartTime:     req.StartTime,
+			EndTime:       req.EndTime,
+		}},
+	)
+	if err != nil {
+		level.Error(svc.logger).Log("msg", "failed to delete series", "tenant", req.TenantId, "err", err)
+		return nil, err
+	}
+	return &metastorev1.DeleteSeriesResponse{Deletion: r.(*raft_log.DeleteSeriesResponse).Deletion}, nil
+}
+
+func validateDeleteSeriesRequest(req *metastorev1.DeleteSeriesRequest) error {
+	if req.TenantId == "" {
+		return fmt.Errorf("tenant_id is required")
+	}
+	if _, err := parser.ParseMetricSelector(req.LabelSelector); err != nil {
+		return fmt.Errorf("invalid label_selector: %w", err)
+	}
+	if req.StartTime > req.EndTime {
+		return fmt.Errorf("start_time must not be after end_time")
+	}
+	return nil
 }
@@ -0,0 +1,268 @@
+package metastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1/raft_log"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/deletions"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/fsm"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/index"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/raftnode"
+	"github.com/grafana/pyroscope/pkg/experiment/metastore/tombstones"
+	"github.com/grafana/pyroscope/pkg/test"
+)
+
+type tenantServiceSuite struct {
+	db         *bbolt.DB
+	cmd        uint64
+	index      *index.Index
+	tombstones *tombstones.Tombstones
+	deletions  *deletions.SeriesDeletions
+	compactor  *compactionQueueStub
+	handler    *TenantCommandHandler
+	service    *TenantService
+}
+
+// compactionQueueStub records the blocks removed from the compaction queue.
+type compactionQueueStub struct{ deleted []string }
+
+func (c *compactionQueueStub) DeleteBlocks(_ *bbolt.Tx, _ string, _, _ uint32, blocks ...string) error {
+	c.deleted = append(c.deleted, blocks...)
+	return nil
+}
+
+func newTenantServiceSuite(t *testing.T) *tenantServiceSuite {
+	s := &tenantServiceSuite{
+		db:         test.BoltDB(t),
+		index:      index.NewIndex(log.NewNopLogger(), index.NewStore(), index.DefaultConfig),
+		tombstones: tombstones.NewTombstones(tombstones.NewStore()),
+		deletions:  deletions.NewSeriesDeletions(),
+		compactor:  new(compactionQueueStub),
+	}
+	require.NoError(t, s.db.Update(func(tx *bbolt.Tx) error {
+		for _, init := range []func(*bbolt.Tx) error{s.index.Init, s.tombstones.Init, s.deletions.Init} {
+			if err := init(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	s.handler = NewTenantCommandHandler(log.NewNopLogger(), s.index, s.compactor, s.tombstones, s.deletions)
+	s.service = NewTenantService(log.NewNopLogger(), s, s, s.index, s.tombstones)
+	return s
+}
+
+// Propose applies the command to the local state,
+// as if it was committed to the raft log.
+func (s *tenantServiceSuite) Propose(t fsm.RaftLogEntryType, req proto.Message) (resp proto.Message, err error) {
+	s.cmd++
+	cmd := &raft.Log{Index: s.cmd, AppendedAt: test.Time("2024-09-24T00:00:00.000Z")}
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		switch raft_log.RaftCommand(t) {
+		case raft_log.RaftCommand_RAFT_COMMAND_DELETE_TENANT:
+			resp, err = s.handler.DeleteTenant(tx, cmd, req.(*raft_log.DeleteTenantRequest))
+		case raft_log.RaftCommand_RAFT_COMMAND_TRUNCATE_INDEX:
+			resp, err = s.handler.TruncateIndex(tx, cmd, req.(*raft_log.TruncateIndexRequest))
+		case raft_log.RaftCommand_RAFT_COMMAND_DELETE_SERIES:
+			resp, err = s.handler.DeleteSeries(tx, cmd, req.(*raft_log.DeleteSeriesRequest))
+		}
+		return err
+	})
+	return resp, err
+}
+
+func (s *tenantServiceSuite) ConsistentRead(_ context.Context, read func(*bbolt.Tx, raftnode.ReadIndex)) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		read(tx, raftnode.ReadIndex{})
+		return nil
+	})
+}
+
+func (s *tenantServiceSuite) insertBlocks(t *testing.T, blocks ...*metastorev1.BlockMeta) {
+	require.NoError(t, s.db.Update(func(tx *bbolt.Tx) error {
+		for _, b := range blocks {
+			if err := s.index.InsertBlock(tx, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *tenantServiceSuite) listDeletions(t *testing.T, tenant string) (found []*metastorev1.SeriesDeletion) {
+	require.NoError(t, s.db.View(func(tx *bbolt.Tx) (err error) {
+		found, err = s.deletions.ListTenantDeletions(tx, tenant)
+		return err
+	}))
+	return found
+}
+
+func newTenantBlock(id string, tenant string, shard, level uint32, minT, maxT int64) *metastorev1.BlockMeta {
+	return &metastorev1.BlockMeta{
+		Id:              id,
+		Tenant:          1,
+		Shard:           shard,
+		CompactionLevel: level,
+		MinTime:         minT,
+		MaxTime:         maxT,
+		Size:            100,
+		CreatedBy:       2,
+		Datasets: []*metastorev1.Dataset{
+			{Tenant: 1, Name: 3, MinTime: minT, MaxTime: maxT, Labels: []int32{1, 4, 3}},
+		},
+		StringTable: []string{"", tenant, "ingester", "dataset-a", "service_name"},
+	}
+}
+
+func TestTenantService_DeleteTenant(t *testing.T) {
+	s := newTenantServiceSuite(t)
+	ctx := context.Background()
+
+	minT := test.UnixMilli("2024-09-23T08:00:00.000Z")
+	maxT := test.UnixMilli("2024-09-23T09:00:00.000Z")
+	blocks := []*metastorev1.BlockMeta{
+		newTenantBlock(test.ULID("2024-09-23T08:00:00.001Z"), "tenant-a", 1, 1, minT, maxT),
+		newTenantBlock(test.ULID("2024-09-23T08:00:00.002Z"), "tenant-a", 2, 1, minT, maxT),
+		newTenantBlock(test.ULID("2024-09-23T08:00:00.003Z"), "tenant-b", 1, 1, minT, maxT),
+		// Another partition.
+		newTenantBlock(test.ULID("2024-09-23T20:00:00.001Z"), "tenant-a", 1, 2, minT, maxT),
+	}
+	s.insertBlocks(t, blocks...)
+
+	_, err := s.service.DeleteSeries(ctx, &metastorev1.DeleteSeriesRequest{
+		TenantId:      "tenant-a",
+		LabelSelector: `{service_name="service-a"}`,
+		StartTime:     minT,
+		EndTime:       maxT,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.listDeletions(t, "tenant-a"), 1)
+
+	resp, err := s.service.DeleteTenant(ctx, &metastorev1.DeleteTenantRequest{TenantId: "tenant-a"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.DeletedBlocks)
+
+	// The blocks are removed from the index and the compaction queue.
+	assert.Equal(t, new(metastorev1.TenantStats), s.index.GetTenantStats("tenant-a"))
+	assert.True(t, s.index.GetTenantStats("tenant-b").DataIngested)
+	assert.ElementsMatch(t, []string{blocks[0].Id, blocks[1].Id, blocks[3].Id}, s.compactor.deleted)
+
+	// Tombstones are created for each of the deleted blocks.
+	for _, b := range []*metastorev1.BlockMeta{blocks[0], blocks[1], blocks[3]} {
+		assert.True(t, s.tombstones.Exists("tenant-a", b.Shard, b.Id))
+	}
+	assert.False(t, s.tombstones.Exists("tenant-b", blocks[2].Shard, blocks[2].Id))
+	tenant, err := s.service.GetTenant(ctx, &metastorev1.GetTenantRequest{TenantId: "tenant-a"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), tenant.Stats.BlocksPendingDeletion)
+	assert.False(t, tenant.Stats.DataIngested)
+
+	// Series deletions are dropped once no data left.
+	assert.Empty(t, s.listDeletions(t, "tenant-a"))
+
+	// The second call is a no-op.
+	resp, err = s.service.DeleteTenant(ctx, &metastorev1.DeleteTenantRequest{TenantId: "tenant-a"})
+	require.NoError(t, err)
+	assert.Zero(t, resp.DeletedBlocks)
+	assert.Len(t, s.compactor.deleted, 3)
+	tenant, err = s.service.GetTenant(ctx, &metastorev1.GetTenantRequest{TenantId: "tenant-a"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), tenant.Stats.BlocksPendingDeletion)
+}
+
+func TestTenantCommandHandler_DeleteTenant(t *testing.T) {
+	s := newTenantServiceSuite(t)
+
+	minT := test.UnixMilli("2024-09-23T08:00:00.000Z")
+	maxT := test.UnixMilli("2024-09-23T09:00:00.000Z")
+	blocks := []*metastorev1.BlockMeta{
+		newTenantBlock(test.ULID("2024-09-23T08:00:00.001Z"), "tenant-a", 1, 1, minT, maxT),
+		newTenantBlock(test.ULID("2024-09-23T20:00:00.001Z"), "tenant-a", 1, 1, minT, maxT),
+	}
+	s.insertBlocks(t, blocks...)
+
+	deleteTenant := func() *raft_log.DeleteTenantResponse {
+		resp, err := s.Propose(
+			fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_DELETE_TENANT),
+			&raft_log.DeleteTenantRequest{TenantId: "tenant-a"},
+		)
+		require.NoError(t, err)
+		return resp.(*raft_log.DeleteTenantResponse)
+	}
+
+	// Each command deletes a single partition.
+	resp := deleteTenant()
+	assert.Equal(t, uint64(1), resp.DeletedBlocks)
+	assert.True(t, resp.More)
+	assert.True(t, s.tombstones.Exists("tenant-a", 1, blocks[0].Id))
+	assert.False(t, s.tombstones.Exists("tenant-a", 1, blocks[1].Id))
+
+	resp = deleteTenant()
+	assert.Equal(t, uint64(1), resp.DeletedBlocks)
+	assert.False(t, resp.More)
+	assert.True(t, s.tombstones.Exists("tenant-a", 1, blocks[1].Id))
+
+	resp = deleteTenant()
+	assert.Zero(t, resp.DeletedBlocks)
+	assert.False(t, resp.More)
+	assert.Equal(t, 2, countTenantTombstones(s.tombstones))
+}
+
+func countTenantTombstones(ts *tombstones.Tombstones) (n int) {
+	it := ts.ListTombstones(test.Time("2025-01-01T00:00:00.000Z"))
+	for it.Next() {
+		n++
+	}
+	return n
+}
+
+// tenantIndexStub returns the predefined truncation results in order.
+type tenantIndexStub struct {
+	TenantIndexDeleter
+	truncated []truncateResult
+}
+
+type truncateResult struct {
+	blocks []*metastorev1.BlockMeta
+	more   bool
+}
+
+func (x *tenantIndexStub) TruncateTenant(*bbolt.Tx, string, time.Time) ([]*metastorev1.BlockMeta, bool, error) {
+	r := x.truncated[0]
+	x.truncated = x.truncated[1:]
+	return r.blocks, r.more, nil
+}
+
+func TestTenantCommandHandler_TruncateIndex_More(t *testing.T) {
+	s := newTenantServiceSuite(t)
+	minT := test.UnixMilli("2024-09-23T08:00:00.000Z")
+	maxT := test.UnixMilli("2024-09-23T09:00:00.000Z")
+	s.handler.index = &tenantIndexStub{truncated: []truncateResult{
+		{blocks: []*metastorev1.BlockMeta{newTenantBlock(test.ULID("2024-09-23T08:00:00.001Z"), "tenant-a", 1, 1, minT, maxT)}},
+		// Nothing was deleted in the partition, but there are more to truncate.
+		{more: true},
+	}}
+
+	resp, err := s.Propose(
+		fsm.RaftLogEntryType(raft_log.RaftCommand_RAFT_COMMAND_TRUNCATE_INDEX),
+		&raft_log.TruncateIndexRequest{Tenants: []*raft_log.TenantRetention{
+			{TenantId: "tenant-a", RetentionStart: maxT},
+			{TenantId: "tenant-b", RetentionStart: maxT},
+		}},
+	)
+	require.NoError(t, err)
+	truncated := resp.(*raft_log.TruncateIndexResponse)
+	require.Len(t, truncated.Tenants, 1)
+	assert.Equal(t, "tenant-a", truncated.Tenants[0].TenantId)
+	assert.True(t, truncated.More)
+}
@@ -39,7 +39,21 @@ func (s *TombstoneStore) StoreTombstones(tx *bbolt.Tx, entry TombstoneEntry) err
 }
 
 func (s *TombstoneStore) DeleteTombstones(tx *bbolt.Tx, entry TombstoneEntry) error {
-	return tx.Bucket(s.bucketName).Delete(marshalTombstoneEntryKey(entry))
+	bucket := tx.Bucket(s.bucketName)
+	if err := bucket.Delete(marshalTombstoneEntryKey(entry)); err != nil {
+		return err
+	}
+	// Entries created before the tombstone name was included into the
+	// key: we only delete the entry if it refers to the same tombstones,
+	// as multiple tombstones may have been created by a single command.
+	k := marshalTombstoneEntryKey(entry)[:tombstoneEntryKeyPrefixSize]
+	if v := bucket.Get(k); v != nil {
+		var e TombstoneEntry
+		if err := unmarshalTombstoneEntry(&e, store.KV{Key: k, Value: v}); err == nil && e.key() == entry.key() {
+			return bucket.Delete(k)
+		}
+	}
+	return nil
 }
 
 func (s *TombstoneStore) ListEntries(tx *bbolt.Tx) iter.Iterator[TombstoneEntry] {
@@ -82,15 +96,28 @@ func marshalTombstoneEntry(e TombstoneEntry) store.KV {
 	return store.KV{Key: k, Value: b}
 }
 
+// The key is composed of the raft command index, the time the command
+// was appended to the log, and the tombstone name: a single command may
+// create multiple tombstones.
+const tombstoneEntryKeyPrefixSize = 16
+
 func marshalTombstoneEntryKey(e TombstoneEntry) []byte {
-	b := make([]byte, 16)
+	name := e.key()
+	b := make([]byte, tombstoneEntryKeyPrefixSize, tombstoneEntryKeyPrefixSize+len(name))
 	binary.BigEndian.PutUint64(b[0:8], e.Index)
 	binary.BigEndian.PutUint64(b[8:16], uint64(e.AppendedAt))
-	return b
+	return append(b, name...)
+}
+
+func (e TombstoneEntry) key() string {
+	if e.Tombstones != nil && e.Tombstones.Blocks != nil {
+		return e.Tombstones.Blocks.Name
+	}
+	return ""
 }
 
 func unmarshalTombstoneEntry(dst *TombstoneEntry, e store.KV) error {
-	if len(e.Key) < 16 {
+	if len(e.Key) < tombstoneEntryKeyPrefixSize {
 		return ErrInvalidTombstoneEntry
 	}
 	dst.Index = binary.BigEndian.Uint64(e.Key[0:8])
@@ -99,3 +99,71 @@ func TestTombstoneStore_DeleteQueuedEntries(t *testing.T) {
 	assert.Nil(t, iter.Close())
 	require.NoError(t, tx.Rollback())
 }
+
+func TestTombstoneStore_MultipleTombstonesPerCommand(t *testing.T) {
+	db := test.BoltDB(t)
+
+	s := NewTombstoneStore()
+	tx, err := db.Begin(true)
+	require.NoError(t, err)
+	require.NoError(t, s.CreateBuckets(tx))
+
+	appendedAt := time.Now().UnixNano()
+	entries := make([]TombstoneEntry, 3)
+	for i, name := range []string{"a", "b", "c"} {
+		entries[i] = TombstoneEntry{
+			Index:      1,
+			AppendedAt: appendedAt,
+			Tombstones: &metastorev1.Tombstones{
+				Blocks: &metastorev1.BlockTombstones{Name: name},
+			},
+		}
+		assert.NoError(t, s.StoreTombstones(tx, entries[i]))
+	}
+	// Legacy entry: the key does not include the tombstone name.
+	legacy := TombstoneEntry{
+		Index:      0,
+		AppendedAt: appendedAt,
+		Tombstones: &metastorev1.Tombstones{
+			Blocks: &metastorev1.BlockTombstones{Name: "legacy"},
+		},
+	}
+	kv := marshalTombstoneEntry(legacy)
+	require.NoError(t, tx.Bucket(tombstoneBucketName).Put(kv.Key[:tombstoneEntryKeyPrefixSize], kv.Value))
+	require.NoError(t, tx.Commit())
+
+	tx, err = db.Begin(true)
+	require.NoError(t, err)
+	assert.NoError(t, s.DeleteTombstones(tx, entries[1]))
+	assert.NoError(t, s.DeleteTombstones(tx, TombstoneEntry{
+		Index:      0,
+		AppendedAt: appendedAt,
+		Tombstones: &metastorev1.Tombstones{
+			Blocks: &metastorev1.BlockTombstones{Name: "unknown"},
+		},
+	}))
+	require.NoError(t, tx.Commit())
+
+	collect := func() []TombstoneEntry {
+		tx, err := db.Begin(false)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, tx.Rollback())
+		}()
+		var actual []TombstoneEntry
+		iter := s.ListEntries(tx)
+		for iter.Next() {
+			actual = append(actual, iter.At())
+		}
+		require.NoError(t, iter.Err())
+		return actual
+	}
+
+	assert.Equal(t, []TombstoneEntry{legacy, entries[0], entries[2]}, collect())
+
+	tx, err = db.Begin(true)
+	require.NoError(t, err)
+	assert.NoError(t, s.DeleteTombstones(tx, legacy))
+	require.NoError(t, tx.Commit())
+	assert.Equal(t, []TombstoneEntry{entries[0], entries[2]}, collect())
+}
@@ -1,6 +1,7 @@
 package tombstones
 
 import (
+	"sync"
 	"time"
 
 	"github.com/hashicorp/raft"
@@ -23,6 +24,11 @@ type Tombstones struct {
 	blocks     map[tenantBlockKey]*tenantBlocks
 	queue      *tombstoneQueue
 	store      TombstoneStore
+
+	// Number of blocks awaiting deletion, per tenant. The counters are
+	// read outside of the FSM, therefore they are protected by the mutex.
+	mu     sync.RWMutex
+	counts map[string]uint64
 }
 
 type tenantBlockKey struct {
@@ -40,6 +46,7 @@ func NewTombstones(store TombstoneStore) *Tombstones {
 		blocks:     make(map[tenantBlockKey]*tenantBlocks),
 		queue:      newTombstoneQueue(),
 		store:      store,
+		counts:     make(map[string]uint64),
 	}
 }
 
@@ -62,6 +69,15 @@ func (x *Tombstones) ListTombstones(before time.Time) iter.Iterator[*metastorev1
 	}
 }
 
+// CountTenantBlocks returns the number of tenant blocks awaiting deletion.
+// The function is safe to be used in read-only transactions concurrently
+// with the FSM updates.
+func (x *Tombstones) CountTenantBlocks(_ *bbolt.Tx, tenant string) (uint64, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.counts[tenant], nil
+}
+
 func (x *Tombstones) AddTombstones(tx *bbolt.Tx, cmd *raft.Log, t *metastorev1.Tombstones) error {
 	var k tombstoneKey
 	if !k.set(t) {
@@ -138,9 +154,16 @@ func (x *Tombstones) putBlockTombstones(t *metastorev1.BlockTombstones) {
 		m = &tenantBlocks{blocks: make(map[string]struct{})}
 		x.blocks[bk] = m
 	}
+	var n uint64
 	for _, b := range t.Blocks {
-		m.blocks[b] = struct{}{}
+		if _, found := m.blocks[b]; !found {
+			m.blocks[b] = struct{}{}
+			n++
+		}
 	}
+	x.mu.Lock()
+	x.counts[t.Tenant] += n
+	x.mu.Unlock()
 }
 
 func (x *Tombstones) deleteBlockTombstones(t *metastorev1.BlockTombstones) {
@@ -152,9 +175,21 @@ func (x *Tombstones) deleteBlockTombstones(t *metastorev1.BlockTombstones) {
 	if !found {
 		return
 	}
+	var n uint64
 	for _, b := range t.Blocks {
-		delete(m.blocks, b)
+		if _, found := m.blocks[b]; found {
+			delete(m.blocks, b)
+			n++
+		}
+	}
+	if len(m.blocks) == 0 {
+		delete(x.blocks, bk)
+	}
+	x.mu.Lock()
+	if x.counts[t.Tenant] -= n; x.counts[t.Tenant] == 0 {
+		delete(x.counts, t.Tenant)
 	}
+	x.mu.Unlock()
 }
 
 func (x *Tombstones) Init(tx *bbolt.Tx) error {
@@ -165,6 +200,9 @@ func (x *Tombstones) Restore(tx *bbolt.Tx) error {
 	x.queue = newTombstoneQueue()
 	clear(x.tombstones)
 	clear(x.blocks)
+	x.mu.Lock()
+	clear(x.counts)
+	x.mu.Unlock()
 	entries := x.store.ListEntries(tx)
 	defer func() {
 		_ = entries.Close()
@@ -79,6 +79,8 @@ func TestTombstonesRestore(t *testing.T) {
 	assert.True(t, restored.Exists("tenant-2", 2, "block-3-1"))
 	assert.True(t, restored.Exists("tenant-2", 2, "block-3-2"))
 	assert.Equal(t, 3, countTombstones(restored))
+	assert.Equal(t, uint64(4), countTenantBlocks(t, restored, "tenant-1"))
+	assert.Equal(t, uint64(2), countTenantBlocks(t, restored, "tenant-2"))
 
 	futureTime := now.Add(time.Hour)
 	iter := restored.ListTombstones(futureTime)
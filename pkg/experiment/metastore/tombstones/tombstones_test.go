@@ -62,6 +62,7 @@ func TestTombstonesIdempotence(t *testing.T) {
 
 		assert.True(t, ts.Exists("test-tenant", 1, "block-1"))
 		assert.True(t, ts.Exists("test-tenant", 1, "block-2"))
+		assert.Equal(t, uint64(2), countTenantBlocks(t, ts, "test-tenant"))
 	})
 
 	t.Run("DeleteTombstones", func(t *testing.T) {
@@ -85,6 +86,7 @@ func TestTombstonesIdempotence(t *testing.T) {
 
 		assert.False(t, ts.Exists("test-tenant", 1, "block-1"))
 		assert.False(t, ts.Exists("test-tenant", 1, "block-2"))
+		assert.Equal(t, uint64(0), countTenantBlocks(t, ts, "test-tenant"))
 	})
 }
 
@@ -96,3 +98,9 @@ func countTombstones(ts *Tombstones) int {
 	}
 	return c
 }
+
+func countTenantBlocks(t *testing.T, ts *Tombstones, tenant string) uint64 {
+	n, err := ts.CountTenantBlocks(nil, tenant)
+	require.NoError(t, err)
+	return n
+}
@@ -6,11 +6,11 @@ import (
 )
 
 type Config struct {
-	Enabled     bool `yaml:"enabled"`
+	Enabled     bool `yaml:"enabled" category:"experimental"`
 	RulesSource struct {
-		ClientAddress string `yaml:"client_address"`
+		ClientAddress string `yaml:"client_address" category:"experimental"`
 	} `yaml:"rules_source"`
-	RemoteWriteAddress string `yaml:"remote_write_address"`
+	RemoteWriteAddress string `yaml:"remote_write_address" category:"experimental"`
 }
 
 func (c *Config) Validate() error {
@@ -24,8 +24,10 @@ func (c *Config) Validate() error {
 }
 
 func (c *Config) RegisterFlags(f *flag.FlagSet) {
-	const prefix = "compaction-worker.metrics-exporter."
+	c.RegisterFlagsWithPrefix("compaction-worker.metrics-exporter.", f)
+}
 
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
 	f.BoolVar(&c.Enabled, prefix+"enabled", false, "This parameter specifies whether the metrics exporter is enabled.")
 	f.StringVar(&c.RulesSource.ClientAddress, prefix+"rules-source.client-address", "", "The address to use for the recording rules client connection.")
 	f.StringVar(&c.RemoteWriteAddress, prefix+"remote-write-address", "", "The address to use for metrics tenant.")
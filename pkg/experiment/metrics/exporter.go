@@ -21,6 +21,7 @@ import (
 	"github.com/prometheus/prometheus/storage/remote"
 
 	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/util"
 )
 
 type StaticExporter struct {
@@ -127,12 +128,13 @@ func newMetrics(reg prometheus.Registerer, remoteUrl string) *clientMetrics {
 		}, []string{"tenant"}),
 	}
 	if reg != nil {
+		// Exporters sharing the remote write address
+		// (e.g., ingester and compaction worker) share
+		// the metrics.
 		remoteUrlReg := prometheus.WrapRegistererWith(prometheus.Labels{"url": remoteUrl}, reg)
-		remoteUrlReg.MustRegister(
-			m.requestDuration,
-			m.requestBodySize,
-			m.seriesSent,
-		)
+		m.requestDuration = util.RegisterOrGet(remoteUrlReg, m.requestDuration)
+		m.requestBodySize = util.RegisterOrGet(remoteUrlReg, m.requestBodySize)
+		m.seriesSent = util.RegisterOrGet(remoteUrlReg, m.seriesSent)
 	}
 	return m
 }
@@ -1,20 +1,27 @@
 package metrics
 
 import (
+	"context"
 	"sort"
+	"time"
 
+	"github.com/parquet-go/parquet-go"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/prompb"
 
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 )
 
 type SampleObserver struct {
 	state *observerState
 
 	recordingTime  int64
+	resolution     int64
 	externalLabels labels.Labels
 
 	exporter Exporter
@@ -24,18 +31,39 @@ type SampleObserver struct {
 type observerState struct {
 	tenant     string
 	recordings []*recording
+	partitions map[partitionKey]*partitionSymbols
 }
 
 type recording struct {
 	rule  *phlaremodel.RecordingRule
 	data  map[model.Fingerprint]*prompb.TimeSeries
 	state *recordingState
+
+	// selector is not nil if the rule has a stack trace filter.
+	// In this case, the observed value is the call site total,
+	// instead of the profile total. If locationOnly is set, the
+	// call site location is matched at any stack trace depth.
+	selector     *typesv1.StackTraceSelector
+	locationOnly bool
+}
+
+type partitionKey struct {
+	symbols   symdb.SymbolsReader
+	partition uint64
+}
+
+// partitionSymbols holds the symbols of a stack trace partition,
+// and the stack trace selections of the recordings.
+type partitionSymbols struct {
+	reader     symdb.PartitionReader
+	selections map[*recording]*symdb.SelectedStackTraces
 }
 
 type recordingState struct {
 	fp      model.Fingerprint
 	matches bool
-	sample  *prompb.Sample
+	series  *prompb.TimeSeries
+	sample  int
 }
 
 type Ruler interface {
@@ -59,6 +87,16 @@ func NewSampleObserver(recordingTime int64, exporter Exporter, ruler Ruler, labe
 	}
 }
 
+// NewWindowedSampleObserver creates a sample observer that aggregates the
+// observed profiles into time windows of the given resolution, instead of
+// recording a single sample. A sample is recorded at the end of the window,
+// but not after the recording time.
+func NewWindowedSampleObserver(recordingTime int64, resolution time.Duration, exporter Exporter, ruler Ruler, labels ...labels.Label) *SampleObserver {
+	o := NewSampleObserver(recordingTime, exporter, ruler, labels...)
+	o.resolution = resolution.Milliseconds()
+	return o
+}
+
 func (o *SampleObserver) initObserver(tenant string) {
 	recordingRules := o.ruler.RecordingRules(tenant)
 
@@ -68,11 +106,24 @@ func (o *SampleObserver) initObserver(tenant string) {
 	}
 
 	for i, rule := range recordingRules {
-		o.state.recordings[i] = &recording{
+		rec := &recording{
 			rule:  rule,
 			data:  make(map[model.Fingerprint]*prompb.TimeSeries),
 			state: &recordingState{},
 		}
+		switch {
+		case rule.FunctionName != "":
+			rec.selector = &typesv1.StackTraceSelector{
+				CallSite: []*typesv1.Location{{Name: rule.FunctionName}},
+			}
+			rec.locationOnly = true
+		case len(rule.StackTraceSelector.GetCallSite()) > 0:
+			rec.selector = rule.StackTraceSelector
+		}
+		if rec.selector != nil && o.state.partitions == nil {
+			o.state.partitions = make(map[partitionKey]*partitionSymbols)
+		}
+		o.state.recordings[i] = rec
 	}
 }
 
@@ -87,38 +138,135 @@ func (o *SampleObserver) initObserver(tenant string) {
 //     be aggregated to. Note that every rule will eventually create multiple single-sample (aggregated) series,
 //     depending on the rule.GroupBy space. More info in initState
 //
+// If a rule has a stack trace filter, only the samples of the selected stack
+// traces are aggregated. Stack traces are resolved with the symbols of the
+// source dataset, therefore the entry must not be rewritten yet.
+//
 // This call is not thread-safe
 func (o *SampleObserver) Observe(row block.ProfileEntry) {
-	tenant := row.Dataset.TenantID()
+	o.observe(row.Dataset.TenantID(), row.Dataset.Symbols(), row.Fingerprint, row.Labels, row.Row)
+}
+
+// ObserveProfile is identical to Observe, but it accepts a profile of a
+// phlaredb block: the profile belongs to the tenant, and its stack traces
+// refer to the symbols given.
+//
+// This call is not thread-safe
+func (o *SampleObserver) ObserveProfile(
+	tenant string,
+	symbols symdb.SymbolsReader,
+	fp model.Fingerprint,
+	lbls phlaremodel.Labels,
+	row schemav1.ProfileRow,
+) {
+	o.observe(tenant, symbols, fp, lbls, row)
+}
+
+func (o *SampleObserver) observe(
+	tenant string,
+	symbols symdb.SymbolsReader,
+	fp model.Fingerprint,
+	lbls phlaremodel.Labels,
+	row schemav1.ProfileRow,
+) {
 	if o.state == nil {
 		o.initObserver(tenant)
 	}
-	if o.state.tenant != row.Dataset.TenantID() {
+	if o.state.tenant != tenant {
 		// new tenant to observe, flush data of previous tenant and restart the observer
 		o.flush()
 		o.initObserver(tenant)
 	}
+	recordingTime := o.sampleTime(row)
 	for _, rec := range o.state.recordings {
-		if rec.state.fp != row.Fingerprint {
+		if rec.state.fp != fp {
 			// new batch of rows, let's precompute its state for this recording
-			rec.initState(row.Fingerprint, row.Labels, o.externalLabels, o.recordingTime)
+			rec.initState(fp, lbls, o.externalLabels)
 		}
 		if rec.state.matches {
-			rec.state.sample.Value += float64(row.Row.TotalValue())
+			rec.state.sampleAt(recordingTime).Value += o.value(rec, symbols, row)
 		}
 	}
 }
 
+// sampleTime returns the timestamp of the sample the row is aggregated to.
+func (o *SampleObserver) sampleTime(row schemav1.ProfileRow) int64 {
+	if o.resolution <= 0 {
+		return o.recordingTime
+	}
+	t := int64(model.TimeFromUnixNano(row.TimeNanos()))
+	return min((t/o.resolution+1)*o.resolution, o.recordingTime)
+}
+
+func (o *SampleObserver) value(rec *recording, symbols symdb.SymbolsReader, row schemav1.ProfileRow) float64 {
+	if rec.selector == nil {
+		return float64(row.TotalValue())
+	}
+	p := o.state.partition(symbols, row.StacktracePartitionID())
+	if p == nil {
+		return 0
+	}
+	var values symdb.CallSiteValues
+	row.ForStacktraceIdsAndValues(func(ids []parquet.Value, v []parquet.Value) {
+		p.selection(rec).CallSiteValuesParquet(&values, ids, v)
+	})
+	if rec.locationOnly {
+		return float64(values.LocationTotal)
+	}
+	return float64(values.Total)
+}
+
+// partition returns the symbols of the stack trace partition.
+// If the partition can't be loaded, the stack traces of the entry can't
+// be resolved, and nil is returned: the entry is not recorded.
+func (s *observerState) partition(symbols symdb.SymbolsReader, partition uint64) *partitionSymbols {
+	k := partitionKey{symbols: symbols, partition: partition}
+	p, ok := s.partitions[k]
+	if ok {
+		return p
+	}
+	if r, err := symbols.Partition(context.Background(), partition); err == nil {
+		p = &partitionSymbols{
+			reader:     r,
+			selections: make(map[*recording]*symdb.SelectedStackTraces),
+		}
+	}
+	s.partitions[k] = p
+	return p
+}
+
+func (p *partitionSymbols) selection(rec *recording) *symdb.SelectedStackTraces {
+	selection, ok := p.selections[rec]
+	if !ok {
+		selection = symdb.SelectStackTraces(p.reader.Symbols(), rec.selector)
+		p.selections[rec] = selection
+	}
+	return selection
+}
+
+func (s *observerState) release() {
+	for _, p := range s.partitions {
+		if p != nil {
+			p.reader.Release()
+		}
+	}
+	s.partitions = nil
+}
+
 func (o *SampleObserver) flush() {
 	timeSeries := make([]prompb.TimeSeries, 0)
 	for _, rec := range o.state.recordings {
 		for _, series := range rec.data {
+			sort.Slice(series.Samples, func(i, j int) bool {
+				return series.Samples[i].Timestamp < series.Samples[j].Timestamp
+			})
 			timeSeries = append(timeSeries, *series)
 		}
 	}
 	if len(timeSeries) > 0 {
 		_ = o.exporter.Send(o.state.tenant, timeSeries)
 	}
+	o.state.release()
 	o.state = nil
 }
 
@@ -131,7 +279,7 @@ func (o *SampleObserver) Close() {
 // initState compute labelsMap for quick lookups. Then check whether row matches the filters
 // if filter match, then labels to export are computed, and fetch/create the series where the value needs to be
 // aggregated. This state is hold for the following rows with the same fingerprint, so we can observe those faster
-func (r *recording) initState(fp model.Fingerprint, rowLabels phlaremodel.Labels, externalLabels labels.Labels, recordingTime int64) {
+func (r *recording) initState(fp model.Fingerprint, rowLabels phlaremodel.Labels, externalLabels labels.Labels) {
 	r.state.fp = fp
 	labelsMap := map[string]string{}
 	for _, label := range rowLabels {
@@ -148,13 +296,32 @@ func (r *recording) initState(fp model.Fingerprint, rowLabels phlaremodel.Labels
 
 	series, ok := r.data[aggregatedFp]
 	if !ok {
-		series = newTimeSeries(exportedLabels, recordingTime)
+		series = newTimeSeries(exportedLabels)
 		r.data[aggregatedFp] = series
 	}
-	r.state.sample = &series.Samples[0]
+	r.state.series = series
+	r.state.sample = -1
+}
+
+// sampleAt returns the series sample with the given timestamp.
+// The sample is created, if it does not exist.
+func (s *recordingState) sampleAt(t int64) *prompb.Sample {
+	samples := s.series.Samples
+	if s.sample >= 0 && samples[s.sample].Timestamp == t {
+		return &samples[s.sample]
+	}
+	for i := len(samples) - 1; i >= 0; i-- {
+		if samples[i].Timestamp == t {
+			s.sample = i
+			return &samples[i]
+		}
+	}
+	s.series.Samples = append(samples, prompb.Sample{Timestamp: t})
+	s.sample = len(s.series.Samples) - 1
+	return &s.series.Samples[s.sample]
 }
 
-func newTimeSeries(exportedLabels labels.Labels, recordingTime int64) *prompb.TimeSeries {
+func newTimeSeries(exportedLabels labels.Labels) *prompb.TimeSeries {
 	// prompb.Labels don't implement sort interface, so we need to use labels.Labels and transform it later
 	pbLabels := make([]prompb.Label, 0, len(exportedLabels))
 	for _, label := range exportedLabels {
@@ -164,12 +331,8 @@ func newTimeSeries(exportedLabels labels.Labels, recordingTime int64) *prompb.Ti
 		})
 	}
 	series := &prompb.TimeSeries{
-		Labels: pbLabels,
-		Samples: []prompb.Sample{
-			{
-				Value: 0, Timestamp: recordingTime,
-			},
-		},
+		Labels:  pbLabels,
+		Samples: make([]prompb.Sample, 0, 1),
 	}
 	return series
 }
@@ -1,20 +1,27 @@
 package metrics
 
 import (
+	"context"
+	"os"
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/parquet-go/parquet-go"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore/testutil"
 	v1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
 	"github.com/grafana/pyroscope/pkg/test/mocks/mockmetrics"
 )
@@ -96,6 +103,45 @@ func Test_Observer_observe(t *testing.T) {
 	exporter.AssertNotCalled(t, "Send", "tenant2", mock.Anything)
 }
 
+func Test_Observer_Windowed(t *testing.T) {
+	ruler := new(mockmetrics.MockRuler)
+	ruler.On("RecordingRules", mock.Anything).Return([]*phlaremodel.RecordingRule{{
+		Matchers:       []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "a", "1")},
+		ExternalLabels: labels.Labels{{Name: model.MetricNameLabel, Value: "total"}},
+	}})
+	exporter := new(mockmetrics.MockExporter)
+	var sent []prompb.TimeSeries
+	exporter.On("Send", "tenant1", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).([]prompb.TimeSeries)
+	}).Return(nil).Once()
+
+	timeCol, ok := v1.ProfilesSchema.Lookup(v1.TimeNanosColumnName)
+	require.True(t, ok)
+	ls := phlaremodel.Labels{{Name: "a", Value: "1"}}
+	observe := func(o *SampleObserver, ts time.Duration, v int64) {
+		row := make(v1.ProfileRow, 4)
+		row[3] = parquet.Int64Value(v)
+		row = append(row, parquet.Int64Value(int64(ts)).Level(0, 0, timeCol.ColumnIndex))
+		o.ObserveProfile("tenant1", nil, model.Fingerprint(ls.Hash()), ls, row)
+	}
+
+	// The last window is truncated at the recording time.
+	observer := NewWindowedSampleObserver(150e3, time.Minute, exporter, ruler)
+	observe(observer, 130*time.Second, 1<<0)
+	observe(observer, 10*time.Second, 1<<1)
+	observe(observer, 70*time.Second, 1<<2)
+	observe(observer, 20*time.Second, 1<<3)
+	observer.Close()
+
+	exporter.AssertExpectations(t)
+	require.Len(t, sent, 1)
+	assert.Equal(t, []prompb.Sample{
+		{Timestamp: 60e3, Value: 1<<1 + 1<<3},
+		{Timestamp: 120e3, Value: 1 << 2},
+		{Timestamp: 150e3, Value: 1 << 0},
+	}, sent[0].Samples)
+}
+
 func sameSeries(series1 []prompb.TimeSeries, series2 []prompb.TimeSeries) bool {
 	for _, s := range series1 {
 		found := false
@@ -164,3 +210,77 @@ func datasetForTenant(tenant string) *block.Dataset {
 		),
 	)
 }
+
+type seriesExporter map[string]float64
+
+func (e seriesExporter) Send(_ string, series []prompb.TimeSeries) error {
+	for _, s := range series {
+		for _, l := range s.Labels {
+			if l.Name == model.MetricNameLabel {
+				e[l.Value] += s.Samples[0].Value
+			}
+		}
+	}
+	return nil
+}
+
+func (e seriesExporter) Flush() {}
+
+func Test_Observer_StackTraceFilter(t *testing.T) {
+	ctx := context.Background()
+	bucket, _ := testutil.NewFilesystemBucket(t, ctx, "../block/testdata")
+
+	var resp metastorev1.GetBlockMetadataResponse
+	raw, err := os.ReadFile("../block/testdata/block-metas.json")
+	require.NoError(t, err)
+	require.NoError(t, protojson.Unmarshal(raw, &resp))
+
+	newRule := func(name string, selector *typesv1.StackTraceSelector, functionName string) *phlaremodel.RecordingRule {
+		return &phlaremodel.RecordingRule{
+			Matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, phlaremodel.LabelNameProfileType, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+			},
+			ExternalLabels:     labels.Labels{{Name: model.MetricNameLabel, Value: name}},
+			StackTraceSelector: selector,
+			FunctionName:       functionName,
+		}
+	}
+	callSite := func(names ...string) *typesv1.StackTraceSelector {
+		s := new(typesv1.StackTraceSelector)
+		for _, n := range names {
+			s.CallSite = append(s.CallSite, &typesv1.Location{Name: n})
+		}
+		return s
+	}
+
+	ruler := new(mockmetrics.MockRuler)
+	ruler.On("RecordingRules", mock.Anything).Return([]*phlaremodel.RecordingRule{
+		newRule("total", nil, ""),
+		newRule("root", callSite("github.com/hashicorp/memberlist.(*Memberlist).packetListen"), ""),
+		newRule("call_site", callSite(
+			"github.com/hashicorp/memberlist.(*Memberlist).packetListen",
+			"github.com/hashicorp/memberlist.(*Memberlist).ingestPacket",
+		), ""),
+		newRule("function", nil, "runtime.mallocgc"),
+		newRule("missing", nil, "missing"),
+	})
+
+	exporter := make(seriesExporter)
+	observer := NewSampleObserver(blockTime, exporter, ruler)
+	dst, tempdir := testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+	_, err = block.Compact(ctx, resp.Blocks, bucket,
+		block.WithCompactionDestination(dst),
+		block.WithCompactionTempDir(tempdir),
+		block.WithSampleObserver(observer),
+	)
+	require.NoError(t, err)
+	observer.Close()
+
+	assert.Equal(t, seriesExporter{
+		"total":     670e6,
+		"root":      80e6,
+		"call_site": 50e6,
+		"function":  110e6,
+		"missing":   0,
+	}, exporter)
+}
@@ -14,23 +14,30 @@ import (
 
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/objstore"
 	"github.com/grafana/pyroscope/pkg/util"
 )
 
 type Config struct {
 	Address          string            `yaml:"address"`
 	GRPCClientConfig grpcclient.Config `yaml:"grpc_client_config" doc:"description=Configures the gRPC client used to communicate between the query-frontends and the query-schedulers."`
+
+	BucketCache objstore.CachingBucketConfig `yaml:"bucket_cache" doc:"description=Configures the cache of the objects read from the object storage."`
 }
 
 func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	f.StringVar(&cfg.Address, "query-backend.address", "localhost:9095", "")
 	cfg.GRPCClientConfig.RegisterFlagsWithPrefix("query-backend.grpc-client-config", f)
+	cfg.BucketCache.RegisterFlagsWithPrefix("query-backend.bucket-cache.", f)
 }
 
 func (cfg *Config) Validate() error {
 	if cfg.Address == "" {
 		return fmt.Errorf("query-backend.address is required")
 	}
+	if err := cfg.BucketCache.Validate(); err != nil {
+		return err
+	}
 	return cfg.GRPCClientConfig.Validate()
 }
 
@@ -117,6 +117,7 @@ type request struct {
 	matchers  []*labels.Matcher
 	startTime int64 // Unix nano.
 	endTime   int64 // Unix nano.
+	deletions *block.SeriesDeletions
 }
 
 func (r *request) setTraceTags(span opentracing.Span) {
@@ -150,11 +151,16 @@ func validateRequest(req *queryv1.InvokeRequest) (*request, error) {
 	if err != nil {
 		return nil, fmt.Errorf("label selection is invalid: %w", err)
 	}
+	deletions, err := block.NewSeriesDeletions(req.SeriesDeletions)
+	if err != nil {
+		return nil, err
+	}
 	r := request{
 		src:       req,
 		matchers:  matchers,
 		startTime: model.Time(req.StartTime).UnixNano(),
 		endTime:   model.Time(req.EndTime).UnixNano(),
+		deletions: deletions,
 	}
 	return &r, nil
 }
@@ -15,6 +15,7 @@ import (
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block"
 	"github.com/grafana/pyroscope/pkg/experiment/block/metadata"
 	"github.com/grafana/pyroscope/pkg/experiment/query_backend/query_plan"
@@ -264,6 +265,254 @@ func (s *testSuite) Test_QueryTimeSeries() {
 	s.Require().NotNil(resp.Reports[0].TimeSeries)
 }
 
+func (s *testSuite) Test_QueryTimeSeries_Aggregation() {
+	var minT, maxT int64
+	for _, b := range s.meta {
+		if minT == 0 || b.MinTime < minT {
+			minT = b.MinTime
+		}
+		maxT = max(maxT, b.MaxTime)
+	}
+	count := typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT
+	req := &queryv1.InvokeRequest{
+		StartTime: minT,
+		EndTime:   maxT,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_TIME_SERIES,
+			TimeSeries: &queryv1.TimeSeriesQuery{
+				GroupBy:     []string{"service_name"},
+				Step:        60,
+				Aggregation: &count,
+			},
+		}},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+
+	// All the points are retained: the aggregation
+	// is applied once the reports are merged.
+	series := resp.Reports[0].TimeSeries.TimeSeries
+	var profiles int
+	for _, x := range series {
+		profiles += len(x.Points)
+	}
+	s.Require().NotZero(profiles)
+
+	it := phlaremodel.NewTimeSeriesMergeIterator(series)
+	var total float64
+	for _, x := range phlaremodel.RangeSeries(it, minT, maxT+60000, 60000, &count) {
+		for _, p := range x.Points {
+			total += p.Value
+		}
+	}
+	s.Assert().Equal(float64(profiles), total)
+}
+
+func (s *testSuite) Test_QueryHeatmap() {
+	var minT, maxT int64
+	for _, b := range s.meta {
+		if minT == 0 || b.MinTime < minT {
+			minT = b.MinTime
+		}
+		maxT = max(maxT, b.MaxTime)
+	}
+	// The first time bucket is aligned to the step
+	// and is preceded by the step of data.
+	start := minT - minT%60000 - 60000
+	end := maxT - maxT%60000 + 60000
+	count := typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT
+	req := &queryv1.InvokeRequest{
+		StartTime: start,
+		EndTime:   end,
+		Query: []*queryv1.Query{
+			{
+				QueryType: queryv1.QueryType_QUERY_HEATMAP,
+				Heatmap: &queryv1.HeatmapQuery{
+					Step:            60,
+					ProfilesPerCell: 1,
+				},
+			},
+			{
+				QueryType: queryv1.QueryType_QUERY_TIME_SERIES,
+				TimeSeries: &queryv1.TimeSeriesQuery{
+					Step:        60,
+					Aggregation: &count,
+				},
+			},
+		},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 2)
+	// Reports are not ordered.
+	slices.SortFunc(resp.Reports, func(a, b *queryv1.Report) int {
+		return int(a.ReportType - b.ReportType)
+	})
+
+	var profiles int
+	for _, x := range resp.Reports[0].TimeSeries.TimeSeries {
+		for _, p := range x.Points {
+			if p.Value > 0 {
+				profiles++
+			}
+		}
+	}
+	s.Require().NotZero(profiles)
+
+	var total int64
+	for _, c := range resp.Reports[1].Heatmap.Cells {
+		s.Assert().Zero(c.Timestamp % 60000)
+		s.Assert().Greater(c.UpperBound, c.LowerBound)
+		s.Require().Len(c.Profiles, 1)
+		p := c.Profiles[0]
+		s.Assert().NotEmpty(phlaremodel.Labels(p.Labels).Get("service_name"))
+		s.Assert().Greater(p.Value, c.LowerBound)
+		s.Assert().LessOrEqual(p.Value, c.UpperBound)
+		s.Assert().Greater(p.Timestamp, c.Timestamp-60000)
+		s.Assert().LessOrEqual(p.Timestamp, c.Timestamp)
+		total += c.Count
+	}
+	s.Assert().Equal(int64(profiles), total)
+}
+
+func (s *testSuite) Test_QueryTopFunctions() {
+	resp, err := s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+		EndTime:       time.Now().UnixMilli(),
+		LabelSelector: "{}",
+		QueryPlan:     s.plan,
+		Query: []*queryv1.Query{
+			{
+				QueryType:    queryv1.QueryType_QUERY_TOP_FUNCTIONS,
+				TopFunctions: &queryv1.TopFunctionsQuery{},
+			},
+			{
+				QueryType: queryv1.QueryType_QUERY_TREE,
+				Tree:      &queryv1.TreeQuery{},
+			},
+		},
+		Tenant: s.tenant,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 2)
+	var functions []*typesv1.TopFunction
+	var tree *phlaremodel.Tree
+	for _, r := range resp.Reports {
+		switch r.ReportType {
+		case queryv1.ReportType_REPORT_TOP_FUNCTIONS:
+			functions = r.TopFunctions.Functions
+		case queryv1.ReportType_REPORT_TREE:
+			tree, err = phlaremodel.UnmarshalTree(r.Tree.Tree)
+			s.Require().NoError(err)
+		}
+	}
+	s.Require().NotNil(tree)
+
+	// The function values must match the values of the full tree.
+	expected := phlaremodel.NewFunctionValues[string]()
+	tree.IterateStacks(func(_ string, self int64, stack []string) {
+		// Skip the virtual root node of the unmarshalled tree.
+		expected.Add(stack[:len(stack)-1], self)
+	})
+
+	s.Require().Len(functions, expected.Len())
+	values := make(map[string]*typesv1.TopFunction, len(functions))
+	for _, f := range functions {
+		s.Assert().Empty(f.Filename)
+		s.Assert().Zero(f.Line)
+		values[f.Name] = f
+	}
+	expected.Range(func(name string, self, total int64) {
+		f, ok := values[name]
+		s.Require().True(ok, name)
+		s.Assert().Equal(self, f.Self, name)
+		s.Assert().Equal(total, f.Total, name)
+	})
+}
+
+func (s *testSuite) Test_QueryTopFunctions_Lines() {
+	query := func(granularity typesv1.FunctionGranularity) []*typesv1.TopFunction {
+		resp, err := s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+			EndTime:       time.Now().UnixMilli(),
+			LabelSelector: "{}",
+			QueryPlan:     s.plan,
+			Query: []*queryv1.Query{{
+				QueryType:    queryv1.QueryType_QUERY_TOP_FUNCTIONS,
+				TopFunctions: &queryv1.TopFunctionsQuery{Granularity: granularity},
+			}},
+			Tenant: s.tenant,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Reports, 1)
+		return resp.Reports[0].TopFunctions.Functions
+	}
+
+	functions := query(typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FUNCTION)
+	lines := query(typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE)
+	s.Require().Greater(len(lines), len(functions))
+
+	// Self values of the lines add up to the function self value.
+	self := make(map[string]int64)
+	for _, f := range lines {
+		self[f.Name] += f.Self
+	}
+	for _, f := range functions {
+		s.Assert().Equal(f.Self, self[f.Name], f.Name)
+	}
+}
+
+func (s *testSuite) Test_QueryCallersCallees() {
+	invoke := func(query *queryv1.Query) *queryv1.Report {
+		resp, err := s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+			EndTime:       time.Now().UnixMilli(),
+			LabelSelector: "{}",
+			QueryPlan:     s.plan,
+			Query:         []*queryv1.Query{query},
+			Tenant:        s.tenant,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Reports, 1)
+		return resp.Reports[0]
+	}
+
+	r := invoke(&queryv1.Query{
+		QueryType: queryv1.QueryType_QUERY_TREE,
+		Tree:      &queryv1.TreeQuery{},
+	})
+	tree, err := phlaremodel.UnmarshalTree(r.Tree.Tree)
+	s.Require().NoError(err)
+	// Pick a function that is not a root of the tree.
+	var function string
+	tree.IterateStacks(func(name string, _ int64, stack []string) {
+		if function == "" && len(stack) > 3 {
+			function = stack[1]
+		}
+	})
+	s.Require().NotEmpty(function)
+
+	r = invoke(&queryv1.Query{
+		QueryType:      queryv1.QueryType_QUERY_CALLERS_CALLEES,
+		CallersCallees: &queryv1.CallersCalleesQuery{Function: function},
+	})
+	callers, err := phlaremodel.UnmarshalTree(r.CallersCallees.Callers)
+	s.Require().NoError(err)
+	callees, err := phlaremodel.UnmarshalTree(r.CallersCallees.Callees)
+	s.Require().NoError(err)
+
+	expectedCallers, expectedCallees := tree.CallersCallees(function)
+	s.Assert().NotZero(expectedCallers.Total())
+	s.Assert().Equal(expectedCallers.String(), callers.String())
+	s.Assert().Equal(expectedCallees.String(), callees.String())
+}
+
 func (s *testSuite) Test_QueryTree_All_Tenant_Isolation() {
 	queryTenant := "some-tenant"
 
@@ -284,3 +533,260 @@ func (s *testSuite) Test_QueryTree_All_Tenant_Isolation() {
 	s.Require().NotNil(resp)
 	s.Require().Len(resp.Reports, 0)
 }
+
+func (s *testSuite) Test_QueryTimeSeries_SeriesDeletions() {
+	var minT, maxT int64
+	for _, b := range s.meta {
+		if minT == 0 || b.MinTime < minT {
+			minT = b.MinTime
+		}
+		maxT = max(maxT, b.MaxTime)
+	}
+	req := &queryv1.InvokeRequest{
+		StartTime: minT,
+		EndTime:   maxT,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_TIME_SERIES,
+			TimeSeries: &queryv1.TimeSeriesQuery{
+				GroupBy: []string{"service_name"},
+				Step:    1.0,
+			},
+		}},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	services := func(resp *queryv1.InvokeResponse) []string {
+		var names []string
+		for _, x := range resp.Reports[0].TimeSeries.TimeSeries {
+			names = append(names, phlaremodel.Labels(x.Labels).Get("service_name"))
+		}
+		return names
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Require().Contains(services(resp), "test-app")
+
+	for _, tenant := range s.tenant {
+		req.SeriesDeletions = append(req.SeriesDeletions, &metastorev1.SeriesDeletion{
+			Tenant:        tenant,
+			LabelSelector: `{service_name="test-app"}`,
+			StartTime:     req.StartTime,
+			EndTime:       req.EndTime,
+			CreatedAt:     time.Now().UnixMilli(),
+		})
+	}
+
+	resp, err = s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().NotContains(services(resp), "test-app")
+	s.Assert().NotEmpty(services(resp))
+}
+
+func (s *testSuite) timeRange() (minT, maxT int64) {
+	for _, b := range s.meta {
+		if minT == 0 || b.MinTime < minT {
+			minT = b.MinTime
+		}
+		maxT = max(maxT, b.MaxTime)
+	}
+	return minT, maxT
+}
+
+func (s *testSuite) seriesDeletions(selector string, start, end int64) []*metastorev1.SeriesDeletion {
+	deletions := make([]*metastorev1.SeriesDeletion, 0, len(s.tenant))
+	for _, tenant := range s.tenant {
+		deletions = append(deletions, &metastorev1.SeriesDeletion{
+			Tenant:        tenant,
+			LabelSelector: selector,
+			StartTime:     start,
+			EndTime:       end,
+			CreatedAt:     time.Now().UnixMilli(),
+		})
+	}
+	return deletions
+}
+
+func (s *testSuite) Test_QueryLabelNames_SeriesDeletions() {
+	minT, maxT := s.timeRange()
+	req := &queryv1.InvokeRequest{
+		StartTime: minT,
+		EndTime:   maxT,
+		Query: []*queryv1.Query{{
+			QueryType:  queryv1.QueryType_QUERY_LABEL_NAMES,
+			LabelNames: &queryv1.LabelNamesQuery{},
+		}},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Require().Contains(resp.Reports[0].LabelNames.LabelNames, "service_name")
+
+	// Deletions that do not cover the whole dataset time range
+	// do not affect label queries.
+	req.SeriesDeletions = s.seriesDeletions(`{service_name=~".+"}`, minT, minT)
+	resp, err = s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().Contains(resp.Reports[0].LabelNames.LabelNames, "service_name")
+
+	req.SeriesDeletions = s.seriesDeletions(`{service_name=~".+"}`, minT, maxT)
+	resp, err = s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().Empty(resp.Reports[0].LabelNames.LabelNames)
+}
+
+func (s *testSuite) Test_QueryLabelValues_SeriesDeletions() {
+	minT, maxT := s.timeRange()
+	req := &queryv1.InvokeRequest{
+		StartTime: minT,
+		EndTime:   maxT,
+		Query: []*queryv1.Query{{
+			QueryType:   queryv1.QueryType_QUERY_LABEL_VALUES,
+			LabelValues: &queryv1.LabelValuesQuery{LabelName: "service_name"},
+		}},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Require().Contains(resp.Reports[0].LabelValues.LabelValues, "test-app")
+
+	req.SeriesDeletions = s.seriesDeletions(`{service_name="test-app"}`, minT, minT)
+	resp, err = s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().Contains(resp.Reports[0].LabelValues.LabelValues, "test-app")
+
+	req.SeriesDeletions = s.seriesDeletions(`{service_name="test-app"}`, minT, maxT)
+	resp, err = s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().NotContains(resp.Reports[0].LabelValues.LabelValues, "test-app")
+	s.Assert().NotEmpty(resp.Reports[0].LabelValues.LabelValues)
+}
+
+func (s *testSuite) Test_QuerySeriesLabels_SeriesDeletions() {
+	minT, maxT := s.timeRange()
+	req := &queryv1.InvokeRequest{
+		StartTime: minT,
+		EndTime:   maxT,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_SERIES_LABELS,
+			SeriesLabels: &queryv1.SeriesLabelsQuery{
+				LabelNames: []string{"service_name"},
+			},
+		}},
+		QueryPlan:     s.plan,
+		LabelSelector: "{}",
+		Tenant:        s.tenant,
+	}
+
+	services := func(resp *queryv1.InvokeResponse) []string {
+		var names []string
+		for _, x := range resp.Reports[0].SeriesLabels.SeriesLabels {
+			names = append(names, phlaremodel.Labels(x.Labels).Get("service_name"))
+		}
+		return names
+	}
+
+	resp, err := s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Require().Contains(services(resp), "test-app")
+
+	req.SeriesDeletions = s.seriesDeletions(`{service_name="test-app"}`, minT, minT)
+	resp, err = s.reader.Invoke(s.ctx, req.CloneVT())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().Contains(services(resp), "test-app")
+
+	req.SeriesDeletions = s.seriesDeletions(`{service_name="test-app"}`, minT, maxT)
+	resp, err = s.reader.Invoke(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 1)
+	s.Assert().NotContains(services(resp), "test-app")
+	s.Assert().NotEmpty(services(resp))
+}
+
+func (s *testSuite) Test_QueryProfileTrees() {
+	resp, err := s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+		EndTime:       time.Now().UnixMilli(),
+		LabelSelector: `{service_name="test-app",__profile_type__="process_cpu:cpu:nanoseconds:cpu:nanoseconds"}`,
+		QueryPlan:     s.plan,
+		Query: []*queryv1.Query{{
+			QueryType:    queryv1.QueryType_QUERY_SERIES_LABELS,
+			SeriesLabels: &queryv1.SeriesLabelsQuery{},
+		}},
+		Tenant: s.tenant,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(resp.Reports[0].SeriesLabels.SeriesLabels)
+	series := resp.Reports[0].SeriesLabels.SeriesLabels[0].Labels
+	selector := phlaremodel.LabelPairsString(series)
+
+	resp, err = s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+		EndTime:       time.Now().UnixMilli(),
+		LabelSelector: selector,
+		QueryPlan:     s.plan,
+		Query: []*queryv1.Query{
+			{
+				QueryType:    queryv1.QueryType_QUERY_PROFILE_TREES,
+				ProfileTrees: &queryv1.ProfileTreesQuery{},
+			},
+			{
+				QueryType: queryv1.QueryType_QUERY_TREE,
+				Tree:      &queryv1.TreeQuery{},
+			},
+		},
+		Tenant: s.tenant,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Reports, 2)
+	slices.SortFunc(resp.Reports, func(a, b *queryv1.Report) int {
+		return int(a.ReportType - b.ReportType)
+	})
+
+	merged, err := phlaremodel.UnmarshalTree(resp.Reports[0].Tree.Tree)
+	s.Require().NoError(err)
+	report := resp.Reports[1].ProfileTrees
+	s.Require().Len(report.Series, 1)
+	s.Assert().ElementsMatch(series, report.Series[0].Labels)
+	s.Require().NotEmpty(report.Profiles)
+
+	var total int64
+	for i, p := range report.Profiles {
+		if i > 0 {
+			s.Assert().LessOrEqual(report.Profiles[i-1].Timestamp, p.Timestamp)
+		}
+		tree, err := phlaremodel.UnmarshalTree(p.Tree)
+		s.Require().NoError(err)
+		total += tree.Total()
+	}
+	s.Assert().Equal(merged.Total(), total)
+
+	// The query fails if more profiles are selected than allowed.
+	_, err = s.reader.Invoke(s.ctx, &queryv1.InvokeRequest{
+		EndTime:       time.Now().UnixMilli(),
+		LabelSelector: "{}",
+		QueryPlan:     s.plan,
+		Query: []*queryv1.Query{{
+			QueryType:    queryv1.QueryType_QUERY_PROFILE_TREES,
+			ProfileTrees: &queryv1.ProfileTreesQuery{Limit: 1},
+		}},
+		Tenant: s.tenant,
+	})
+	s.Require().ErrorContains(err, "the query selects more than 1 profiles")
+}
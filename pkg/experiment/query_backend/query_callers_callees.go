@@ -0,0 +1,111 @@
+package query_backend
+
+import (
+	"sync"
+
+	"github.com/grafana/dskit/runutil"
+
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+	"github.com/grafana/pyroscope/pkg/model"
+	parquetquery "github.com/grafana/pyroscope/pkg/phlaredb/query"
+	v1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+func init() {
+	registerQueryType(
+		queryv1.QueryType_QUERY_CALLERS_CALLEES,
+		queryv1.ReportType_REPORT_CALLERS_CALLEES,
+		queryCallersCallees,
+		newCallersCalleesAggregator,
+		[]block.Section{
+			block.SectionTSDB,
+			block.SectionProfiles,
+			block.SectionSymbols,
+		}...,
+	)
+}
+
+func queryCallersCallees(q *queryContext, query *queryv1.Query) (*queryv1.Report, error) {
+	entries, err := profileEntryIterator(q)
+	if err != nil {
+		return nil, err
+	}
+	defer runutil.CloseWithErrCapture(&err, entries, "failed to close profile entry iterator")
+
+	var columns v1.SampleColumns
+	if err = columns.Resolve(q.ds.Profiles().Schema()); err != nil {
+		return nil, err
+	}
+
+	profiles := parquetquery.NewRepeatedRowIterator(q.ctx, entries, q.ds.Profiles().RowGroups(),
+		columns.StacktraceID.ColumnIndex,
+		columns.Value.ColumnIndex,
+	)
+	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
+
+	// The tree is not truncated: the function
+	// may be called anywhere in the stacks.
+	resolver := symdb.NewResolver(q.ctx, q.ds.Symbols())
+	defer resolver.Release()
+
+	for profiles.Next() {
+		p := profiles.At()
+		resolver.AddSamplesFromParquetRow(p.Row.Partition, p.Values[0], p.Values[1])
+	}
+	if err = profiles.Err(); err != nil {
+		return nil, err
+	}
+
+	tree, err := resolver.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	maxNodes := query.CallersCallees.GetMaxNodes()
+	callers, callees := tree.CallersCallees(query.CallersCallees.GetFunction())
+	resp := &queryv1.Report{
+		CallersCallees: &queryv1.CallersCalleesReport{
+			Query:   query.CallersCallees.CloneVT(),
+			Callers: callers.Bytes(maxNodes),
+			Callees: callees.Bytes(maxNodes),
+		},
+	}
+	return resp, nil
+}
+
+type callersCalleesAggregator struct {
+	init    sync.Once
+	query   *queryv1.CallersCalleesQuery
+	callers *model.TreeMerger
+	callees *model.TreeMerger
+}
+
+func newCallersCalleesAggregator(*queryv1.InvokeRequest) aggregator {
+	return new(callersCalleesAggregator)
+}
+
+func (a *callersCalleesAggregator) aggregate(report *queryv1.Report) error {
+	r := report.CallersCallees
+	a.init.Do(func() {
+		a.callers = model.NewTreeMerger()
+		a.callees = model.NewTreeMerger()
+		a.query = r.Query.CloneVT()
+	})
+	if err := a.callers.MergeTreeBytes(r.Callers); err != nil {
+		return err
+	}
+	return a.callees.MergeTreeBytes(r.Callees)
+}
+
+func (a *callersCalleesAggregator) build() *queryv1.Report {
+	maxNodes := a.query.GetMaxNodes()
+	return &queryv1.Report{
+		CallersCallees: &queryv1.CallersCalleesReport{
+			Query:   a.query,
+			Callers: a.callers.Tree().Bytes(maxNodes),
+			Callees: a.callees.Tree().Bytes(maxNodes),
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package query_backend
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafana/dskit/runutil"
+	"github.com/prometheus/common/model"
+
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	parquetquery "github.com/grafana/pyroscope/pkg/phlaredb/query"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+func init() {
+	registerQueryType(
+		queryv1.QueryType_QUERY_HEATMAP,
+		queryv1.ReportType_REPORT_HEATMAP,
+		queryHeatmap,
+		newHeatmapAggregator,
+		[]block.Section{
+			block.SectionTSDB,
+			block.SectionProfiles,
+		}...,
+	)
+}
+
+func queryHeatmap(q *queryContext, query *queryv1.Query) (r *queryv1.Report, err error) {
+	// Series labels are only needed for profile references:
+	// in this case, the series are grouped by all the labels.
+	var groupBy []string
+	if query.Heatmap.ProfilesPerCell > 0 {
+		if groupBy, err = q.ds.Index().LabelNames(); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := profileEntryIterator(q, groupBy...)
+	if err != nil {
+		return nil, err
+	}
+	defer runutil.CloseWithErrCapture(&err, entries, "failed to close profile entry iterator")
+
+	column, err := schemav1.ResolveColumnByPath(q.ds.Profiles().Schema(), strings.Split("TotalValue", "."))
+	if err != nil {
+		return nil, err
+	}
+
+	rows := parquetquery.NewRepeatedRowIteratorBatchSize(
+		q.ctx,
+		entries,
+		q.ds.Profiles().RowGroups(),
+		bigBatchSize,
+		column.ColumnIndex,
+	)
+	defer runutil.CloseWithErrCapture(&err, rows, "failed to close column iterator")
+
+	builder := newHeatmapBuilder(
+		int64(model.TimeFromUnixNano(q.req.startTime)),
+		int64(model.TimeFromUnixNano(q.req.endTime)),
+		query.Heatmap,
+	)
+	for rows.Next() {
+		row := rows.At()
+		builder.Add(
+			row.Row.Labels,
+			int64(row.Row.Timestamp),
+			float64(row.Values[0][0].Int64()),
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	resp := &queryv1.Report{
+		Heatmap: &queryv1.HeatmapReport{
+			Query: query.Heatmap.CloneVT(),
+			Cells: builder.Build(),
+		},
+	}
+
+	return resp, nil
+}
+
+// newHeatmapBuilder creates a heatmap builder for the query time range.
+// As with time series, the time range includes the step preceding the
+// first time bucket.
+func newHeatmapBuilder(start, end int64, query *queryv1.HeatmapQuery) *phlaremodel.HeatmapBuilder {
+	step := time.Duration(query.Step * float64(time.Second)).Milliseconds()
+	return phlaremodel.NewHeatmapBuilder(start+step, end, step, int(query.ProfilesPerCell))
+}
+
+type heatmapAggregator struct {
+	init  sync.Once
+	query *queryv1.HeatmapQuery
+	cells *phlaremodel.HeatmapMerger
+}
+
+func newHeatmapAggregator(*queryv1.InvokeRequest) aggregator { return new(heatmapAggregator) }
+
+func (a *heatmapAggregator) aggregate(report *queryv1.Report) error {
+	r := report.Heatmap
+	a.init.Do(func() {
+		a.query = r.Query.CloneVT()
+		a.cells = phlaremodel.NewHeatmapMerger(int(a.query.ProfilesPerCell))
+	})
+	a.cells.MergeHeatmap(r.Cells)
+	return nil
+}
+
+func (a *heatmapAggregator) build() *queryv1.Report {
+	return &queryv1.Report{
+		Heatmap: &queryv1.HeatmapReport{
+			Query: a.query,
+			Cells: a.cells.Heatmap(),
+		},
+	}
+}
@@ -1,10 +1,14 @@
 package query_backend
 
 import (
+	"bytes"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/runutil"
 	"github.com/prometheus/prometheus/model/labels"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block"
@@ -41,9 +45,27 @@ func queryPprof(q *queryContext, query *queryv1.Query) (*queryv1.Report, error)
 		return nil, err
 	}
 
-	profiles := parquetquery.NewRepeatedRowIterator(q.ctx, entries, q.ds.Profiles().RowGroups(),
+	columnIndices := []int{
 		columns.StacktraceID.ColumnIndex,
-		columns.Value.ColumnIndex)
+		columns.Value.ColumnIndex,
+	}
+	// If the profile ID is specified, only the matching profile is
+	// merged. The ID column is read only for the selected rows.
+	var profileID []byte
+	if query.Pprof.ProfileId != "" {
+		id, err := uuid.Parse(query.Pprof.ProfileId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "failed to parse profile ID: "+err.Error())
+		}
+		c, err := v1.ResolveColumnByPath(q.ds.Profiles().Schema(), []string{v1.IDColumnName})
+		if err != nil {
+			return nil, err
+		}
+		profileID = id[:]
+		columnIndices = append(columnIndices, c.ColumnIndex)
+	}
+
+	profiles := parquetquery.NewRepeatedRowIterator(q.ctx, entries, q.ds.Profiles().RowGroups(), columnIndices...)
 	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
 
 	resolverOptions := make([]symdb.ResolverOption, 0)
@@ -57,6 +79,9 @@ func queryPprof(q *queryContext, query *queryv1.Query) (*queryv1.Report, error)
 
 	for profiles.Next() {
 		p := profiles.At()
+		if profileID != nil && !bytes.Equal(p.Values[2][0].ByteArray(), profileID) {
+			continue
+		}
 		resolver.AddSamplesFromParquetRow(p.Row.Partition, p.Values[0], p.Values[1])
 	}
 	if err = profiles.Err(); err != nil {
@@ -5,6 +5,7 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
 
+	"github.com/grafana/pyroscope/pkg/experiment/block"
 	"github.com/grafana/pyroscope/pkg/iter"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/phlaredb"
@@ -40,9 +41,18 @@ func profileEntryIterator(q *queryContext, groupBy ...string) (iter.Iterator[Pro
 		q.ds.Profiles().Column(q.ctx, "StacktracePartition", nil),
 	)
 
+	deleted, err := getDeletedSeries(q)
+	if err != nil {
+		return nil, err
+	}
+	var rows iter.Iterator[*parquetquery.IteratorResult] = results
+	if len(deleted) > 0 {
+		rows = &deletedProfilesFilter{Iterator: results, series: deleted}
+	}
+
 	buf := make([][]parquet.Value, 3)
 	entries := iter.NewAsyncBatchIterator[*parquetquery.IteratorResult, ProfileEntry](
-		results, bigBatchSize,
+		rows, bigBatchSize,
 		func(r *parquetquery.IteratorResult) ProfileEntry {
 			buf = r.Columns(buf,
 				schemav1.SeriesIndexColumnName,
@@ -62,6 +72,110 @@ func profileEntryIterator(q *queryContext, groupBy ...string) (iter.Iterator[Pro
 	return entries, nil
 }
 
+// getDeletedSeries returns the series deletions that apply to the
+// dataset, indexed by the series index.
+func getDeletedSeries(q *queryContext) (map[uint32][]*block.SeriesDeletion, error) {
+	deletions := q.req.deletions.Dataset(q.ds)
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+	deleted := make(map[uint32][]*block.SeriesDeletion)
+	for _, d := range deletions {
+		if d.EndTime < q.req.startTime || d.StartTime > q.req.endTime {
+			continue
+		}
+		ids, err := getSeriesIDs(q.ds.Index(), d.Matchers...)
+		if err != nil {
+			return nil, err
+		}
+		for id := range ids {
+			deleted[id] = append(deleted[id], d)
+		}
+	}
+	return deleted, nil
+}
+
+// getLabelPostings returns the postings of the series matching the query
+// selector. Label queries only read the index, therefore a series is only
+// excluded if it is deleted for the whole dataset time range within the
+// query time range: otherwise, some of its profiles might be still visible.
+func getLabelPostings(q *queryContext) (index.Postings, error) {
+	postings, err := getPostings(q.ds.Index(), q.req.matchers...)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := getDeletedPostings(q)
+	if err != nil {
+		_ = postings.Close()
+		return nil, err
+	}
+	if deleted == nil {
+		return postings, nil
+	}
+	return index.Without(postings, deleted), nil
+}
+
+// getDeletedPostings returns the postings of the series deleted for the
+// whole dataset time range within the query time range, or nil if there
+// are no such series.
+func getDeletedPostings(q *queryContext) (index.Postings, error) {
+	deletions := q.req.deletions.Dataset(q.ds)
+	if len(deletions) == 0 {
+		return nil, nil
+	}
+	md := q.ds.Metadata()
+	start := max(model.Time(md.MinTime).UnixNano(), q.req.startTime)
+	end := min(model.Time(md.MaxTime).UnixNano(), q.req.endTime)
+	var deleted []index.Postings
+	for _, d := range deletions {
+		if d.StartTime > start || d.EndTime < end {
+			continue
+		}
+		p, err := phlaredb.PostingsForMatchers(q.ds.Index(), nil, d.Matchers...)
+		if err != nil {
+			for _, x := range deleted {
+				_ = x.Close()
+			}
+			return nil, err
+		}
+		deleted = append(deleted, p)
+	}
+	if len(deleted) == 0 {
+		return nil, nil
+	}
+	return index.Merge(deleted...), nil
+}
+
+// deletedProfilesFilter skips profiles of the deleted series.
+// The iterator results must include the series index and
+// timestamp columns.
+type deletedProfilesFilter struct {
+	iter.Iterator[*parquetquery.IteratorResult]
+	series map[uint32][]*block.SeriesDeletion
+	buf    [][]parquet.Value
+}
+
+func (f *deletedProfilesFilter) Next() bool {
+	for f.Iterator.Next() {
+		if !f.deleted(f.At()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *deletedProfilesFilter) deleted(r *parquetquery.IteratorResult) bool {
+	f.buf = r.Columns(f.buf,
+		schemav1.SeriesIndexColumnName,
+		schemav1.TimeNanosColumnName)
+	for _, d := range f.series[f.buf[0][0].Uint32()] {
+		if d.Contains(f.buf[1][0].Int64()) {
+			return true
+		}
+	}
+	return false
+}
+
 type series struct {
 	fingerprint model.Fingerprint
 	labels      phlaremodel.Labels
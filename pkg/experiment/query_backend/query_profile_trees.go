@@ -0,0 +1,153 @@
+package query_backend
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/grafana/dskit/runutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+	"github.com/grafana/pyroscope/pkg/model"
+	parquetquery "github.com/grafana/pyroscope/pkg/phlaredb/query"
+	v1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+func init() {
+	registerQueryType(
+		queryv1.QueryType_QUERY_PROFILE_TREES,
+		queryv1.ReportType_REPORT_PROFILE_TREES,
+		queryProfileTrees,
+		newProfileTreesAggregator,
+		[]block.Section{
+			block.SectionTSDB,
+			block.SectionProfiles,
+			block.SectionSymbols,
+		}...,
+	)
+}
+
+func queryProfileTrees(q *queryContext, query *queryv1.Query) (r *queryv1.Report, err error) {
+	// The series of the profiles are reported
+	// for the caller to check the selector.
+	names, err := q.ds.Index().LabelNames()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := profileEntryIterator(q, names...)
+	if err != nil {
+		return nil, err
+	}
+	defer runutil.CloseWithErrCapture(&err, entries, "failed to close profile entry iterator")
+
+	var columns v1.SampleColumns
+	if err = columns.Resolve(q.ds.Profiles().Schema()); err != nil {
+		return nil, err
+	}
+
+	profiles := parquetquery.NewRepeatedRowIterator(q.ctx, entries, q.ds.Profiles().RowGroups(),
+		columns.StacktraceID.ColumnIndex,
+		columns.Value.ColumnIndex,
+	)
+	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
+
+	limit := query.ProfileTrees.Limit
+	series := make(map[uint64]*typesv1.Labels)
+	var trees []*queryv1.ProfileTree
+	for profiles.Next() {
+		p := profiles.At()
+		if limit > 0 && int64(len(trees)) >= limit {
+			return nil, tooManyProfilesError(limit)
+		}
+		if _, ok := series[uint64(p.Row.Fingerprint)]; !ok {
+			series[uint64(p.Row.Fingerprint)] = &typesv1.Labels{Labels: p.Row.Labels}
+		}
+		// The tree of each profile is built separately and is not truncated.
+		resolver := symdb.NewResolver(q.ctx, q.ds.Symbols())
+		resolver.AddSamplesFromParquetRow(p.Row.Partition, p.Values[0], p.Values[1])
+		tree, err := resolver.Tree()
+		resolver.Release()
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, &queryv1.ProfileTree{
+			Timestamp: int64(p.Row.Timestamp),
+			Tree:      tree.Bytes(0),
+		})
+	}
+	if err = profiles.Err(); err != nil {
+		return nil, err
+	}
+
+	sortProfileTrees(trees)
+	resp := &queryv1.Report{
+		ProfileTrees: &queryv1.ProfileTreesReport{
+			Query:    query.ProfileTrees.CloneVT(),
+			Series:   seriesLabels(series),
+			Profiles: trees,
+		},
+	}
+	return resp, nil
+}
+
+func tooManyProfilesError(limit int64) error {
+	return status.Errorf(codes.InvalidArgument, "the query selects more than %d profiles: narrow the time range", limit)
+}
+
+func sortProfileTrees(trees []*queryv1.ProfileTree) {
+	slices.SortFunc(trees, func(a, b *queryv1.ProfileTree) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+}
+
+func seriesLabels(series map[uint64]*typesv1.Labels) []*typesv1.Labels {
+	labels := make([]*typesv1.Labels, 0, len(series))
+	for _, s := range series {
+		labels = append(labels, s)
+	}
+	return labels
+}
+
+type profileTreesAggregator struct {
+	init     sync.Once
+	query    *queryv1.ProfileTreesQuery
+	mu       sync.Mutex
+	series   *model.LabelMerger
+	profiles []*queryv1.ProfileTree
+}
+
+func newProfileTreesAggregator(*queryv1.InvokeRequest) aggregator {
+	return new(profileTreesAggregator)
+}
+
+func (a *profileTreesAggregator) aggregate(report *queryv1.Report) error {
+	r := report.ProfileTrees
+	a.init.Do(func() {
+		a.query = r.Query.CloneVT()
+		a.series = model.NewLabelMerger()
+	})
+	a.series.MergeSeries(r.Series)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.profiles = append(a.profiles, r.Profiles...)
+	if limit := a.query.Limit; limit > 0 && int64(len(a.profiles)) > limit {
+		return tooManyProfilesError(limit)
+	}
+	return nil
+}
+
+func (a *profileTreesAggregator) build() *queryv1.Report {
+	sortProfileTrees(a.profiles)
+	return &queryv1.Report{
+		ProfileTrees: &queryv1.ProfileTreesReport{
+			Query:    a.query,
+			Series:   a.series.Labels(),
+			Profiles: a.profiles,
+		},
+	}
+}
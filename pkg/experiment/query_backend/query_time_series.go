@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/runutil"
 	"github.com/parquet-go/parquet-go"
 
@@ -30,7 +31,13 @@ func init() {
 }
 
 func queryTimeSeries(q *queryContext, query *queryv1.Query) (r *queryv1.Report, err error) {
-	entries, err := profileEntryIterator(q, query.TimeSeries.GroupBy...)
+	// Exemplars refer to the profile series, therefore the series
+	// fingerprints are needed; the builder groups the series anyway.
+	groupBy := query.TimeSeries.GroupBy
+	if query.TimeSeries.IncludeExemplars {
+		groupBy = nil
+	}
+	entries, err := profileEntryIterator(q, groupBy...)
 	if err != nil {
 		return nil, err
 	}
@@ -45,20 +52,34 @@ func queryTimeSeries(q *queryContext, query *queryv1.Query) (r *queryv1.Report,
 	annotationKeysColumn, _ := schemav1.ResolveColumnByPath(q.ds.Profiles().Schema(), schemav1.AnnotationKeyColumnPath)
 	annotationValuesColumn, _ := schemav1.ResolveColumnByPath(q.ds.Profiles().Schema(), schemav1.AnnotationValueColumnPath)
 
+	columns := []int{
+		column.ColumnIndex,
+		annotationKeysColumn.ColumnIndex,
+		annotationValuesColumn.ColumnIndex,
+	}
+	idColumn := -1
+	if query.TimeSeries.IncludeExemplars {
+		c, err := schemav1.ResolveColumnByPath(q.ds.Profiles().Schema(), []string{schemav1.IDColumnName})
+		if err != nil {
+			return nil, err
+		}
+		idColumn = c.ColumnIndex
+		columns = append(columns, idColumn)
+	}
+
 	rows := parquetquery.NewRepeatedRowIteratorBatchSize(
 		q.ctx,
 		entries,
 		q.ds.Profiles().RowGroups(),
 		bigBatchSize,
-		column.ColumnIndex,
-		annotationKeysColumn.ColumnIndex,
-		annotationValuesColumn.ColumnIndex,
+		columns...,
 	)
 	defer runutil.CloseWithErrCapture(&err, rows, "failed to close column iterator")
 
 	builder := phlaremodel.NewTimeSeriesBuilder(query.TimeSeries.GroupBy...)
 	for rows.Next() {
 		row := rows.At()
+		var profileID string
 		annotations := schemav1.Annotations{
 			Keys:   make([]string, 0),
 			Values: make([]string, 0),
@@ -70,6 +91,11 @@ func queryTimeSeries(q *queryContext, query *queryv1.Query) (r *queryv1.Report,
 			if e[0].Column() == annotationValuesColumn.ColumnIndex && e[0].Kind() == parquet.ByteArray {
 				annotations.Values = append(annotations.Values, e[0].String())
 			}
+			if e[0].Column() == idColumn {
+				if id, err := uuid.FromBytes(e[0].ByteArray()); err == nil {
+					profileID = id.String()
+				}
+			}
 		}
 		builder.Add(
 			row.Row.Fingerprint,
@@ -77,6 +103,7 @@ func queryTimeSeries(q *queryContext, query *queryv1.Query) (r *queryv1.Report,
 			int64(row.Row.Timestamp),
 			float64(row.Values[0][0].Int64()),
 			annotations,
+			profileID,
 		)
 	}
 	if err = rows.Err(); err != nil {
@@ -111,17 +138,26 @@ func newTimeSeriesAggregator(req *queryv1.InvokeRequest) aggregator {
 func (a *timeSeriesAggregator) aggregate(report *queryv1.Report) error {
 	r := report.TimeSeries
 	a.init.Do(func() {
-		a.series = phlaremodel.NewTimeSeriesMerger(true)
 		a.query = r.Query.CloneVT()
+		a.series = phlaremodel.NewTimeSeriesMerger(phlaremodel.IsSummableAggregation(a.query.Aggregation))
 	})
 	a.series.MergeTimeSeries(r.TimeSeries)
 	return nil
 }
 
 func (a *timeSeriesAggregator) build() *queryv1.Report {
-	// TODO(kolesnikovae): Average aggregation should be implemented in
-	//  the way that it can be distributed (count + sum), and should be done
-	//  at "aggregate" call.
+	if !phlaremodel.IsSummableAggregation(a.query.Aggregation) {
+		// The aggregation can't be distributed: all the points are
+		// retained, and the aggregation is applied by the caller,
+		// once all the reports are merged.
+		return &queryv1.Report{
+			TimeSeries: &queryv1.TimeSeriesReport{
+				Query:      a.query,
+				TimeSeries: a.series.TimeSeries(),
+			},
+		}
+	}
+	// Rate is calculated by the caller from the sum.
 	sum := typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM
 	stepMilli := time.Duration(a.query.GetStep() * float64(time.Second)).Milliseconds()
 	seriesIterator := phlaremodel.NewTimeSeriesMergeIterator(a.series.TimeSeries())
@@ -0,0 +1,100 @@
+package query_backend
+
+import (
+	"sync"
+
+	"github.com/grafana/dskit/runutil"
+
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/block"
+	"github.com/grafana/pyroscope/pkg/model"
+	parquetquery "github.com/grafana/pyroscope/pkg/phlaredb/query"
+	v1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+func init() {
+	registerQueryType(
+		queryv1.QueryType_QUERY_TOP_FUNCTIONS,
+		queryv1.ReportType_REPORT_TOP_FUNCTIONS,
+		queryTopFunctions,
+		newTopFunctionsAggregator,
+		[]block.Section{
+			block.SectionTSDB,
+			block.SectionProfiles,
+			block.SectionSymbols,
+		}...,
+	)
+}
+
+func queryTopFunctions(q *queryContext, query *queryv1.Query) (*queryv1.Report, error) {
+	entries, err := profileEntryIterator(q)
+	if err != nil {
+		return nil, err
+	}
+	defer runutil.CloseWithErrCapture(&err, entries, "failed to close profile entry iterator")
+
+	var columns v1.SampleColumns
+	if err = columns.Resolve(q.ds.Profiles().Schema()); err != nil {
+		return nil, err
+	}
+
+	profiles := parquetquery.NewRepeatedRowIterator(q.ctx, entries, q.ds.Profiles().RowGroups(),
+		columns.StacktraceID.ColumnIndex,
+		columns.Value.ColumnIndex,
+	)
+	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
+
+	resolver := symdb.NewResolver(q.ctx, q.ds.Symbols())
+	defer resolver.Release()
+
+	for profiles.Next() {
+		p := profiles.At()
+		resolver.AddSamplesFromParquetRow(p.Row.Partition, p.Values[0], p.Values[1])
+	}
+	if err = profiles.Err(); err != nil {
+		return nil, err
+	}
+
+	functions, err := resolver.TopFunctions(query.TopFunctions.GetGranularity())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &queryv1.Report{
+		TopFunctions: &queryv1.TopFunctionsReport{
+			Query:     query.TopFunctions.CloneVT(),
+			Functions: functions,
+		},
+	}
+	return resp, nil
+}
+
+type topFunctionsAggregator struct {
+	init      sync.Once
+	query     *queryv1.TopFunctionsQuery
+	functions *model.TopFunctionsMerger
+}
+
+func newTopFunctionsAggregator(*queryv1.InvokeRequest) aggregator {
+	return new(topFunctionsAggregator)
+}
+
+func (a *topFunctionsAggregator) aggregate(report *queryv1.Report) error {
+	r := report.TopFunctions
+	a.init.Do(func() {
+		a.functions = model.NewTopFunctionsMerger()
+		a.query = r.Query.CloneVT()
+	})
+	a.functions.MergeTopFunctions(r.Functions)
+	return nil
+}
+
+func (a *topFunctionsAggregator) build() *queryv1.Report {
+	return &queryv1.Report{
+		TopFunctions: &queryv1.TopFunctionsReport{
+			Query:     a.query,
+			Functions: a.functions.TopFunctions(),
+		},
+	}
+}
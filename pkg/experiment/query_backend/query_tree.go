@@ -49,6 +49,15 @@ func queryTree(q *queryContext, query *queryv1.Query) (*queryv1.Report, error) {
 		columns.Value.ColumnIndex,
 	}
 	if len(spanSelector) > 0 {
+		if !columns.HasSpanID() {
+			// None of the samples can match the span selector.
+			return &queryv1.Report{
+				Tree: &queryv1.TreeReport{
+					Query: query.Tree.CloneVT(),
+					Tree:  new(model.Tree).Bytes(query.Tree.GetMaxNodes()),
+				},
+			}, nil
+		}
 		indices = append(indices, columns.SpanID.ColumnIndex)
 	}
 
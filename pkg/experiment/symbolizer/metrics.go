@@ -46,6 +46,9 @@ type metrics struct {
 	// Debug symbol resolution metrics
 	debugSymbolResolution       *prometheus.HistogramVec
 	debugSymbolResolutionErrors *prometheus.CounterVec
+
+	// Debug info upload metrics
+	debuginfoUploads *prometheus.CounterVec
 }
 
 func newMetrics(reg prometheus.Registerer) *metrics {
@@ -95,6 +98,14 @@ func newMetrics(reg prometheus.Registerer) *metrics {
 			},
 			[]string{"error_type"},
 		),
+		// debug info upload metrics
+		debuginfoUploads: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "pyroscope_symbolizer_debuginfo_uploads_total",
+				Help: "Total number of debug info uploads by format and status",
+			},
+			[]string{"format", "status"},
+		),
 	}
 
 	if reg != nil {
@@ -117,6 +128,7 @@ func (m *metrics) register() {
 		m.profileSymbolization,
 		m.debugSymbolResolution,
 		m.debugSymbolResolutionErrors,
+		m.debuginfoUploads,
 	}
 
 	for _, collector := range collectors {
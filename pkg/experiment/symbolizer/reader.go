@@ -80,8 +80,10 @@ func (m *memoryBuffer) Bytes() []byte {
 	return m.data
 }
 
-// detectCompression checks if data is compressed and decompresses it if needed
-func detectCompression(data []byte) ([]byte, error) {
+// detectCompression checks if data is compressed and decompresses it if needed.
+// Decompressed data larger than maxSize bytes is rejected; maxSize <= 0 means
+// no limit.
+func detectCompression(data []byte, maxSize int64) ([]byte, error) {
 	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
 		r, err := gzip.NewReader(bytes.NewReader(data))
 		if err != nil {
@@ -89,7 +91,7 @@ func detectCompression(data []byte) ([]byte, error) {
 		}
 		defer r.Close()
 
-		decompressed, err := io.ReadAll(r)
+		decompressed, err := readAllLimited(r, maxSize)
 		if err != nil {
 			return nil, fmt.Errorf("decompress gzip data: %w", err)
 		}
@@ -105,7 +107,7 @@ func detectCompression(data []byte) ([]byte, error) {
 		}
 		defer r.Close()
 
-		decompressed, err := io.ReadAll(r)
+		decompressed, err := readAllLimited(r, maxSize)
 		if err != nil {
 			return nil, fmt.Errorf("decompress zstd data: %w", err)
 		}
@@ -115,3 +117,18 @@ func detectCompression(data []byte) ([]byte, error) {
 
 	return data, nil
 }
+
+// readAllLimited reads r until EOF, failing if it yields more than maxSize bytes.
+func readAllLimited(r io.Reader, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		return io.ReadAll(r)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("decompressed size exceeds the limit of %d bytes", maxSize)
+	}
+	return data, nil
+}
@@ -12,8 +12,10 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/dgraph-io/ristretto/v2"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/grafana/dskit/tenant"
 	"github.com/prometheus/client_golang/prometheus"
 
 	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
@@ -27,17 +29,32 @@ type DebuginfodClient interface {
 
 type Config struct {
 	DebuginfodURL string `yaml:"debuginfod_url"`
+	MaxUploadSize int64  `yaml:"max_upload_size"`
 }
 
 func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	f.StringVar(&cfg.DebuginfodURL, "symbolizer.debuginfod-url", "https://debuginfod.elfutils.org", "URL of the debuginfod server")
+	f.Int64Var(&cfg.MaxUploadSize, "symbolizer.max-upload-size", 1<<30, "Maximum size in bytes of a debug info file uploaded by a tenant, both compressed and decompressed")
 }
 
+const (
+	uploadedNotFoundCacheMaxItems = 100000
+	// Uploads made through other instances are only visible
+	// once the cached miss expires.
+	uploadedNotFoundCacheTTL = 5 * time.Minute
+)
+
 type Symbolizer struct {
+	cfg     Config
 	logger  log.Logger
 	client  DebuginfodClient
 	bucket  objstore.Bucket
 	metrics *metrics
+
+	// Tenant debug info that was not found in the object store, keyed by
+	// the object path. Uploads are rare, so the cache saves a bucket
+	// request per symbolization for the vast majority of build IDs.
+	uploadedNotFoundCache *ristretto.Cache[string, bool]
 }
 
 func New(logger log.Logger, cfg Config, reg prometheus.Registerer, bucket objstore.Bucket) (*Symbolizer, error) {
@@ -48,11 +65,22 @@ func New(logger log.Logger, cfg Config, reg prometheus.Registerer, bucket objsto
 		return nil, err
 	}
 
+	uploadedNotFoundCache, err := ristretto.NewCache(&ristretto.Config[string, bool]{
+		NumCounters: uploadedNotFoundCacheMaxItems * 10,
+		MaxCost:     uploadedNotFoundCacheMaxItems,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create uploaded debug info not-found cache: %w", err)
+	}
+
 	return &Symbolizer{
-		logger:  logger,
-		client:  client,
-		bucket:  bucket,
-		metrics: metrics,
+		cfg:                   cfg,
+		logger:                logger,
+		client:                client,
+		bucket:                bucket,
+		metrics:               metrics,
+		uploadedNotFoundCache: uploadedNotFoundCache,
 	}, nil
 }
 
@@ -226,14 +254,16 @@ func (s *Symbolizer) updateAllSymbolsInProfile(
 				maxFuncID++
 				funcID = maxFuncID
 				profile.Function = append(profile.Function, &googlev1.Function{
-					Id:   funcID,
-					Name: nameIdx,
+					Id:       funcID,
+					Name:     nameIdx,
+					Filename: filenameIdx,
 				})
 				funcMap[key] = funcID
 			}
 
 			profile.Location[locIdx].Line[j] = &googlev1.Line{
 				FunctionId: funcID,
+				Line:       int64(line.LineNumber),
 			}
 		}
 
@@ -294,6 +324,13 @@ func (s *Symbolizer) symbolizeWithTable(table *lidia.Table, req *request) {
 }
 
 func (s *Symbolizer) getLidiaBytes(ctx context.Context, buildID string) ([]byte, error) {
+	// Debug info uploaded by the tenant takes precedence.
+	if tenantIDs, err := tenant.TenantIDs(ctx); err == nil {
+		if lidiaBytes, ok := s.fetchUploadedLidia(ctx, tenantIDs, buildID); ok {
+			return lidiaBytes, nil
+		}
+	}
+
 	if client, ok := s.client.(*DebuginfodHTTPClient); ok {
 		if found, _ := client.notFoundCache.Get(buildID); found {
 			return nil, buildIDNotFoundError{buildID: buildID}
@@ -375,7 +412,7 @@ func (s *Symbolizer) fetchFromDebuginfod(ctx context.Context, buildID string) (i
 }
 
 func (s *Symbolizer) processELFData(data []byte) (lidiaData []byte, err error) {
-	decompressedData, err := detectCompression(data)
+	decompressedData, err := detectCompression(data, s.cfg.MaxUploadSize)
 	if err != nil {
 		s.metrics.debugSymbolResolutionErrors.WithLabelValues("compression_error").Inc()
 		return nil, fmt.Errorf("detect compression: %w", err)
@@ -94,6 +94,48 @@ func TestSymbolizePprof(t *testing.T) {
 				assertLocationHasFunction(t, p, p.Location[0], "main", "main")
 			},
 		},
+		{
+			name: "inlined functions",
+			profile: &googlev1.Profile{
+				Mapping: []*googlev1.Mapping{{
+					BuildId:     1,
+					MemoryStart: 0x0,
+					MemoryLimit: 0x1000000,
+					FileOffset:  0x0,
+				}},
+				Location: []*googlev1.Location{{
+					Id:        1,
+					MappingId: 1,
+					Address:   0x2745,
+				}},
+				StringTable: []string{"", "build-id"},
+			},
+			setupMock: func(mockClient *mocksymbolizer.MockDebuginfodClient, mockBucket *mockobjstore.MockBucket) {
+				mockClient.On("FetchDebuginfo", mock.Anything, "build-id").Return(openTestFile(t), nil).Once()
+				mockBucket.On("Get", mock.Anything, "build-id").Return(nil, fmt.Errorf("not found")).Once()
+				mockBucket.On("Upload", mock.Anything, "build-id", mock.Anything).Return(nil).Once()
+			},
+			validate: func(t *testing.T, p *googlev1.Profile) {
+				require.True(t, p.Mapping[0].HasFunctions)
+
+				// fprintf is inlined into main.
+				lines := p.Location[0].Line
+				require.Len(t, lines, 2)
+				for i, expected := range []struct {
+					function string
+					file     string
+					line     int64
+				}{
+					{"fprintf", "/usr/include/x86_64-linux-gnu/bits/stdio2.h", 79},
+					{"main", "/usr/src/stress-1.0.7-1/src/stress.c", 442},
+				} {
+					fn := p.Function[lines[i].FunctionId-1]
+					require.Equal(t, expected.function, p.StringTable[fn.Name])
+					require.Equal(t, expected.file, p.StringTable[fn.Filename])
+					require.Equal(t, expected.line, lines[i].Line)
+				}
+			},
+		},
 		{
 			name: "empty build ID",
 			profile: &googlev1.Profile{
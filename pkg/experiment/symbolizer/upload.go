@@ -0,0 +1,190 @@
+package symbolizer
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/go-kit/log/level"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/tenant"
+
+	"github.com/grafana/pyroscope/lidia"
+)
+
+const (
+	uploadFormatELF   = "elf"
+	uploadFormatLidia = "lidia"
+
+	// Uploaded debug info is stored per tenant, separately
+	// from the debug info fetched from debuginfod.
+	uploadedDebuginfoPrefix = "tenants"
+)
+
+type invalidDebuginfoError struct {
+	err error
+}
+
+func (e invalidDebuginfoError) Error() string {
+	return fmt.Sprintf("invalid debug info: %v", e.err)
+}
+
+func (e invalidDebuginfoError) Unwrap() error { return e.err }
+
+func uploadedDebuginfoPath(tenantID, buildID string) string {
+	return path.Join(uploadedDebuginfoPrefix, tenantID, buildID)
+}
+
+// UploadHandler returns the HTTP handler that accepts debug info uploads:
+// the request body is either an ELF file or a lidia table (optionally
+// gzip or zstd compressed), the build ID is specified in the path.
+func (s *Symbolizer) UploadHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantID, err := tenant.TenantID(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		buildID, err := sanitizeBuildID(mux.Vars(r)["build_id"])
+		if err != nil || buildID == "" {
+			http.Error(w, "invalid build ID", http.StatusBadRequest)
+			return
+		}
+		body := http.MaxBytesReader(w, r.Body, s.cfg.MaxUploadSize)
+		data, err := io.ReadAll(body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err = s.Upload(r.Context(), tenantID, buildID, data); err != nil {
+			var invalidErr invalidDebuginfoError
+			if errors.As(err, &invalidErr) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			level.Error(s.logger).Log("msg", "failed to upload debug info", "tenant", tenantID, "buildID", buildID, "err", err)
+			http.Error(w, "failed to upload debug info", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// Upload stores the tenant debug info for the build ID. ELF files are
+// converted to lidia tables; the build ID of the ELF file, if present,
+// must match the given one.
+func (s *Symbolizer) Upload(ctx context.Context, tenantID, buildID string, data []byte) (err error) {
+	format := uploadFormatLidia
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusErrorOther
+			var invalidErr invalidDebuginfoError
+			if errors.As(err, &invalidErr) {
+				status = statusErrorPrefix + "invalid_debuginfo"
+			}
+		}
+		s.metrics.debuginfoUploads.WithLabelValues(format, status).Inc()
+	}()
+
+	data, err = detectCompression(data, s.cfg.MaxUploadSize)
+	if err != nil {
+		return invalidDebuginfoError{err: err}
+	}
+
+	var lidiaData []byte
+	if bytes.HasPrefix(data, []byte(elf.ELFMAG)) {
+		format = uploadFormatELF
+		if lidiaData, err = s.uploadedELFToLidia(buildID, data); err != nil {
+			return err
+		}
+	} else {
+		table, err := lidia.OpenReader(NewReaderAtCloser(data), lidia.WithCRC())
+		if err != nil {
+			return invalidDebuginfoError{err: fmt.Errorf("neither ELF nor lidia: %w", err)}
+		}
+		table.Close()
+		lidiaData = data
+	}
+
+	objectPath := uploadedDebuginfoPath(tenantID, buildID)
+	if err = s.bucket.Upload(ctx, objectPath, bytes.NewReader(lidiaData)); err != nil {
+		return err
+	}
+	s.uploadedNotFoundCache.Del(objectPath)
+	return nil
+}
+
+func (s *Symbolizer) uploadedELFToLidia(buildID string, data []byte) ([]byte, error) {
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, invalidDebuginfoError{err: fmt.Errorf("parse ELF file: %w", err)}
+	}
+	fileBuildID, err := GNUBuildID(f)
+	_ = f.Close()
+	if err != nil {
+		return nil, invalidDebuginfoError{err: err}
+	}
+	if fileBuildID != "" && fileBuildID != buildID {
+		return nil, invalidDebuginfoError{err: fmt.Errorf("build ID mismatch: ELF file has build ID %s", fileBuildID)}
+	}
+	return s.processELFData(data)
+}
+
+// GNUBuildID returns the GNU build ID of the ELF file,
+// or an empty string if the file does not have one.
+func GNUBuildID(f *elf.File) (string, error) {
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		return "", nil
+	}
+	data, err := section.Data()
+	if err != nil {
+		return "", fmt.Errorf("read build ID note: %w", err)
+	}
+	// Note header: name size, description size, and type;
+	// the name and the description are 4-byte aligned.
+	const noteHeaderSize = 12
+	if len(data) < noteHeaderSize {
+		return "", fmt.Errorf("malformed build ID note")
+	}
+	nameSize := f.ByteOrder.Uint32(data[0:4])
+	descSize := f.ByteOrder.Uint32(data[4:8])
+	descOffset := noteHeaderSize + (uint64(nameSize)+3)&^3
+	if descOffset+uint64(descSize) > uint64(len(data)) {
+		return "", fmt.Errorf("malformed build ID note")
+	}
+	return hex.EncodeToString(data[descOffset : descOffset+uint64(descSize)]), nil
+}
+
+// fetchUploadedLidia retrieves the lidia table uploaded by any of the tenants.
+func (s *Symbolizer) fetchUploadedLidia(ctx context.Context, tenantIDs []string, buildID string) ([]byte, bool) {
+	for _, tenantID := range tenantIDs {
+		objectPath := uploadedDebuginfoPath(tenantID, buildID)
+		if found, _ := s.uploadedNotFoundCache.Get(objectPath); found {
+			s.metrics.cacheOperations.WithLabelValues("uploaded_not_found", "get", statusSuccess).Inc()
+			continue
+		}
+		data, err := s.fetchLidiaFromObjectStore(ctx, objectPath)
+		if err == nil {
+			return data, true
+		}
+		if s.bucket.IsObjNotFoundErr(err) {
+			s.uploadedNotFoundCache.SetWithTTL(objectPath, true, 1, uploadedNotFoundCacheTTL)
+			continue
+		}
+		level.Warn(s.logger).Log("msg", "failed to fetch uploaded debug info", "tenant", tenantID, "buildID", buildID, "err", err)
+	}
+	return nil, false
+}
@@ -0,0 +1,139 @@
+package symbolizer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/user"
+	"github.com/stretchr/testify/require"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/objstore/providers/memory"
+	"github.com/grafana/pyroscope/pkg/test/mocks/mocksymbolizer"
+)
+
+const testBuildID = "2fa2055ef20fabc972d5751147e093275514b142"
+
+func newUploadTestSymbolizer(t *testing.T) *Symbolizer {
+	cache, err := ristretto.NewCache(&ristretto.Config[string, bool]{
+		NumCounters: 1000,
+		MaxCost:     100,
+		BufferItems: 64,
+	})
+	require.NoError(t, err)
+	return &Symbolizer{
+		cfg:                   Config{MaxUploadSize: 1 << 20},
+		logger:                log.NewNopLogger(),
+		client:                mocksymbolizer.NewMockDebuginfodClient(t),
+		bucket:                objstore.NewBucket(memory.NewInMemBucket()),
+		metrics:               newMetrics(nil),
+		uploadedNotFoundCache: cache,
+	}
+}
+
+func gzipData(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestUploadHandler(t *testing.T) {
+	elfData, err := os.ReadFile("testdata/symbols.debug")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		tenantID string
+		buildID  string
+		body     []byte
+		status   int
+	}{
+		{name: "elf", tenantID: "tenant-a", buildID: testBuildID, body: elfData, status: http.StatusOK},
+		{name: "build id mismatch", tenantID: "tenant-a", buildID: "0123456789abcdef", body: elfData, status: http.StatusBadRequest},
+		{name: "invalid build id", tenantID: "tenant-a", buildID: "build.id", body: elfData, status: http.StatusBadRequest},
+		{name: "unknown format", tenantID: "tenant-a", buildID: testBuildID, body: []byte("not a binary"), status: http.StatusBadRequest},
+		{name: "too large", tenantID: "tenant-a", buildID: testBuildID, body: make([]byte, 2<<20), status: http.StatusRequestEntityTooLarge},
+		{name: "gzip", tenantID: "tenant-a", buildID: testBuildID, body: gzipData(t, elfData), status: http.StatusOK},
+		{name: "too large decompressed", tenantID: "tenant-a", buildID: testBuildID, body: gzipData(t, make([]byte, 2<<20)), status: http.StatusBadRequest},
+		{name: "no tenant", buildID: testBuildID, body: elfData, status: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newUploadTestSymbolizer(t)
+			router := mux.NewRouter()
+			router.Handle("/debuginfo/upload/{build_id}", s.UploadHandler())
+
+			req := httptest.NewRequest(http.MethodPost, "/debuginfo/upload/"+tc.buildID, bytes.NewReader(tc.body))
+			if tc.tenantID != "" {
+				req = req.WithContext(user.InjectOrgID(req.Context(), tc.tenantID))
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+
+			exists, err := s.bucket.Exists(context.Background(), uploadedDebuginfoPath(tc.tenantID, tc.buildID))
+			require.NoError(t, err)
+			require.Equal(t, tc.status == http.StatusOK, exists)
+		})
+	}
+}
+
+func TestSymbolizeWithUploadedDebuginfo(t *testing.T) {
+	elfData, err := os.ReadFile("testdata/symbols.debug")
+	require.NoError(t, err)
+
+	s := newUploadTestSymbolizer(t)
+	require.NoError(t, s.Upload(context.Background(), "tenant-a", testBuildID, elfData))
+
+	// Lidia tables are stored as is.
+	lidiaData, err := s.fetchLidiaFromObjectStore(context.Background(), uploadedDebuginfoPath("tenant-a", testBuildID))
+	require.NoError(t, err)
+	require.NoError(t, s.Upload(context.Background(), "tenant-b", testBuildID, lidiaData))
+
+	for _, tenantID := range []string{"tenant-a", "tenant-b"} {
+		profile := &googlev1.Profile{
+			Mapping:     []*googlev1.Mapping{{Id: 1, BuildId: 1}},
+			Location:    []*googlev1.Location{{Id: 1, MappingId: 1, Address: 0x1500}},
+			StringTable: []string{"", testBuildID},
+		}
+		// The debuginfod client mock has no expectations:
+		// the uploaded debug info must be used.
+		ctx := user.InjectOrgID(context.Background(), tenantID)
+		require.NoError(t, s.SymbolizePprof(ctx, profile))
+		require.True(t, profile.Mapping[0].HasFunctions)
+		assertLocationHasFunction(t, profile, profile.Location[0], "main", "main")
+	}
+}
+
+func TestUploadedDebuginfoNotFoundCache(t *testing.T) {
+	elfData, err := os.ReadFile("testdata/symbols.debug")
+	require.NoError(t, err)
+
+	s := newUploadTestSymbolizer(t)
+	ctx := context.Background()
+	objectPath := uploadedDebuginfoPath("tenant-a", testBuildID)
+
+	_, ok := s.fetchUploadedLidia(ctx, []string{"tenant-a"}, testBuildID)
+	require.False(t, ok)
+	s.uploadedNotFoundCache.Wait()
+	found, _ := s.uploadedNotFoundCache.Get(objectPath)
+	require.True(t, found)
+
+	// The upload invalidates the cached miss.
+	require.NoError(t, s.Upload(ctx, "tenant-a", testBuildID, elfData))
+	found, _ = s.uploadedNotFoundCache.Get(objectPath)
+	require.False(t, found)
+	_, ok = s.fetchUploadedLidia(ctx, []string{"tenant-a"}, testBuildID)
+	require.True(t, ok)
+}
@@ -55,6 +55,8 @@ type Config struct {
 	EnableIPv6 bool     `yaml:"instance_enable_ipv6" category:"advanced"`
 	Port       int      `yaml:"instance_port" category:"advanced"`
 
+	ResultsCache ResultsCacheConfig `yaml:"results_cache" doc:"description=Configures the cache of the sub-query results."`
+
 	// For backward compatibility only. The parameter has a name that is
 	// inconsistent with the way address is specified in other places.
 	// The parameter is replaced with `instance_addr`.
@@ -74,6 +76,7 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet, logger log.Logger) {
 	f.BoolVar(&cfg.EnableIPv6, "query-frontend.instance-enable-ipv6", false, "Enable using a IPv6 instance address. (default false)")
 	f.IntVar(&cfg.Port, "query-frontend.instance-port", 0, "Port to advertise to query-scheduler and querier (defaults to -server.http-listen-port).")
 	cfg.GRPCClientConfig.RegisterFlagsWithPrefix("query-frontend.grpc-client-config", f)
+	cfg.ResultsCache.RegisterFlagsWithPrefix("query-frontend.results-cache.", f)
 }
 
 func (cfg *Config) Validate() error {
@@ -81,6 +84,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("scheduler address cannot be specified when query-scheduler service discovery mode is set to '%s'", cfg.QuerySchedulerDiscovery.Mode)
 	}
 
+	if err := cfg.ResultsCache.Validate(); err != nil {
+		return err
+	}
+
 	return cfg.GRPCClientConfig.Validate()
 }
 
@@ -104,6 +111,7 @@ type Frontend struct {
 	schedulerWorkers        *frontendSchedulerWorkers
 	schedulerWorkersWatcher *services.FailureWatcher
 	requests                *requestsInProgress
+	resultsCache            *resultsCache
 }
 
 type Limits interface {
@@ -112,8 +120,10 @@ type Limits interface {
 	MaxQueryLength(tenantID string) time.Duration
 	MaxQueryLookback(tenantID string) time.Duration
 	QueryAnalysisEnabled(string) bool
+	QueryAnalysisSeriesEnabled(string) bool
 	SymbolizerEnabled(string) bool
 	validation.FlameGraphLimits
+	ResultsCacheLimits
 }
 
 type frontendRequest struct {
@@ -153,6 +163,11 @@ func NewFrontend(cfg Config, limits Limits, log log.Logger, reg prometheus.Regis
 		return nil, err
 	}
 
+	resultsCache, err := newResultsCache(cfg.ResultsCache, limits, log, reg)
+	if err != nil {
+		return nil, err
+	}
+
 	f := &Frontend{
 		cfg:                     cfg,
 		log:                     log,
@@ -161,6 +176,7 @@ func NewFrontend(cfg Config, limits Limits, log log.Logger, reg prometheus.Regis
 		schedulerWorkers:        schedulerWorkers,
 		schedulerWorkersWatcher: services.NewFailureWatcher(),
 		requests:                newRequestsInProgress(),
+		resultsCache:            resultsCache,
 		VCSServiceHandler:       vcs.New(log, reg),
 	}
 	f.GRPCRoundTripper = &realFrontendRoundTripper{frontend: f}
@@ -203,6 +219,7 @@ func (f *Frontend) running(ctx context.Context) error {
 }
 
 func (f *Frontend) stopping(_ error) error {
+	defer f.resultsCache.close()
 	return errors.Wrap(services.StopAndAwaitTerminated(context.Background(), f.schedulerWorkers), "failed to stop frontend scheduler workers")
 }
 
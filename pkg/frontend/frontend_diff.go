@@ -36,12 +36,22 @@ func (f *Frontend) Diff(
 	c.Msg.Left.MaxNodes = &maxNodes
 	c.Msg.Right.MaxNodes = &maxNodes
 
-	var left, right *phlaremodel.Tree
-	g.Go(func() error {
-		var leftErr error
-		left, leftErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Left))
-		return leftErr
-	})
+	lefts := []*querierv1.SelectMergeStacktracesRequest{c.Msg.Left}
+	if c.Msg.Baseline != nil {
+		if lefts, err = phlaremodel.DiffBaselineWindows(c.Msg.Left, c.Msg.Baseline); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	leftTrees := make([]*phlaremodel.Tree, len(lefts))
+	var right *phlaremodel.Tree
+	for i, left := range lefts {
+		g.Go(func() error {
+			var leftErr error
+			leftTrees[i], leftErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(left))
+			return leftErr
+		})
+	}
 	g.Go(func() error {
 		var rightErr error
 		right, rightErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Right))
@@ -51,10 +61,17 @@ func (f *Frontend) Diff(
 		return nil, err
 	}
 
+	left := leftTrees[0]
+	var changes []*querierv1.DiffNodeChange
+	if c.Msg.Baseline != nil {
+		left = phlaremodel.NewBaselineTree(leftTrees...)
+		changes = phlaremodel.NewDiffChanges(left, right, c.Msg.Baseline.Threshold, int(maxNodes))
+	}
+
 	diff, err := phlaremodel.NewFlamegraphDiff(left, right, maxNodes)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff}), nil
+	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff, Changes: changes}), nil
 }
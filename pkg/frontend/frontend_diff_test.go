@@ -39,6 +39,10 @@ func (m *mockLimits) QueryAnalysisEnabled(_ string) bool {
 	return true
 }
 
+func (m *mockLimits) QueryAnalysisSeriesEnabled(_ string) bool {
+	return true
+}
+
 func (m *mockLimits) MaxFlameGraphNodesDefault(_ string) int {
 	return 10_000
 }
@@ -49,6 +53,10 @@ func (m *mockLimits) MaxFlameGraphNodesMax(_ string) int {
 
 func (m *mockLimits) SymbolizerEnabled(s string) bool { return true }
 
+func (m *mockLimits) RejectOlderThan(_ string) time.Duration {
+	return time.Hour
+}
+
 type mockRoundTripper struct {
 	callback func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error)
 }
@@ -184,4 +192,59 @@ func Test_Frontend_Diff(t *testing.T) {
 		)
 	})
 
+	t.Run("diff against baseline windows", func(t *testing.T) {
+		left := now - 5000
+		frontend.GRPCRoundTripper = &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectMergeStacktracesRequest, querierv1.SelectMergeStacktracesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+				s := new(model.Tree)
+				switch req.Msg.Start {
+				case left:
+					s.InsertStack(25, "foo", "bar")
+					s.InsertStack(75, "foo", "baz")
+				case left - 10000:
+					s.InsertStack(225, "foo", "bar")
+					s.InsertStack(75, "foo", "baz")
+				case left - 20000:
+					// The window is empty.
+				case now:
+					s.InsertStack(150, "foo", "bar")
+					s.InsertStack(50, "foo", "baz")
+				}
+				return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{
+					Flamegraph: model.NewFlameGraph(s, -1),
+				}), nil
+			})
+		}}
+
+		resp, err := frontend.Diff(
+			ctx,
+			connect.NewRequest(&querierv1.DiffRequest{
+				Left: &querierv1.SelectMergeStacktracesRequest{
+					ProfileTypeID: profileType,
+					LabelSelector: "{}",
+					Start:         left,
+					End:           left + 1000,
+				},
+				Right: &querierv1.SelectMergeStacktracesRequest{
+					ProfileTypeID: profileType,
+					LabelSelector: "{}",
+					Start:         now,
+					End:           now + 1000,
+				},
+				Baseline: &querierv1.DiffBaseline{
+					Windows:   3,
+					Interval:  10000,
+					Threshold: 0.2,
+				},
+			}),
+		)
+		require.NoError(t, err)
+		// The baseline is the average of the normalized non-empty windows.
+		require.Equal(t, int64(200), resp.Msg.Flamegraph.LeftTicks)
+		require.Equal(t, int64(200), resp.Msg.Flamegraph.RightTicks)
+		require.Equal(t, []*querierv1.DiffNodeChange{
+			{Stack: []string{"foo", "bar"}, LeftShare: 0.5, RightShare: 0.75},
+			{Stack: []string{"foo", "baz"}, LeftShare: 0.5, RightShare: 0.25},
+		}, resp.Msg.Changes)
+	})
 }
@@ -0,0 +1,47 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (f *Frontend) GetProfile(
+	ctx context.Context,
+	c *connect.Request[querierv1.GetProfileRequest],
+) (*connect.Response[profilev1.Profile], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("timestamp", model.Time(c.Msg.Timestamp).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("profile_id", c.Msg.ProfileId)
+
+	ctx = connectgrpc.WithProcedure(ctx, querierv1connect.QuerierServiceGetProfileProcedure)
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	ts := model.Time(c.Msg.Timestamp)
+	validated, err := validation.ValidateRangeRequest(f.limits, tenantIDs, model.Interval{Start: ts, End: ts}, model.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if validated.IsEmpty {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+
+	// A single profile is requested: the query is not split.
+	return connectgrpc.RoundTripUnary[
+		querierv1.GetProfileRequest,
+		profilev1.Profile](ctx, f, c)
+}
@@ -0,0 +1,100 @@
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (f *Frontend) SelectCallersCallees(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectCallersCalleesRequest],
+) (*connect.Response[querierv1.SelectCallersCalleesResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("max_nodes", c.Msg.GetMaxNodes()).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("function", c.Msg.Function)
+
+	ctx = connectgrpc.WithProcedure(ctx, querierv1connect.QuerierServiceSelectCallersCalleesProcedure)
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	validated, err := validation.ValidateRangeRequest(f.limits, tenantIDs, model.Interval{Start: model.Time(c.Msg.Start), End: model.Time(c.Msg.End)}, model.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if validated.IsEmpty {
+		return connect.NewResponse(&querierv1.SelectCallersCalleesResponse{}), nil
+	}
+	maxNodes, err := validation.ValidateMaxNodes(f.limits, tenantIDs, c.Msg.GetMaxNodes())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	if maxConcurrent := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxQueryParallelism); maxConcurrent > 0 {
+		g.SetLimit(maxConcurrent)
+	}
+
+	callers := phlaremodel.NewTreeMerger()
+	callees := phlaremodel.NewTreeMerger()
+	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
+	intervals := NewTimeIntervalIterator(time.UnixMilli(int64(validated.Start)), time.UnixMilli(int64(validated.End)), interval)
+
+	for intervals.Next() {
+		r := intervals.At()
+		g.Go(func() error {
+			req := connectgrpc.CloneRequest(c, &querierv1.SelectCallersCalleesRequest{
+				ProfileTypeID: c.Msg.ProfileTypeID,
+				LabelSelector: c.Msg.LabelSelector,
+				Start:         r.Start.UnixMilli(),
+				End:           r.End.UnixMilli(),
+				Function:      c.Msg.Function,
+				MaxNodes:      &maxNodes,
+				Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+			})
+			resp, err := connectgrpc.RoundTripUnary[
+				querierv1.SelectCallersCalleesRequest,
+				querierv1.SelectCallersCalleesResponse](ctx, f, req)
+			if err != nil {
+				return err
+			}
+			if err = callers.MergeTreeBytes(resp.Msg.CallersTree); err != nil {
+				return err
+			}
+			return callees.MergeTreeBytes(resp.Msg.CalleesTree)
+		})
+	}
+
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var resp querierv1.SelectCallersCalleesResponse
+	switch c.Msg.Format {
+	default:
+		resp.Callers = phlaremodel.NewFlameGraph(callers.Tree(), maxNodes)
+		resp.Callees = phlaremodel.NewFlameGraph(callees.Tree(), maxNodes)
+	case querierv1.ProfileFormat_PROFILE_FORMAT_TREE:
+		resp.CallersTree = callers.Tree().Bytes(maxNodes)
+		resp.CalleesTree = callees.Tree().Bytes(maxNodes)
+	}
+	return connect.NewResponse(&resp), nil
+}
@@ -85,9 +85,9 @@ func (f *Frontend) selectMergeStacktracesTree(
 				MaxNodes:      &maxNodes,
 				Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
 			})
-			resp, err := connectgrpc.RoundTripUnary[
+			resp, err := roundTripCached[
 				querierv1.SelectMergeStacktracesRequest,
-				querierv1.SelectMergeStacktracesResponse](ctx, f, req)
+				querierv1.SelectMergeStacktracesResponse](ctx, f, req, r.End)
 			if err != nil {
 				return err
 			}
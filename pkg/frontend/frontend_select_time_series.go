@@ -53,8 +53,11 @@ func (f *Frontend) SelectSeries(
 
 	m := phlaremodel.NewTimeSeriesMerger(true)
 	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
-	intervals := NewTimeIntervalIterator(time.UnixMilli(c.Msg.Start), time.UnixMilli(c.Msg.End), interval,
-		WithAlignment(time.Second*time.Duration(c.Msg.Step)))
+	options := []TimeIntervalIteratorOption{WithAlignment(time.Second * time.Duration(c.Msg.Step))}
+	if f.resultsCache != nil {
+		options = append(options, WithStableBoundaries())
+	}
+	intervals := NewTimeIntervalIterator(time.UnixMilli(c.Msg.Start), time.UnixMilli(c.Msg.End), interval, options...)
 
 	for intervals.Next() {
 		r := intervals.At()
@@ -68,10 +71,11 @@ func (f *Frontend) SelectSeries(
 				Step:               c.Msg.Step,
 				Aggregation:        c.Msg.Aggregation,
 				StackTraceSelector: c.Msg.StackTraceSelector,
+				IncludeExemplars:   c.Msg.IncludeExemplars,
 			})
-			resp, err := connectgrpc.RoundTripUnary[
+			resp, err := roundTripCached[
 				querierv1.SelectSeriesRequest,
-				querierv1.SelectSeriesResponse](ctx, f, req)
+				querierv1.SelectSeriesResponse](ctx, f, req, r.End)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,109 @@
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (f *Frontend) SelectTopFunctions(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectTopFunctionsRequest],
+) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("granularity", c.Msg.Granularity.String()).
+		SetTag("order_by", c.Msg.OrderBy.String()).
+		SetTag("limit", c.Msg.Limit).
+		SetTag("baseline_start", model.Time(c.Msg.BaselineStart).Time().String()).
+		SetTag("baseline_end", model.Time(c.Msg.BaselineEnd).Time().String())
+
+	ctx = connectgrpc.WithProcedure(ctx, querierv1connect.QuerierServiceSelectTopFunctionsProcedure)
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	type timeRange struct {
+		validation.ValidatedRangeRequest
+		merge func([]*typesv1.TopFunction)
+	}
+	// Sub-queries return all the functions: the
+	// limit can only be applied to the merged result.
+	m := phlaremodel.NewTopFunctionsMerger()
+	ranges := make([]timeRange, 0, 2)
+	addRange := func(start, end int64, merge func([]*typesv1.TopFunction)) error {
+		validated, err := validation.ValidateRangeRequest(f.limits, tenantIDs, model.Interval{Start: model.Time(start), End: model.Time(end)}, model.Now())
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		ranges = append(ranges, timeRange{ValidatedRangeRequest: validated, merge: merge})
+		return nil
+	}
+	if err = addRange(c.Msg.Start, c.Msg.End, m.MergeTopFunctions); err != nil {
+		return nil, err
+	}
+	diff := c.Msg.BaselineEnd > 0
+	if diff {
+		if err = addRange(c.Msg.BaselineStart, c.Msg.BaselineEnd, m.MergeBaseline); err != nil {
+			return nil, err
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	if maxConcurrent := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxQueryParallelism); maxConcurrent > 0 {
+		g.SetLimit(maxConcurrent)
+	}
+
+	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
+	for _, tr := range ranges {
+		if tr.IsEmpty {
+			continue
+		}
+		intervals := NewTimeIntervalIterator(time.UnixMilli(int64(tr.Start)), time.UnixMilli(int64(tr.End)), interval)
+		for intervals.Next() {
+			r := intervals.At()
+			g.Go(func() error {
+				req := connectgrpc.CloneRequest(c, &querierv1.SelectTopFunctionsRequest{
+					ProfileTypeID: c.Msg.ProfileTypeID,
+					LabelSelector: c.Msg.LabelSelector,
+					Start:         r.Start.UnixMilli(),
+					End:           r.End.UnixMilli(),
+					Granularity:   c.Msg.Granularity,
+				})
+				resp, err := connectgrpc.RoundTripUnary[
+					querierv1.SelectTopFunctionsRequest,
+					querierv1.SelectTopFunctionsResponse](ctx, f, req)
+				if err != nil {
+					return err
+				}
+				tr.merge(resp.Msg.Functions)
+				return nil
+			})
+		}
+	}
+
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+		Functions: m.Top(c.Msg.OrderBy, diff, int(c.Msg.Limit)),
+	}), nil
+}
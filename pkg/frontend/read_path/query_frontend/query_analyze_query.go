@@ -0,0 +1,149 @@
+package query_frontend
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+// AnalyzeQuery reports the blocks and datasets the query would touch,
+// based on the metadata of the blocks. Unlike the old read path, the
+// number of profiles and samples is not known without reading the blocks.
+func (q *QueryFrontend) AnalyzeQuery(
+	ctx context.Context,
+	c *connect.Request[querierv1.AnalyzeQueryRequest],
+) (*connect.Response[querierv1.AnalyzeQueryResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "AnalyzeQuery")
+	defer sp.Finish()
+	sp.SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("query", c.Msg.Query)
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	for _, tenantID := range tenantIDs {
+		if !q.limits.QueryAnalysisEnabled(tenantID) {
+			return connect.NewResponse(&querierv1.AnalyzeQueryResponse{}), nil
+		}
+	}
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.Start, &c.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if empty {
+		return connect.NewResponse(&querierv1.AnalyzeQueryResponse{}), nil
+	}
+
+	matchers, err := analyzeQueryMatchers(c.Msg.Query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	md, err := q.metadataQueryClient.QueryMetadata(ctx, &metastorev1.QueryMetadataRequest{
+		TenantId:  tenantIDs,
+		StartTime: c.Msg.Start,
+		EndTime:   c.Msg.End,
+		Query:     matchersToLabelSelector(datasetMatchers(matchers)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scope := newQueryScope(md.Blocks)
+	resp := &querierv1.AnalyzeQueryResponse{
+		QueryScopes: []*querierv1.QueryScope{scope},
+		QueryImpact: &querierv1.QueryImpact{
+			TotalBytesInTimeRange: scope.IndexBytes + scope.ProfileBytes + scope.SymbolBytes,
+		},
+	}
+
+	if len(md.Blocks) > 0 && q.seriesAnalysisEnabled(tenantIDs) {
+		series, err := q.Series(ctx, connect.NewRequest(&querierv1.SeriesRequest{
+			Matchers: []string{matchersToLabelSelector(matchers)},
+			Start:    c.Msg.Start,
+			End:      c.Msg.End,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		scope.SeriesCount = uint64(len(series.Msg.LabelsSet))
+		resp.QueryImpact.TotalQueriedSeries = scope.SeriesCount
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (q *QueryFrontend) seriesAnalysisEnabled(tenantIDs []string) bool {
+	for _, tenantID := range tenantIDs {
+		if !q.limits.QueryAnalysisSeriesEnabled(tenantID) {
+			return false
+		}
+	}
+	return true
+}
+
+// analyzeQueryMatchers parses the query selector: the metric
+// name, if present, is treated as the profile type.
+func analyzeQueryMatchers(query string) ([]*labels.Matcher, error) {
+	if query == "" {
+		return nil, nil
+	}
+	matchers, err := parser.ParseMetricSelector(query)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matchers {
+		if m.Name == labels.MetricName {
+			m.Name = phlaremodel.LabelNameProfileType
+		}
+	}
+	return matchers, nil
+}
+
+// datasetMatchers returns the matchers that can be evaluated against
+// the dataset labels. Other matchers are ignored: datasets that do not
+// contain the queried series are still included into the query scope.
+func datasetMatchers(matchers []*labels.Matcher) []*labels.Matcher {
+	return slices.DeleteFunc(slices.Clone(matchers), func(m *labels.Matcher) bool {
+		return m.Name != phlaremodel.LabelNameServiceName && m.Name != phlaremodel.LabelNameProfileType
+	})
+}
+
+func newQueryScope(blocks []*metastorev1.BlockMeta) *querierv1.QueryScope {
+	scope := &querierv1.QueryScope{
+		ComponentType:  "Object storage",
+		ComponentCount: uint64((len(blocks) + maxReadsPerNode - 1) / maxReadsPerNode),
+		BlockCount:     uint64(len(blocks)),
+	}
+	for _, b := range blocks {
+		for _, ds := range b.Datasets {
+			// Only regular datasets are accounted: the tenant-wide
+			// dataset index does not contain profile data.
+			if ds.Format != 0 || len(ds.TableOfContents) < 3 {
+				continue
+			}
+			scope.DatasetCount++
+			// By default (format 0), the sections are:
+			//  - 0: profiles.parquet
+			//  - 1: index.tsdb
+			//  - 2: symbols.symdb
+			toc := ds.TableOfContents
+			scope.ProfileBytes += toc[1] - toc[0]
+			scope.IndexBytes += toc[2] - toc[1]
+			scope.SymbolBytes += toc[0] + ds.Size - toc[2]
+		}
+	}
+	return scope
+}
@@ -32,13 +32,23 @@ func (q *QueryFrontend) Diff(
 	c.Msg.Left.MaxNodes = &maxNodes
 	c.Msg.Right.MaxNodes = &maxNodes
 
-	var left, right []byte
+	lefts := []*querierv1.SelectMergeStacktracesRequest{c.Msg.Left}
+	if c.Msg.Baseline != nil {
+		if lefts, err = phlaremodel.DiffBaselineWindows(c.Msg.Left, c.Msg.Baseline); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	leftTrees := make([][]byte, len(lefts))
+	var right []byte
 	g, ctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		var leftErr error
-		left, leftErr = q.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Left))
-		return leftErr
-	})
+	for i, left := range lefts {
+		g.Go(func() error {
+			var leftErr error
+			leftTrees[i], leftErr = q.selectMergeStacktracesTree(ctx, connect.NewRequest(left))
+			return leftErr
+		})
+	}
 	g.Go(func() error {
 		var rightErr error
 		right, rightErr = q.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Right))
@@ -48,9 +58,29 @@ func (q *QueryFrontend) Diff(
 		return nil, err
 	}
 
-	diff, err := phlaremodel.NewFlamegraphDiffFromBytes(left, right, maxNodes)
+	if c.Msg.Baseline == nil {
+		diff, err := phlaremodel.NewFlamegraphDiffFromBytes(leftTrees[0], right, maxNodes)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff}), nil
+	}
+
+	windows := make([]*phlaremodel.Tree, len(leftTrees))
+	for i, b := range leftTrees {
+		if windows[i], err = phlaremodel.UnmarshalTree(b); err != nil {
+			return nil, err
+		}
+	}
+	rightTree, err := phlaremodel.UnmarshalTree(right)
+	if err != nil {
+		return nil, err
+	}
+	leftTree := phlaremodel.NewBaselineTree(windows...)
+	changes := phlaremodel.NewDiffChanges(leftTree, rightTree, c.Msg.Baseline.Threshold, int(maxNodes))
+	diff, err := phlaremodel.NewFlamegraphDiff(leftTree, rightTree, maxNodes)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff}), nil
+	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff, Changes: changes}), nil
 }
@@ -65,6 +65,12 @@ func NewQueryFrontend(
 	}
 }
 
+// TODO(kolesnikovae): Should be dynamic.
+const (
+	maxReadsPerNode  = 4
+	maxMergesPerNode = 20
+)
+
 var xrand = rand.New(rand.NewSource(4349676827832284783))
 var xrandMutex = sync.Mutex{} // todo fix the race properly
 
@@ -80,10 +86,11 @@ func (q *QueryFrontend) Query(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	blocks, err := q.QueryMetadata(ctx, req)
+	md, err := q.queryMetadata(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	blocks := md.Blocks
 	if len(blocks) == 0 {
 		return new(queryv1.QueryResponse), nil
 	}
@@ -93,8 +100,7 @@ func (q *QueryFrontend) Query(
 		blocks[i], blocks[j] = blocks[j], blocks[i]
 	})
 	xrandMutex.Unlock()
-	// TODO(kolesnikovae): Should be dynamic.
-	p := queryplan.Build(blocks, 4, 20)
+	p := queryplan.Build(blocks, maxReadsPerNode, maxMergesPerNode)
 
 	// Only check for symbolization if all tenants have it enabled
 	shouldSymbolize := q.shouldSymbolize(tenants, blocks)
@@ -121,6 +127,8 @@ func (q *QueryFrontend) Query(
 		Options:       &queryv1.InvokeOptions{},
 		QueryPlan:     p,
 		Query:         modifiedQueries,
+		// Deleted series are filtered out by the query backend.
+		SeriesDeletions: md.SeriesDeletions,
 	})
 	if err != nil {
 		return nil, err
@@ -146,6 +154,17 @@ func (q *QueryFrontend) QueryMetadata(
 	ctx context.Context,
 	req *queryv1.QueryRequest,
 ) ([]*metastorev1.BlockMeta, error) {
+	md, err := q.queryMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return md.Blocks, nil
+}
+
+func (q *QueryFrontend) queryMetadata(
+	ctx context.Context,
+	req *queryv1.QueryRequest,
+) (*metastorev1.QueryMetadataResponse, error) {
 	tenants, err := tenant.TenantIDs(ctx)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -180,12 +199,7 @@ func (q *QueryFrontend) QueryMetadata(
 	}
 
 	query.Query = matchersToLabelSelector(matchers)
-	md, err := q.metadataQueryClient.QueryMetadata(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-
-	return md.Blocks, nil
+	return q.metadataQueryClient.QueryMetadata(ctx, query)
 }
 
 // hasUnsymbolizedProfiles checks if a block has unsymbolized profiles
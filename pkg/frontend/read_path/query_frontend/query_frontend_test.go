@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
+
+	"connectrpc.com/connect"
 
 	"github.com/go-kit/log"
 	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	metastorev1 "github.com/grafana/pyroscope/api/gen/proto/go/metastore/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
 	"github.com/grafana/pyroscope/pkg/experiment/block/metadata"
 	"github.com/grafana/pyroscope/pkg/tenant"
@@ -97,6 +102,91 @@ func Test_QueryFrontend_QueryMetadata(t *testing.T) {
 	}
 }
 
+func Test_QueryFrontend_AnalyzeQuery(t *testing.T) {
+	mockLimits := mockfrontend.NewMockLimits(t)
+	mockLimits.On("QueryAnalysisEnabled", "org").Return(true)
+	mockLimits.On("QueryAnalysisSeriesEnabled", "org").Return(false)
+	mockLimits.On("MaxQueryLookback", "org").Return(time.Duration(0))
+	mockLimits.On("MaxQueryLength", "org").Return(time.Duration(0))
+
+	start := time.Now().Add(-time.Hour).UnixMilli()
+	end := time.Now().UnixMilli()
+	mockMetadataClient := new(mockmetastorev1.MockMetadataQueryServiceClient)
+	mockMetadataClient.On("QueryMetadata", mock.Anything, &metastorev1.QueryMetadataRequest{
+		TenantId:  []string{"org"},
+		StartTime: start,
+		EndTime:   end,
+		Query:     `{service_name="service-a",__profile_type__="process_cpu:cpu:nanoseconds:cpu:nanoseconds"}`,
+	}).Return(&metastorev1.QueryMetadataResponse{
+		Blocks: []*metastorev1.BlockMeta{
+			{
+				Id: "block_id_a",
+				Datasets: []*metastorev1.Dataset{
+					{TableOfContents: []uint64{0, 10, 30}, Size: 60},
+					{TableOfContents: []uint64{60, 65, 75}, Size: 20},
+				},
+			},
+			{
+				Id: "block_id_b",
+				Datasets: []*metastorev1.Dataset{
+					{TableOfContents: []uint64{0, 100, 200}, Size: 1000},
+					// The tenant-wide dataset index is not accounted.
+					{Format: 1, TableOfContents: []uint64{1000}, Size: 50},
+				},
+			},
+		},
+	}, nil).Once()
+
+	f := &QueryFrontend{limits: mockLimits, metadataQueryClient: mockMetadataClient}
+	ctx := user.InjectOrgID(context.Background(), "org")
+	resp, err := f.AnalyzeQuery(ctx, connect.NewRequest(&querierv1.AnalyzeQueryRequest{
+		Start: start,
+		End:   end,
+		Query: `process_cpu:cpu:nanoseconds:cpu:nanoseconds{service_name="service-a",pod="pod-a"}`,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, &querierv1.AnalyzeQueryResponse{
+		QueryScopes: []*querierv1.QueryScope{{
+			ComponentType:  "Object storage",
+			ComponentCount: 1,
+			BlockCount:     2,
+			DatasetCount:   3,
+			ProfileBytes:   10 + 5 + 100,
+			IndexBytes:     20 + 10 + 100,
+			SymbolBytes:    30 + 5 + 800,
+		}},
+		QueryImpact: &querierv1.QueryImpact{
+			TotalBytesInTimeRange: 60 + 20 + 1000,
+		},
+	}, resp.Msg)
+	mockMetadataClient.AssertExpectations(t)
+}
+
+func Test_QueryFrontend_SelectMergeSpanProfile_SpanSelector(t *testing.T) {
+	mockLimits := mockfrontend.NewMockLimits(t)
+	mockLimits.On("MaxQueryLookback", "org").Return(time.Duration(0))
+	mockLimits.On("MaxQueryLength", "org").Return(time.Duration(0))
+
+	// The metadata is not queried: the request is handled by the frontend.
+	f := &QueryFrontend{limits: mockLimits}
+	_, ctx := opentracing.StartSpanFromContext(user.InjectOrgID(context.Background(), "org"), "test")
+	req := &querierv1.SelectMergeSpanProfileRequest{
+		ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+		LabelSelector: "{}",
+		Start:         time.Now().Add(-time.Hour).UnixMilli(),
+		End:           time.Now().UnixMilli(),
+	}
+
+	resp, err := f.SelectMergeSpanProfile(ctx, connect.NewRequest(req.CloneVT()))
+	require.NoError(t, err)
+	assert.Equal(t, &querierv1.SelectMergeSpanProfileResponse{}, resp.Msg)
+
+	req.SpanSelector = []string{"not-a-span-id"}
+	_, err = f.SelectMergeSpanProfile(ctx, connect.NewRequest(req.CloneVT()))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
 func TestQueryFrontendSymbolization(t *testing.T) {
 	tests := []struct {
 		name              string
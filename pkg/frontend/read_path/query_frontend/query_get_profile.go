@@ -0,0 +1,81 @@
+package query_frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/pprof"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (q *QueryFrontend) GetProfile(
+	ctx context.Context,
+	c *connect.Request[querierv1.GetProfileRequest],
+) (*connect.Response[profilev1.Profile], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("timestamp", model.Time(c.Msg.Timestamp).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("profile_id", c.Msg.ProfileId)
+
+	if _, err := uuid.Parse(c.Msg.ProfileId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid profile ID: %w", err))
+	}
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// The timestamp is truncated to milliseconds, and the
+	// profile time is within [timestamp, timestamp+1).
+	start, end := c.Msg.Timestamp, c.Msg.Timestamp+1
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &start, &end)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if empty {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+
+	labelSelector, err := buildLabelSelectorWithProfileType(c.Msg.LabelSelector, c.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := q.querySingle(ctx, &queryv1.QueryRequest{
+		StartTime:     start,
+		EndTime:       end,
+		LabelSelector: labelSelector,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_PPROF,
+			Pprof: &queryv1.PprofQuery{
+				ProfileId: c.Msg.ProfileId,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+	var p profilev1.Profile
+	if err = pprof.Unmarshal(report.Pprof.Pprof, &p); err != nil {
+		return nil, err
+	}
+	if len(p.Sample) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+	p.TimeNanos = model.Time(c.Msg.Timestamp).UnixNano()
+
+	return connect.NewResponse(&p), nil
+}
@@ -0,0 +1,86 @@
+package query_frontend
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (q *QueryFrontend) SelectCallersCallees(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectCallersCalleesRequest],
+) (*connect.Response[querierv1.SelectCallersCalleesResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("max_nodes", c.Msg.GetMaxNodes()).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("function", c.Msg.Function)
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.Start, &c.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if empty {
+		return connect.NewResponse(&querierv1.SelectCallersCalleesResponse{}), nil
+	}
+
+	maxNodes, err := validation.ValidateMaxNodes(q.limits, tenantIDs, c.Msg.GetMaxNodes())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	labelSelector, err := buildLabelSelectorWithProfileType(c.Msg.LabelSelector, c.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := q.querySingle(ctx, &queryv1.QueryRequest{
+		StartTime:     c.Msg.Start,
+		EndTime:       c.Msg.End,
+		LabelSelector: labelSelector,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_CALLERS_CALLEES,
+			CallersCallees: &queryv1.CallersCalleesQuery{
+				Function: c.Msg.Function,
+				MaxNodes: maxNodes,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return connect.NewResponse(&querierv1.SelectCallersCalleesResponse{}), nil
+	}
+
+	var resp querierv1.SelectCallersCalleesResponse
+	switch c.Msg.Format {
+	case querierv1.ProfileFormat_PROFILE_FORMAT_TREE:
+		resp.CallersTree = report.CallersCallees.Callers
+		resp.CalleesTree = report.CallersCallees.Callees
+	default:
+		callers, err := phlaremodel.UnmarshalTree(report.CallersCallees.Callers)
+		if err != nil {
+			return nil, err
+		}
+		callees, err := phlaremodel.UnmarshalTree(report.CallersCallees.Callees)
+		if err != nil {
+			return nil, err
+		}
+		resp.Callers = phlaremodel.NewFlameGraph(callers, maxNodes)
+		resp.Callees = phlaremodel.NewFlameGraph(callees, maxNodes)
+	}
+	return connect.NewResponse(&resp), nil
+}
@@ -0,0 +1,76 @@
+package query_frontend
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (q *QueryFrontend) SelectHeatmap(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectHeatmapRequest],
+) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("step", c.Msg.Step).
+		SetTag("profiles_per_cell", c.Msg.ProfilesPerCell).
+		SetTag("profile_type", c.Msg.ProfileTypeID)
+
+	if c.Msg.Step <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("step must be positive"))
+	}
+	if c.Msg.ProfilesPerCell < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("profiles_per_cell must not be negative"))
+	}
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.Start, &c.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if empty {
+		return connect.NewResponse(&querierv1.SelectHeatmapResponse{}), nil
+	}
+
+	// The first time bucket includes profiles of the preceding step.
+	stepMs := time.Duration(c.Msg.Step * float64(time.Second)).Milliseconds()
+	start := c.Msg.Start - stepMs
+
+	labelSelector, err := buildLabelSelectorWithProfileType(c.Msg.LabelSelector, c.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := q.querySingle(ctx, &queryv1.QueryRequest{
+		StartTime:     start,
+		EndTime:       c.Msg.End,
+		LabelSelector: labelSelector,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_HEATMAP,
+			Heatmap: &queryv1.HeatmapQuery{
+				Step:            c.Msg.Step,
+				ProfilesPerCell: c.Msg.ProfilesPerCell,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return connect.NewResponse(&querierv1.SelectHeatmapResponse{}), nil
+	}
+	return connect.NewResponse(&querierv1.SelectHeatmapResponse{Cells: report.Heatmap.Cells}), nil
+}
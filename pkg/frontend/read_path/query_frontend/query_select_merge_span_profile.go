@@ -14,8 +14,6 @@ import (
 	"github.com/grafana/pyroscope/pkg/validation"
 )
 
-// TODO(kolesnikovae): Implement span selector.
-
 func (q *QueryFrontend) SelectMergeSpanProfile(
 	ctx context.Context,
 	c *connect.Request[querierv1.SelectMergeSpanProfileRequest],
@@ -25,7 +23,8 @@ func (q *QueryFrontend) SelectMergeSpanProfile(
 		SetTag("end", model.Time(c.Msg.End).Time().String()).
 		SetTag("selector", c.Msg.LabelSelector).
 		SetTag("max_nodes", c.Msg.GetMaxNodes()).
-		SetTag("profile_type", c.Msg.ProfileTypeID)
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("span_selector_size", len(c.Msg.SpanSelector))
 
 	tenantIDs, err := tenant.TenantIDs(ctx)
 	if err != nil {
@@ -38,6 +37,14 @@ func (q *QueryFrontend) SelectMergeSpanProfile(
 	if empty {
 		return connect.NewResponse(&querierv1.SelectMergeSpanProfileResponse{}), nil
 	}
+	if _, err = phlaremodel.NewSpanSelector(c.Msg.SpanSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(c.Msg.SpanSelector) == 0 {
+		// An empty span selector matches no samples. Note that
+		// the tree query ignores the span selector if it is empty.
+		return connect.NewResponse(&querierv1.SelectMergeSpanProfileResponse{}), nil
+	}
 
 	maxNodes, err := validation.ValidateMaxNodes(q.limits, tenantIDs, c.Msg.GetMaxNodes())
 	if err != nil {
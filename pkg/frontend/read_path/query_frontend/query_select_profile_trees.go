@@ -0,0 +1,73 @@
+package query_frontend
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (q *QueryFrontend) SelectProfileTrees(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectProfileTreesRequest],
+) (*connect.Response[querierv1.SelectProfileTreesResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("limit", c.Msg.Limit)
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.Start, &c.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if empty {
+		return connect.NewResponse(&querierv1.SelectProfileTreesResponse{}), nil
+	}
+
+	labelSelector, err := buildLabelSelectorWithProfileType(c.Msg.LabelSelector, c.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := q.querySingle(ctx, &queryv1.QueryRequest{
+		StartTime:     c.Msg.Start,
+		EndTime:       c.Msg.End,
+		LabelSelector: labelSelector,
+		Query: []*queryv1.Query{{
+			QueryType: queryv1.QueryType_QUERY_PROFILE_TREES,
+			ProfileTrees: &queryv1.ProfileTreesQuery{
+				Limit: c.Msg.Limit,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return connect.NewResponse(&querierv1.SelectProfileTreesResponse{}), nil
+	}
+	if n := len(report.ProfileTrees.Series); n > 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("the selector matches %d series, a single series is expected", n))
+	}
+	profiles := make([]*querierv1.ProfileTree, len(report.ProfileTrees.Profiles))
+	for i, p := range report.ProfileTrees.Profiles {
+		profiles[i] = &querierv1.ProfileTree{
+			Timestamp: p.Timestamp,
+			Tree:      p.Tree,
+		}
+	}
+	return connect.NewResponse(&querierv1.SelectProfileTreesResponse{Profiles: profiles}), nil
+}
@@ -11,6 +11,7 @@ import (
 
 	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
 	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/validation"
 )
@@ -53,9 +54,11 @@ func (q *QueryFrontend) SelectSeries(
 		Query: []*queryv1.Query{{
 			QueryType: queryv1.QueryType_QUERY_TIME_SERIES,
 			TimeSeries: &queryv1.TimeSeriesQuery{
-				Step:    c.Msg.GetStep(),
-				GroupBy: c.Msg.GetGroupBy(),
-				Limit:   c.Msg.GetLimit(),
+				Step:             c.Msg.GetStep(),
+				GroupBy:          c.Msg.GetGroupBy(),
+				Limit:            c.Msg.GetLimit(),
+				Aggregation:      c.Msg.Aggregation,
+				IncludeExemplars: c.Msg.IncludeExemplars,
 			},
 		}},
 	})
@@ -65,6 +68,14 @@ func (q *QueryFrontend) SelectSeries(
 	if report == nil {
 		return connect.NewResponse(&querierv1.SelectSeriesResponse{}), nil
 	}
-	series := phlaremodel.TopSeries(report.TimeSeries.TimeSeries, int(c.Msg.GetLimit()))
+	series := report.TimeSeries.TimeSeries
+	if a := c.Msg.Aggregation; a != nil && *a != typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM {
+		// Only the sum is calculated by the query backend; other
+		// aggregations are applied here to the raw points (or to
+		// the sums, in case of rate).
+		it := phlaremodel.NewTimeSeriesMergeIterator(series)
+		series = phlaremodel.RangeSeries(it, start, c.Msg.End, stepMs, a)
+	}
+	series = phlaremodel.TopSeries(series, int(c.Msg.GetLimit()))
 	return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: series}), nil
 }
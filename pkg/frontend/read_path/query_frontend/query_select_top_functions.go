@@ -0,0 +1,96 @@
+package query_frontend
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	queryv1 "github.com/grafana/pyroscope/api/gen/proto/go/query/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (q *QueryFrontend) SelectTopFunctions(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectTopFunctionsRequest],
+) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("profile_type", c.Msg.ProfileTypeID).
+		SetTag("granularity", c.Msg.Granularity.String()).
+		SetTag("order_by", c.Msg.OrderBy.String()).
+		SetTag("limit", c.Msg.Limit).
+		SetTag("baseline_start", model.Time(c.Msg.BaselineStart).Time().String()).
+		SetTag("baseline_end", model.Time(c.Msg.BaselineEnd).Time().String())
+
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	empty, err := validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.Start, &c.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	diff := c.Msg.BaselineEnd > 0
+	baselineEmpty := true
+	if diff {
+		baselineEmpty, err = validation.SanitizeTimeRange(q.limits, tenantIDs, &c.Msg.BaselineStart, &c.Msg.BaselineEnd)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if empty && baselineEmpty {
+		return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{}), nil
+	}
+
+	labelSelector, err := buildLabelSelectorWithProfileType(c.Msg.LabelSelector, c.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, err
+	}
+	m := phlaremodel.NewTopFunctionsMerger()
+	selectTopFunctions := func(start, end int64, merge func([]*typesv1.TopFunction)) func() error {
+		return func() error {
+			report, err := q.querySingle(ctx, &queryv1.QueryRequest{
+				StartTime:     start,
+				EndTime:       end,
+				LabelSelector: labelSelector,
+				Query: []*queryv1.Query{{
+					QueryType: queryv1.QueryType_QUERY_TOP_FUNCTIONS,
+					TopFunctions: &queryv1.TopFunctionsQuery{
+						Granularity: c.Msg.Granularity,
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if report != nil {
+				merge(report.TopFunctions.Functions)
+			}
+			return nil
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	if !empty {
+		g.Go(selectTopFunctions(c.Msg.Start, c.Msg.End, m.MergeTopFunctions))
+	}
+	if !baselineEmpty {
+		g.Go(selectTopFunctions(c.Msg.BaselineStart, c.Msg.BaselineEnd, m.MergeBaseline))
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+		Functions: m.Top(c.Msg.OrderBy, diff, int(c.Msg.Limit)),
+	}), nil
+}
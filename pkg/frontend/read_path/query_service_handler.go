@@ -2,9 +2,12 @@ package read_path
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/prometheus/common/model"
 	"golang.org/x/sync/errgroup"
 
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
@@ -161,6 +164,164 @@ func (r *Router) SelectSeries(
 		})
 }
 
+func (r *Router) SelectHeatmap(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectHeatmapRequest],
+) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	return Query[querierv1.SelectHeatmapRequest, querierv1.SelectHeatmapResponse](ctx, r, c,
+		func(_, _ *querierv1.SelectHeatmapRequest) {},
+		func(a, b *querierv1.SelectHeatmapResponse) (*querierv1.SelectHeatmapResponse, error) {
+			m := phlaremodel.NewHeatmapMerger(int(c.Msg.ProfilesPerCell))
+			m.MergeHeatmap(a.Cells)
+			m.MergeHeatmap(b.Cells)
+			return &querierv1.SelectHeatmapResponse{Cells: m.Heatmap()}, nil
+		})
+}
+
+func (r *Router) SelectTopFunctions(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectTopFunctionsRequest],
+) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	if c.Msg.BaselineEnd > 0 {
+		return r.selectTopFunctionsDiff(ctx, c)
+	}
+	limit := int(c.Msg.Limit)
+	return Query[querierv1.SelectTopFunctionsRequest, querierv1.SelectTopFunctionsResponse](ctx, r, c,
+		func(a, b *querierv1.SelectTopFunctionsRequest) {
+			if a != nil && b != nil {
+				// The limit is applied to the merged result.
+				a.Limit = 0
+				b.Limit = 0
+			}
+		},
+		func(a, b *querierv1.SelectTopFunctionsResponse) (*querierv1.SelectTopFunctionsResponse, error) {
+			m := phlaremodel.NewTopFunctionsMerger()
+			m.MergeTopFunctions(a.Functions)
+			m.MergeTopFunctions(b.Functions)
+			return &querierv1.SelectTopFunctionsResponse{
+				Functions: m.Top(c.Msg.OrderBy, false, limit),
+			}, nil
+		})
+}
+
+// selectTopFunctionsDiff queries the time range and the baseline
+// separately, as they may belong to different read paths.
+func (r *Router) selectTopFunctionsDiff(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectTopFunctionsRequest],
+) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	m := phlaremodel.NewTopFunctionsMerger()
+	g, ctx := errgroup.WithContext(ctx)
+	selectTopFunctions := func(start, end int64, merge func([]*typesv1.TopFunction)) func() error {
+		return func() error {
+			resp, err := r.SelectTopFunctions(ctx, connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+				ProfileTypeID: c.Msg.ProfileTypeID,
+				LabelSelector: c.Msg.LabelSelector,
+				Start:         start,
+				End:           end,
+				Granularity:   c.Msg.Granularity,
+			}))
+			if err != nil {
+				return err
+			}
+			merge(resp.Msg.Functions)
+			return nil
+		}
+	}
+
+	g.Go(selectTopFunctions(c.Msg.Start, c.Msg.End, m.MergeTopFunctions))
+	g.Go(selectTopFunctions(c.Msg.BaselineStart, c.Msg.BaselineEnd, m.MergeBaseline))
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+		Functions: m.Top(c.Msg.OrderBy, true, int(c.Msg.Limit)),
+	}), nil
+}
+
+func (r *Router) SelectCallersCallees(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectCallersCalleesRequest],
+) (*connect.Response[querierv1.SelectCallersCalleesResponse], error) {
+	// We always query data in the tree format and
+	// return it in the format requested by the client.
+	f := c.Msg.Format
+	c.Msg.Format = querierv1.ProfileFormat_PROFILE_FORMAT_TREE
+	resp, err := Query[querierv1.SelectCallersCalleesRequest, querierv1.SelectCallersCalleesResponse](ctx, r, c,
+		func(_, _ *querierv1.SelectCallersCalleesRequest) {},
+		func(a, b *querierv1.SelectCallersCalleesResponse) (*querierv1.SelectCallersCalleesResponse, error) {
+			merge := func(x, y []byte) ([]byte, error) {
+				m := phlaremodel.NewTreeMerger()
+				if err := m.MergeTreeBytes(x); err != nil {
+					return nil, err
+				}
+				if err := m.MergeTreeBytes(y); err != nil {
+					return nil, err
+				}
+				return m.Tree().Bytes(c.Msg.GetMaxNodes()), nil
+			}
+			callers, err := merge(a.CallersTree, b.CallersTree)
+			if err != nil {
+				return nil, err
+			}
+			callees, err := merge(a.CalleesTree, b.CalleesTree)
+			if err != nil {
+				return nil, err
+			}
+			return &querierv1.SelectCallersCalleesResponse{
+				CallersTree: callers,
+				CalleesTree: callees,
+			}, nil
+		},
+	)
+	if err == nil && f != c.Msg.Format {
+		resp.Msg.Callers = phlaremodel.NewFlameGraph(
+			phlaremodel.MustUnmarshalTree(resp.Msg.CallersTree),
+			c.Msg.GetMaxNodes())
+		resp.Msg.Callees = phlaremodel.NewFlameGraph(
+			phlaremodel.MustUnmarshalTree(resp.Msg.CalleesTree),
+			c.Msg.GetMaxNodes())
+	}
+	return resp, err
+}
+
+func (r *Router) SelectProfileTrees(
+	ctx context.Context,
+	c *connect.Request[querierv1.SelectProfileTreesRequest],
+) (*connect.Response[querierv1.SelectProfileTreesResponse], error) {
+	return Query[querierv1.SelectProfileTreesRequest, querierv1.SelectProfileTreesResponse](ctx, r, c,
+		func(_, _ *querierv1.SelectProfileTreesRequest) {},
+		func(a, b *querierv1.SelectProfileTreesResponse) (*querierv1.SelectProfileTreesResponse, error) {
+			// The time ranges do not overlap: the
+			// profiles of a precede the ones of b.
+			profiles := append(a.Profiles, b.Profiles...)
+			if c.Msg.Limit > 0 && int64(len(profiles)) > c.Msg.Limit {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("the query selects %d profiles, the maximum is %d: narrow the time range", len(profiles), c.Msg.Limit))
+			}
+			return &querierv1.SelectProfileTreesResponse{Profiles: profiles}, nil
+		})
+}
+
+// GetProfile routes the request to the frontend of the read path
+// the profile belongs to: the query is never split.
+func (r *Router) GetProfile(
+	ctx context.Context,
+	c *connect.Request[querierv1.GetProfileRequest],
+) (*connect.Response[profilev1.Profile], error) {
+	tenantID, err := tenant.TenantID(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	overrides := r.overrides.ReadPathOverrides(tenantID)
+	split := model.TimeFromUnixNano(overrides.EnableQueryBackendFrom.UnixNano())
+	if overrides.EnableQueryBackend && !split.After(model.Time(c.Msg.Timestamp)) {
+		return r.newFrontend.GetProfile(ctx, c)
+	}
+	return r.oldFrontend.GetProfile(ctx, c)
+}
+
 func (r *Router) Diff(
 	ctx context.Context,
 	c *connect.Request[querierv1.DiffRequest],
@@ -181,32 +342,64 @@ func (r *Router) Diff(
 		}
 	}
 
-	var left, right phlaremodel.Tree
-	g.Go(getTree(&left, c.Msg.Left))
+	lefts := []*querierv1.SelectMergeStacktracesRequest{c.Msg.Left}
+	if c.Msg.Baseline != nil {
+		var err error
+		if lefts, err = phlaremodel.DiffBaselineWindows(c.Msg.Left, c.Msg.Baseline); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	leftTrees := make([]phlaremodel.Tree, len(lefts))
+	var right phlaremodel.Tree
+	for i, left := range lefts {
+		g.Go(getTree(&leftTrees[i], left))
+	}
 	g.Go(getTree(&right, c.Msg.Right))
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
 
-	diff, err := phlaremodel.NewFlamegraphDiff(&left, &right, 0)
+	left := &leftTrees[0]
+	var changes []*querierv1.DiffNodeChange
+	if c.Msg.Baseline != nil {
+		windows := make([]*phlaremodel.Tree, len(leftTrees))
+		for i := range leftTrees {
+			windows[i] = &leftTrees[i]
+		}
+		left = phlaremodel.NewBaselineTree(windows...)
+		changes = phlaremodel.NewDiffChanges(left, &right, c.Msg.Baseline.Threshold, 0)
+	}
+
+	diff, err := phlaremodel.NewFlamegraphDiff(left, &right, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff}), nil
+	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff, Changes: changes}), nil
 }
 
-// Stubs: these methods are not supposed to be implemented
-// and only needed to satisfy interfaces.
-
 func (r *Router) AnalyzeQuery(
 	ctx context.Context,
-	req *connect.Request[querierv1.AnalyzeQueryRequest],
+	c *connect.Request[querierv1.AnalyzeQueryRequest],
 ) (*connect.Response[querierv1.AnalyzeQueryResponse], error) {
-	if r.oldFrontend != nil {
-		return r.oldFrontend.AnalyzeQuery(ctx, req)
-	}
-	return connect.NewResponse(&querierv1.AnalyzeQueryResponse{}), nil
+	return Query[querierv1.AnalyzeQueryRequest, querierv1.AnalyzeQueryResponse](ctx, r, c,
+		func(_, _ *querierv1.AnalyzeQueryRequest) {},
+		func(a, b *querierv1.AnalyzeQueryResponse) (*querierv1.AnalyzeQueryResponse, error) {
+			// Query scopes of the read paths are reported separately.
+			// Note that the series that are present in both time
+			// ranges are counted twice.
+			impact := new(querierv1.QueryImpact)
+			for _, x := range []*querierv1.QueryImpact{a.QueryImpact, b.QueryImpact} {
+				impact.TotalBytesInTimeRange += x.GetTotalBytesInTimeRange()
+				impact.TotalQueriedSeries += x.GetTotalQueriedSeries()
+				impact.DeduplicationNeeded = impact.DeduplicationNeeded || x.GetDeduplicationNeeded()
+			}
+			return &querierv1.AnalyzeQueryResponse{
+				QueryScopes: append(a.QueryScopes, b.QueryScopes...),
+				QueryImpact: impact,
+			}, nil
+		})
 }
 
 func (r *Router) GetProfileStats(
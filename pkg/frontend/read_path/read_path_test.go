@@ -300,3 +300,49 @@ func (s *routerTestSuite) Test_TimeSeries_NoLimit() {
 	s.Require().NoError(err)
 	s.Assert().Equal(expected, resp)
 }
+
+func (s *routerTestSuite) Test_AnalyzeQuery() {
+	s.overrides.On("ReadPathOverrides", "tenant-a").Return(Config{
+		EnableQueryBackend:     true,
+		EnableQueryBackendFrom: time.Unix(5, 0),
+	})
+
+	req := connect.NewRequest(&querierv1.AnalyzeQueryRequest{Start: 10, End: 10000})
+	s.oldFrontend.On("AnalyzeQuery", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&querierv1.AnalyzeQueryResponse{
+			QueryScopes: []*querierv1.QueryScope{
+				{ComponentType: "Short term storage", BlockCount: 1},
+				{ComponentType: "Long term storage", BlockCount: 2},
+			},
+			QueryImpact: &querierv1.QueryImpact{
+				TotalBytesInTimeRange: 100,
+				TotalQueriedSeries:    3,
+				DeduplicationNeeded:   true,
+			},
+		}), nil).Once()
+	s.newFrontend.On("AnalyzeQuery", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&querierv1.AnalyzeQueryResponse{
+			QueryScopes: []*querierv1.QueryScope{
+				{ComponentType: "Object storage", BlockCount: 4, DatasetCount: 8},
+			},
+			QueryImpact: &querierv1.QueryImpact{
+				TotalBytesInTimeRange: 200,
+				TotalQueriedSeries:    2,
+			},
+		}), nil).Once()
+
+	resp, err := s.router.AnalyzeQuery(s.ctx, req)
+	s.Require().NoError(err)
+	s.Assert().Equal(&querierv1.AnalyzeQueryResponse{
+		QueryScopes: []*querierv1.QueryScope{
+			{ComponentType: "Short term storage", BlockCount: 1},
+			{ComponentType: "Long term storage", BlockCount: 2},
+			{ComponentType: "Object storage", BlockCount: 4, DatasetCount: 8},
+		},
+		QueryImpact: &querierv1.QueryImpact{
+			TotalBytesInTimeRange: 300,
+			TotalQueriedSeries:    5,
+			DeduplicationNeeded:   true,
+		},
+	}, resp.Msg)
+}
@@ -0,0 +1,243 @@
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/cache"
+	"github.com/grafana/dskit/tenant"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	lrucache "github.com/grafana/pyroscope/pkg/util/cache"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+)
+
+const (
+	ResultsCacheBackendInMemory  = "inmemory"
+	ResultsCacheBackendMemcached = cache.BackendMemcached
+)
+
+type ResultsCacheConfig struct {
+	Backend      string                      `yaml:"backend"`
+	InMemory     InMemoryCacheConfig         `yaml:"inmemory"`
+	Memcached    cache.MemcachedClientConfig `yaml:"memcached"`
+	TTL          time.Duration               `yaml:"ttl" category:"advanced"`
+	MaxFreshness time.Duration               `yaml:"max_freshness" category:"advanced"`
+}
+
+type InMemoryCacheConfig struct {
+	MaxSizeBytes int `yaml:"max_size_bytes"`
+}
+
+func (cfg *ResultsCacheConfig) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
+	f.StringVar(&cfg.Backend, prefix+"backend", "", fmt.Sprintf("Backend for the query results cache. Supported values: %s, %s. If empty, the results are not cached.", ResultsCacheBackendInMemory, ResultsCacheBackendMemcached))
+	f.IntVar(&cfg.InMemory.MaxSizeBytes, prefix+"inmemory.max-size-bytes", 256<<20, "Maximum size of the in-memory results cache in bytes.")
+	cfg.Memcached.RegisterFlagsWithPrefix(prefix+"memcached.", f)
+	f.DurationVar(&cfg.TTL, prefix+"ttl", 24*time.Hour, "How long to keep the results in the cache.")
+	f.DurationVar(&cfg.MaxFreshness, prefix+"max-freshness", 10*time.Minute, "Results of the sub-queries whose time range ends within this period before now are not cached, as the data may still be changing. The period is extended to the tenant ingestion window (validation.reject-older-than), if longer. If the ingestion window is not limited, only this period applies.")
+}
+
+func (cfg *ResultsCacheConfig) Validate() error {
+	switch cfg.Backend {
+	case "":
+		return nil
+	case ResultsCacheBackendInMemory:
+		if cfg.InMemory.MaxSizeBytes <= 0 {
+			return fmt.Errorf("results cache: in-memory cache size must be positive")
+		}
+		return nil
+	case ResultsCacheBackendMemcached:
+		return cfg.Memcached.Validate()
+	default:
+		return fmt.Errorf("results cache: unsupported backend %q", cfg.Backend)
+	}
+}
+
+// resultsCache stores the responses to sub-queries of past time
+// intervals: such responses are immutable and can be reused by
+// queries of overlapping time ranges. Responses are keyed by the
+// tenant, the procedure, and the sub-query, including its time range.
+//
+// Profiles are accepted by the distributor within the tenant ingestion
+// window: results of sub-queries that end within the window are never
+// cached, as late profiles may still arrive.
+//
+// A nil cache is valid and does not cache anything.
+type resultsCache struct {
+	cache        cache.Cache
+	stop         func()
+	limits       ResultsCacheLimits
+	ttl          time.Duration
+	maxFreshness time.Duration
+
+	requests prometheus.Counter
+	hits     prometheus.Counter
+}
+
+type ResultsCacheLimits interface {
+	RejectOlderThan(tenantID string) time.Duration
+}
+
+func newResultsCache(cfg ResultsCacheConfig, limits ResultsCacheLimits, logger log.Logger, reg prometheus.Registerer) (*resultsCache, error) {
+	c := &resultsCache{
+		limits:       limits,
+		ttl:          cfg.TTL,
+		maxFreshness: cfg.MaxFreshness,
+		stop:         func() {},
+	}
+	switch cfg.Backend {
+	case "":
+		return nil, nil
+	case ResultsCacheBackendInMemory:
+		c.cache = newMemoryCache(cfg.InMemory.MaxSizeBytes)
+	case ResultsCacheBackendMemcached:
+		client, err := cache.NewMemcachedClientWithConfig(logger, "frontend-results-cache", cfg.Memcached, reg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create memcached client: %w", err)
+		}
+		c.cache = cache.NewMemcachedCache("frontend-results-cache", logger, client, reg)
+		c.stop = client.Stop
+	default:
+		return nil, fmt.Errorf("unsupported results cache backend: %s", cfg.Backend)
+	}
+	c.requests = promauto.With(reg).NewCounter(prometheus.CounterOpts{
+		Name: "pyroscope_query_frontend_results_cache_requests_total",
+		Help: "Total number of requests to the query results cache.",
+	})
+	c.hits = promauto.With(reg).NewCounter(prometheus.CounterOpts{
+		Name: "pyroscope_query_frontend_results_cache_hits_total",
+		Help: "Total number of requests to the query results cache that were a hit.",
+	})
+	return c, nil
+}
+
+func (c *resultsCache) close() {
+	if c != nil {
+		c.stop()
+	}
+}
+
+// key returns the cache key of the sub-query of the given type.
+// If the sub-query is not cacheable, the function returns false.
+func (c *resultsCache) key(ctx context.Context, typ string, req []byte, end time.Time) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil || end.After(time.Now().Add(-c.freshness(tenantIDs))) {
+		return "", false
+	}
+	h := sha256.New()
+	_, _ = h.Write([]byte(tenant.JoinTenantIDs(tenantIDs)))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(connectgrpc.ProcedureFromContext(ctx)))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(typ))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(req)
+	return "frontend:results:" + hex.EncodeToString(h.Sum(nil)), true
+}
+
+// freshness returns the period before now within which
+// the results of the tenants sub-queries are not cached.
+func (c *resultsCache) freshness(tenantIDs []string) time.Duration {
+	freshness := c.maxFreshness
+	for _, tenantID := range tenantIDs {
+		freshness = max(freshness, c.limits.RejectOlderThan(tenantID))
+	}
+	return freshness
+}
+
+func (c *resultsCache) fetch(ctx context.Context, key string) ([]byte, bool) {
+	c.requests.Inc()
+	b, ok := c.cache.Fetch(ctx, []string{key})[key]
+	if ok {
+		c.hits.Inc()
+	}
+	return b, ok
+}
+
+func (c *resultsCache) store(key string, value []byte) {
+	c.cache.StoreAsync(map[string][]byte{key: value}, c.ttl)
+}
+
+type cacheableMessage[T any] interface {
+	*T
+	MarshalVT() ([]byte, error)
+	UnmarshalVT([]byte) error
+}
+
+// roundTripCached sends the sub-query of the time range that ends at the
+// given time, unless the response is found in the results cache.
+func roundTripCached[Req, Res any, PReq cacheableMessage[Req], PRes cacheableMessage[Res]](
+	ctx context.Context,
+	f *Frontend,
+	req *connect.Request[Req],
+	end time.Time,
+) (*connect.Response[Res], error) {
+	if f.resultsCache == nil {
+		return connectgrpc.RoundTripUnary[Req, Res](ctx, f, req)
+	}
+	b, err := PReq(req.Msg).MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	key, ok := f.resultsCache.key(ctx, fmt.Sprintf("%T", req.Msg), b, end)
+	if !ok {
+		return connectgrpc.RoundTripUnary[Req, Res](ctx, f, req)
+	}
+	if b, ok = f.resultsCache.fetch(ctx, key); ok {
+		var res Res
+		if err = PRes(&res).UnmarshalVT(b); err == nil {
+			return connect.NewResponse(&res), nil
+		}
+	}
+	resp, err := connectgrpc.RoundTripUnary[Req, Res](ctx, f, req)
+	if err != nil {
+		return nil, err
+	}
+	if b, err = PRes(resp.Msg).MarshalVT(); err == nil {
+		f.resultsCache.store(key, b)
+	}
+	return resp, nil
+}
+
+// memoryCache is an LRU cache limited by the total size of the values.
+type memoryCache struct {
+	lru *lrucache.LRU
+}
+
+func newMemoryCache(maxSize int) *memoryCache {
+	return &memoryCache{lru: lrucache.NewLRU(int64(maxSize), nil)}
+}
+
+func (c *memoryCache) StoreAsync(data map[string][]byte, ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl)
+	for k, v := range data {
+		c.lru.Set(k, v, expiresAt)
+	}
+}
+
+func (c *memoryCache) Fetch(_ context.Context, keys []string, _ ...cache.Option) map[string][]byte {
+	found := make(map[string][]byte, len(keys))
+	for _, k := range keys {
+		if v, ok := c.lru.Get(k); ok {
+			found[k] = v
+		}
+	}
+	return found
+}
+
+func (c *memoryCache) Delete(_ context.Context, key string) error {
+	c.lru.Delete(key)
+	return nil
+}
+
+func (c *memoryCache) Name() string { return "frontend-results-cache" }
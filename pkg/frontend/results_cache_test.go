@@ -0,0 +1,275 @@
+package frontend
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/flagext"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func newResultsCacheTestFrontend(t *testing.T, cfg ResultsCacheConfig) (*Frontend, *atomic.Int64) {
+	c, err := newResultsCache(cfg, &mockLimits{}, log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	t.Cleanup(c.close)
+
+	var calls atomic.Int64
+	f := &Frontend{limits: &mockLimits{}, resultsCache: c}
+	f.GRPCRoundTripper = &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+		calls.Inc()
+		if strings.HasSuffix(req.Url, "SelectSeries") {
+			return connectgrpc.HandleUnary[querierv1.SelectSeriesRequest, querierv1.SelectSeriesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+				return connect.NewResponse(&querierv1.SelectSeriesResponse{
+					Series: []*typesv1.Series{{
+						Labels: []*typesv1.LabelPair{{Name: "service_name", Value: "svc"}},
+						Points: []*typesv1.Point{{Timestamp: req.Msg.Start, Value: 1}},
+					}},
+				}), nil
+			})
+		}
+		return connectgrpc.HandleUnary[querierv1.SelectMergeStacktracesRequest, querierv1.SelectMergeStacktracesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+			s := new(model.Tree)
+			s.InsertStack(1, "foo", "bar")
+			return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Tree: s.Bytes(-1)}), nil
+		})
+	}}
+	return f, &calls
+}
+
+func defaultResultsCacheTestConfig(backend string) ResultsCacheConfig {
+	var cfg ResultsCacheConfig
+	cfg.RegisterFlagsWithPrefix("", flag.NewFlagSet("", flag.PanicOnError))
+	cfg.Backend = backend
+	return cfg
+}
+
+func testResultsCache(t *testing.T, f *Frontend, calls *atomic.Int64, waitStored func(n int)) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	// The range spans two splits.
+	start := time.Now().Truncate(time.Hour).Add(-3 * time.Hour).Add(30 * time.Minute)
+	end := start.Add(time.Hour)
+
+	selectSeries := func() *querierv1.SelectSeriesResponse {
+		resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+			ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+			LabelSelector: "{}",
+			Start:         start.UnixMilli(),
+			End:           end.UnixMilli(),
+			Step:          15,
+		}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+	selectTree := func(labelSelector string) *model.Tree {
+		tree, err := f.selectMergeStacktracesTree(ctx, connect.NewRequest(&querierv1.SelectMergeStacktracesRequest{
+			ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+			LabelSelector: labelSelector,
+			Start:         start.UnixMilli(),
+			End:           end.UnixMilli(),
+		}))
+		require.NoError(t, err)
+		return tree
+	}
+
+	expectedSeries := selectSeries()
+	assert.Equal(t, int64(2), calls.Load())
+	waitStored(2)
+	assert.Equal(t, expectedSeries, selectSeries())
+	assert.Equal(t, int64(2), calls.Load())
+
+	expectedTree := selectTree("{}")
+	assert.Equal(t, int64(4), calls.Load())
+	waitStored(4)
+	assert.Equal(t, expectedTree.String(), selectTree("{}").String())
+	assert.Equal(t, int64(4), calls.Load())
+
+	// A different query is not served from the cache.
+	selectTree(`{service_name="svc"}`)
+	assert.Equal(t, int64(6), calls.Load())
+	assert.Equal(t, 4.0, testutil.ToFloat64(f.resultsCache.hits))
+}
+
+func TestResultsCache_InMemory(t *testing.T) {
+	f, calls := newResultsCacheTestFrontend(t, defaultResultsCacheTestConfig(ResultsCacheBackendInMemory))
+	testResultsCache(t, f, calls, func(int) {})
+}
+
+func TestResultsCache_Memcached(t *testing.T) {
+	server := newMemcachedTestServer(t)
+	cfg := defaultResultsCacheTestConfig(ResultsCacheBackendMemcached)
+	cfg.Memcached.Addresses = flagext.StringSliceCSV{server.addr()}
+	f, calls := newResultsCacheTestFrontend(t, cfg)
+	testResultsCache(t, f, calls, func(n int) {
+		require.Eventually(t, func() bool {
+			return server.len() == n
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestResultsCache_Fresh(t *testing.T) {
+	f, calls := newResultsCacheTestFrontend(t, defaultResultsCacheTestConfig(ResultsCacheBackendInMemory))
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	// The range must fall within a single split.
+	end := time.Now()
+	start := end.Truncate(time.Hour)
+	if !start.Before(end) {
+		t.Skip("the time range is empty")
+	}
+	req := &querierv1.SelectSeriesRequest{
+		ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+		LabelSelector: "{}",
+		Start:         start.UnixMilli(),
+		End:           end.UnixMilli(),
+		Step:          15,
+	}
+	for i := 1; i <= 2; i++ {
+		_, err := f.SelectSeries(ctx, connect.NewRequest(req.CloneVT()))
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), calls.Load())
+	}
+	assert.Zero(t, testutil.ToFloat64(f.resultsCache.requests))
+}
+
+func TestResultsCache_IngestionWindow(t *testing.T) {
+	c, err := newResultsCache(defaultResultsCacheTestConfig(ResultsCacheBackendInMemory), validation.MockLimits{
+		RejectOlderThanValue: 2 * time.Hour,
+	}, log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	t.Cleanup(c.close)
+	ctx := user.InjectOrgID(context.Background(), "test")
+	// Late profiles may still arrive within the ingestion window.
+	_, ok := c.key(ctx, "test", nil, time.Now().Add(-time.Hour))
+	assert.False(t, ok)
+	_, ok = c.key(ctx, "test", nil, time.Now().Add(-3*time.Hour))
+	assert.True(t, ok)
+}
+
+func TestMemoryCache_Eviction(t *testing.T) {
+	c := newMemoryCache(10)
+	ctx := context.Background()
+	c.StoreAsync(map[string][]byte{"a": []byte("1234")}, time.Hour)
+	c.StoreAsync(map[string][]byte{"b": []byte("1234")}, time.Hour)
+	assert.Len(t, c.Fetch(ctx, []string{"a", "b"}), 2)
+	// "a" is the least recently used: it's evicted.
+	c.StoreAsync(map[string][]byte{"c": []byte("1234")}, time.Hour)
+	assert.Equal(t, map[string][]byte{"b": []byte("1234"), "c": []byte("1234")}, c.Fetch(ctx, []string{"a", "b", "c"}))
+	assert.Equal(t, int64(8), c.lru.Size())
+	// Too large.
+	c.StoreAsync(map[string][]byte{"d": []byte("12345678901")}, time.Hour)
+	assert.Empty(t, c.Fetch(ctx, []string{"d"}))
+	// Expired.
+	c.StoreAsync(map[string][]byte{"b": []byte("12")}, -time.Second)
+	assert.Empty(t, c.Fetch(ctx, []string{"b"}))
+	assert.Equal(t, int64(4), c.lru.Size())
+}
+
+// memcachedTestServer implements the subset of the memcached
+// text protocol used by the results cache.
+type memcachedTestServer struct {
+	l net.Listener
+
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMemcachedTestServer(t *testing.T) *memcachedTestServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &memcachedTestServer{l: l, items: make(map[string][]byte)}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *memcachedTestServer) addr() string { return s.l.Addr().String() }
+
+func (s *memcachedTestServer) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+func (s *memcachedTestServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+		switch args[0] {
+		case "get", "gets":
+			s.mu.Lock()
+			for _, k := range args[1:] {
+				if v, ok := s.items[k]; ok {
+					_, _ = fmt.Fprintf(w, "VALUE %s 0 %d 0\r\n%s\r\n", k, len(v), v)
+				}
+			}
+			s.mu.Unlock()
+			_, _ = w.WriteString("END\r\n")
+		case "set":
+			if len(args) < 5 {
+				_, _ = w.WriteString("ERROR\r\n")
+				break
+			}
+			n, err := strconv.Atoi(args[4])
+			if err != nil {
+				return
+			}
+			v := make([]byte, n+2)
+			if _, err = io.ReadFull(r, v); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.items[args[1]] = v[:n]
+			s.mu.Unlock()
+			if args[len(args)-1] != "noreply" {
+				_, _ = w.WriteString("STORED\r\n")
+			}
+		default:
+			_, _ = w.WriteString("ERROR\r\n")
+		}
+		if err = w.Flush(); err != nil {
+			return
+		}
+	}
+}
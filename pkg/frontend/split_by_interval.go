@@ -16,6 +16,8 @@ type TimeIntervalIterator struct {
 	endTime   int64
 	interval  int64
 	alignment int64
+	stable    bool
+	offset    int64
 }
 
 type TimeInterval struct{ Start, End time.Time }
@@ -33,6 +35,20 @@ func WithAlignment(a time.Duration) TimeIntervalIteratorOption {
 	}
 }
 
+// WithStableBoundaries causes sub-range boundaries not to depend on the
+// range start time: sub-ranges start at multiples of the interval, offset
+// by the range start time modulo the alignment. Sub-ranges of overlapping
+// time ranges therefore match, which makes their results reusable.
+//
+// If the interval is not a multiple of the alignment, it is truncated.
+// The option has no effect without alignment: in that case, sub-ranges
+// start at multiples of the interval anyway.
+func WithStableBoundaries() TimeIntervalIteratorOption {
+	return func(i *TimeIntervalIterator) {
+		i.stable = true
+	}
+}
+
 // NewTimeIntervalIterator returns a new interval iterator.
 // If the interval is zero, the entire time span is taken as a single interval.
 func NewTimeIntervalIterator(startTime, endTime time.Time, interval time.Duration,
@@ -49,6 +65,10 @@ func NewTimeIntervalIterator(startTime, endTime time.Time, interval time.Duratio
 		option(i)
 	}
 	i.interval = max(i.interval, i.alignment)
+	if i.stable && i.alignment > 0 {
+		i.interval -= i.interval % i.alignment
+		i.offset = i.startTime % i.alignment
+	}
 	return i
 }
 
@@ -57,7 +77,11 @@ func (i *TimeIntervalIterator) Next() bool { return i.startTime < i.endTime }
 func (i *TimeIntervalIterator) At() TimeInterval {
 	t := TimeInterval{Start: time.Unix(0, i.startTime)}
 	i.startTime += i.interval
-	if i.alignment > 0 {
+	if i.stable && i.alignment > 0 {
+		// Sub-ranges start at a multiple of 'interval',
+		// preserving the 'alignment' of the range start.
+		i.startTime -= (i.startTime - i.offset) % i.interval
+	} else if i.alignment > 0 {
 		// Sub-ranges start at a multiple of 'alignment'.
 		i.startTime -= i.interval % i.alignment
 	} else {
@@ -128,6 +128,33 @@ func Test_TimeIntervalIterator(t *testing.T) {
 	}
 }
 
+func Test_TimeIntervalIterator_StableBoundaries(t *testing.T) {
+	split := func(start, end int64) []TimeInterval {
+		actual, err := iter.Slice[TimeInterval](NewTimeIntervalIterator(time.Unix(0, start), time.Unix(0, end), 100,
+			WithAlignment(15), WithStableBoundaries()))
+		require.NoError(t, err)
+		return actual
+	}
+
+	// The interval is truncated to a multiple of the alignment,
+	// and the sub-ranges keep the offset of the range start.
+	require.Equal(t, []TimeInterval{
+		{time.Unix(0, 5), time.Unix(0, 94)},
+		{time.Unix(0, 95), time.Unix(0, 184)},
+		{time.Unix(0, 185), time.Unix(0, 274)},
+		{time.Unix(0, 275), time.Unix(0, 300)},
+	}, split(5, 300))
+
+	// Overlapping ranges with the same offset are split identically.
+	require.Equal(t, []TimeInterval{
+		{time.Unix(0, 50), time.Unix(0, 94)},
+		{time.Unix(0, 95), time.Unix(0, 184)},
+		{time.Unix(0, 185), time.Unix(0, 274)},
+		{time.Unix(0, 275), time.Unix(0, 364)},
+		{time.Unix(0, 365), time.Unix(0, 400)},
+	}, split(50, 400))
+}
+
 func Test_TimeIntervalIterator_MillisecondsTruncation(t *testing.T) {
 	actual, err := iter.Slice[TimeInterval](NewTimeIntervalIterator(
 		time.UnixMilli(51),
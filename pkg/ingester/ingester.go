@@ -24,6 +24,7 @@ import (
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	ingesterv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
 	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	"github.com/grafana/pyroscope/pkg/experiment/metrics"
 	phlareobj "github.com/grafana/pyroscope/pkg/objstore"
 	phlareobjclient "github.com/grafana/pyroscope/pkg/objstore/client"
 	phlarecontext "github.com/grafana/pyroscope/pkg/phlare/context"
@@ -39,15 +40,17 @@ var activeTenantsStats = usagestats.NewInt("ingester_active_tenants")
 
 type Config struct {
 	LifecyclerConfig ring.LifecyclerConfig `yaml:"lifecycler,omitempty"`
+	MetricsExporter  metrics.Config        `yaml:"metrics_exporter" doc:"hidden"`
 }
 
 // RegisterFlags registers the flags.
 func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	cfg.LifecyclerConfig.RegisterFlags(f, util.Logger)
+	cfg.MetricsExporter.RegisterFlagsWithPrefix("ingester.metrics-exporter.", f)
 }
 
 func (cfg *Config) Validate() error {
-	return nil
+	return cfg.MetricsExporter.Validate()
 }
 
 type Ingester struct {
@@ -71,6 +74,10 @@ type Ingester struct {
 	limits              Limits
 	reg                 prometheus.Registerer
 	usageGroupEvaluator *validation.UsageGroupEvaluator
+
+	ruler         metrics.Ruler
+	exporter      metrics.Exporter
+	ingestersRing ring.ReadRing
 }
 
 type ingesterFlusherCompat struct {
@@ -84,7 +91,17 @@ func (i *ingesterFlusherCompat) Flush() {
 	}
 }
 
-func New(phlarectx context.Context, cfg Config, dbConfig phlaredb.Config, storageBucket phlareobj.Bucket, limits Limits, queryStoreAfter time.Duration) (*Ingester, error) {
+func New(
+	phlarectx context.Context,
+	cfg Config,
+	dbConfig phlaredb.Config,
+	storageBucket phlareobj.Bucket,
+	limits Limits,
+	queryStoreAfter time.Duration,
+	ruler metrics.Ruler,
+	exporter metrics.Exporter,
+	ingestersRing ring.ReadRing,
+) (*Ingester, error) {
 	i := &Ingester{
 		cfg:           cfg,
 		phlarectx:     phlarectx,
@@ -94,6 +111,9 @@ func New(phlarectx context.Context, cfg Config, dbConfig phlaredb.Config, storag
 		dbConfig:      dbConfig,
 		storageBucket: storageBucket,
 		limits:        limits,
+		ruler:         ruler,
+		exporter:      exporter,
+		ingestersRing: ingestersRing,
 	}
 
 	// initialise the local bucket client
@@ -152,7 +172,40 @@ func New(phlarectx context.Context, cfg Config, dbConfig phlaredb.Config, storag
 }
 
 func (i *Ingester) starting(ctx context.Context) error {
-	return services.StartManagerAndAwaitHealthy(ctx, i.subservices)
+	if err := services.StartManagerAndAwaitHealthy(ctx, i.subservices); err != nil {
+		return err
+	}
+	return i.openInstancesWithWAL()
+}
+
+// openInstancesWithWAL opens the instances of the tenants that have
+// write-ahead logs left from the previous run: the logs are replayed
+// when the instance is created.
+func (i *Ingester) openInstancesWithWAL() error {
+	entries, err := os.ReadDir(i.dbConfig.DataPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		tenantID := entry.Name()
+		hasWAL, err := phlaredb.HasWAL(filepath.Join(i.dbConfig.DataPath, tenantID))
+		if err != nil {
+			return err
+		}
+		if !hasWAL {
+			continue
+		}
+		if _, err = i.getOrCreateInstance(tenantID); err != nil {
+			return fmt.Errorf("replaying wal of tenant %s: %w", tenantID, err)
+		}
+	}
+	return nil
 }
 
 func (i *Ingester) running(ctx context.Context) error {
@@ -173,6 +226,9 @@ func (i *Ingester) stopping(_ error) error {
 	for _, inst := range i.instances {
 		errs.Add(inst.Stop())
 	}
+	if i.exporter != nil {
+		i.exporter.Flush()
+	}
 	return errs.Err()
 }
 
@@ -188,7 +244,8 @@ func (i *Ingester) getOrCreateInstance(tenantID string) (*instance, error) {
 	if !ok {
 		var err error
 
-		inst, err = newInstance(i.phlarectx, i.dbConfig, tenantID, i.localBucket, i.storageBucket, NewLimiter(tenantID, i.limits, i.lifecycler, i.cfg.LifecyclerConfig.RingConfig.ReplicationFactor))
+		limiter := NewLimiter(tenantID, i.limits, i.lifecycler, i.cfg.LifecyclerConfig.RingConfig.ReplicationFactor)
+		inst, err = newInstance(i.phlarectx, i.dbConfig, tenantID, i.localBucket, i.storageBucket, limiter, i.dbOptions(tenantID)...)
 		if err != nil {
 			return nil, err
 		}
@@ -236,7 +293,7 @@ func (i *Ingester) getOrOpenInstance(tenantID string) (*instance, error) {
 	}
 
 	limiter := NewLimiter(tenantID, i.limits, i.lifecycler, i.cfg.LifecyclerConfig.RingConfig.ReplicationFactor)
-	inst, err = newInstance(i.phlarectx, i.dbConfig, tenantID, i.localBucket, i.storageBucket, limiter)
+	inst, err = newInstance(i.phlarectx, i.dbConfig, tenantID, i.localBucket, i.storageBucket, limiter, i.dbOptions(tenantID)...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +396,7 @@ func (i *Ingester) Push(ctx context.Context, req *connect.Request[pushv1.PushReq
 
 		for _, series := range req.Msg.Series {
 			groups := i.usageGroupEvaluator.GetMatch(instance.tenantID, usageGroups, series.Labels)
+			lbls := i.observeLabels(instance.tenantID, series.Labels)
 
 			for _, sample := range series.Samples {
 				err := pprof.FromBytes(sample.RawProfile, func(p *profilev1.Profile, size int) error {
@@ -346,7 +404,7 @@ func (i *Ingester) Push(ctx context.Context, req *connect.Request[pushv1.PushReq
 					if err != nil {
 						return err
 					}
-					if err = instance.Ingest(ctx, p, id, series.Annotations, series.Labels...); err != nil {
+					if err = instance.Ingest(ctx, p, id, series.Annotations, lbls...); err != nil {
 						reason := validation.ReasonOf(err)
 						if reason != validation.Unknown {
 							validation.DiscardedProfiles.WithLabelValues(string(reason), instance.tenantID).Add(float64(1))
@@ -73,7 +73,7 @@ func Test_MultitenantReadWrite(t *testing.T) {
 	ing, err := New(ctx, defaultIngesterTestConfig(t), phlaredb.Config{
 		DataPath:         dbPath,
 		MaxBlockDuration: 30 * time.Hour,
-	}, fs, &fakeLimits{}, 0)
+	}, fs, &fakeLimits{}, 0, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, services.StartAndAwaitRunning(context.Background(), ing))
 
@@ -147,7 +147,7 @@ func Test_Query_TenantNotFound(t *testing.T) {
 	ing, err := New(ctx, defaultIngesterTestConfig(t), phlaredb.Config{
 		DataPath:         localPath,
 		MaxBlockDuration: 30 * time.Hour,
-	}, fs, &fakeLimits{}, 0)
+	}, fs, &fakeLimits{}, 0, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, services.StartAndAwaitRunning(context.Background(), ing))
 
@@ -187,7 +187,7 @@ func Test_Query_TenantFound(t *testing.T) {
 	ing, err := New(phlareCtx, defaultIngesterTestConfig(t), phlaredb.Config{
 		DataPath:         dbPath,
 		MaxBlockDuration: 30 * time.Hour,
-	}, fs, &fakeLimits{}, 0)
+	}, fs, &fakeLimits{}, 0, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, services.StartAndAwaitRunning(context.Background(), ing))
 
@@ -229,7 +229,7 @@ func Test_Query_TenantFound(t *testing.T) {
 	ing, err = New(phlareCtx, defaultIngesterTestConfig(t), phlaredb.Config{
 		DataPath:         dbPath,
 		MaxBlockDuration: 30 * time.Hour,
-	}, fs, &fakeLimits{}, 0)
+	}, fs, &fakeLimits{}, 0, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, services.StartAndAwaitRunning(context.Background(), ing))
 
@@ -61,8 +61,10 @@ func NewOTLPIngestHandler(svc PushService, l log.Logger, me bool) Handler {
 			return
 		}
 
-		// Handle HTTP requests (if we want to support HTTP/Protobuf in future)
-		//if r.URL.Path == "/v1/profiles" {}
+		if r.URL.Path == httpPath {
+			h.serveHTTP(w, r)
+			return
+		}
 
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	})
@@ -87,7 +89,13 @@ func (h *ingestHandler) Export(ctx context.Context, er *pprofileotlp.ExportProfi
 			return &pprofileotlp.ExportProfilesServiceResponse{}, fmt.Errorf("failed to extract tenant ID from GRPC request: %w", err)
 		}
 	}
+	return h.export(ctx, er)
+}
 
+// export pushes the profiles of the request. Profiles that can not be
+// converted are rejected: if some of the profiles were accepted, the
+// response indicates partial success; otherwise, an error is returned.
+func (h *ingestHandler) export(ctx context.Context, er *pprofileotlp.ExportProfilesServiceRequest) (*pprofileotlp.ExportProfilesServiceResponse, error) {
 	dc := er.Dictionary
 	if dc == nil {
 		return &pprofileotlp.ExportProfilesServiceResponse{}, status.Errorf(codes.InvalidArgument, "missing profile metadata dictionary")
@@ -98,6 +106,8 @@ func (h *ingestHandler) Export(ctx context.Context, er *pprofileotlp.ExportProfi
 		return &pprofileotlp.ExportProfilesServiceResponse{}, status.Errorf(codes.InvalidArgument, "missing resource profiles")
 	}
 
+	var total, rejected int64
+	var rejectedErr error
 	for i := 0; i < len(rps); i++ {
 		rp := rps[i]
 
@@ -109,11 +119,15 @@ func (h *ingestHandler) Export(ctx context.Context, er *pprofileotlp.ExportProfi
 
 			for k := 0; k < len(sp.Profiles); k++ {
 				p := sp.Profiles[k]
+				total++
 
 				pprofProfiles, err := ConvertOtelToGoogle(p, dc)
 				if err != nil {
-					grpcError := status.Errorf(codes.InvalidArgument, "failed to convert otel profile: %s", err.Error())
-					return &pprofileotlp.ExportProfilesServiceResponse{}, grpcError
+					rejected++
+					if rejectedErr == nil {
+						rejectedErr = fmt.Errorf("failed to convert otel profile: %w", err)
+					}
+					continue
 				}
 
 				req := &distirbutormodel.PushRequest{
@@ -160,7 +174,19 @@ func (h *ingestHandler) Export(ctx context.Context, er *pprofileotlp.ExportProfi
 		}
 	}
 
-	return &pprofileotlp.ExportProfilesServiceResponse{}, nil
+	if rejected == 0 {
+		return &pprofileotlp.ExportProfilesServiceResponse{}, nil
+	}
+	if rejected == total {
+		return &pprofileotlp.ExportProfilesServiceResponse{}, status.Error(codes.InvalidArgument, rejectedErr.Error())
+	}
+	level.Warn(h.log).Log("msg", "some profiles were rejected", "rejected", rejected, "total", total, "err", rejectedErr)
+	return &pprofileotlp.ExportProfilesServiceResponse{
+		PartialSuccess: &pprofileotlp.ExportProfilesPartialSuccess{
+			RejectedProfiles: rejected,
+			ErrorMessage:     rejectedErr.Error(),
+		},
+	}, nil
 }
 
 // getServiceNameFromAttributes extracts service name from OTLP resource attributes.
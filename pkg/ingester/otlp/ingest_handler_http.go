@@ -0,0 +1,250 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log/level"
+	"github.com/grafana/dskit/user"
+	pprofileotlp "go.opentelemetry.io/proto/otlp/collector/profiles/v1development"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+)
+
+const (
+	// httpPath is the OTLP/HTTP path of the profiles signal.
+	httpPath = "/v1/profiles"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+
+	// maxHTTPBodySize limits the request body size, both compressed and
+	// decompressed; it matches the default gRPC max message size.
+	maxHTTPBodySize = 100 << 20
+)
+
+// serveHTTP handles OTLP/HTTP requests: the binary protobuf and the
+// JSON encodings of ExportProfilesServiceRequest are supported. The
+// response is encoded the same way as the request.
+func (h *ingestHandler) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (contentType != contentTypeProtobuf && contentType != contentTypeJSON) {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", r.Header.Get("Content-Type")), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.Context()
+	if !h.multitenancyEnabled {
+		ctx = user.InjectOrgID(ctx, tenant.DefaultTenantID)
+	} else {
+		if _, ctx, err = user.ExtractOrgIDFromHTTPRequest(r); err != nil {
+			level.Error(h.log).Log("msg", "failed to extract tenant ID from HTTP request", "err", err)
+			h.writeHTTPError(w, contentType, status.Errorf(codes.Unauthenticated, "failed to extract tenant ID from HTTP request: %v", err))
+			return
+		}
+	}
+
+	body, err := readHTTPBody(w, r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			st := status.Newf(codes.ResourceExhausted, "failed to read request body: %v", err)
+			h.writeHTTPResponse(w, contentType, http.StatusRequestEntityTooLarge, st.Proto())
+			return
+		}
+		h.writeHTTPError(w, contentType, status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err))
+		return
+	}
+
+	var req pprofileotlp.ExportProfilesServiceRequest
+	switch contentType {
+	case contentTypeProtobuf:
+		err = proto.Unmarshal(body, &req)
+	case contentTypeJSON:
+		if body, err = convertHexIDs(body); err == nil {
+			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, &req)
+		}
+	}
+	if err != nil {
+		h.writeHTTPError(w, contentType, status.Errorf(codes.InvalidArgument, "failed to decode request: %v", err))
+		return
+	}
+
+	resp, err := h.export(ctx, &req)
+	if err != nil {
+		h.writeHTTPError(w, contentType, err)
+		return
+	}
+	h.writeHTTPResponse(w, contentType, http.StatusOK, resp)
+}
+
+func readHTTPBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	var body io.Reader = http.MaxBytesReader(w, r.Body, maxHTTPBodySize)
+	switch r.Header.Get("Content-Encoding") {
+	case "":
+	case "gzip":
+		gr, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		body = io.LimitReader(gr, maxHTTPBodySize+1)
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", r.Header.Get("Content-Encoding"))
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxHTTPBodySize {
+		return nil, &http.MaxBytesError{Limit: maxHTTPBodySize}
+	}
+	return data, nil
+}
+
+// hexIDPaths lists the OTLP/JSON fields holding IDs: OTLP/JSON encodes
+// them as hex strings, while the protobuf JSON mapping expects base64.
+// Arrays along the path are traversed.
+var hexIDPaths = [][]string{
+	{"dictionary", "linkTable", "traceId"},
+	{"dictionary", "linkTable", "spanId"},
+	{"resourceProfiles", "scopeProfiles", "profiles", "profileId"},
+}
+
+// convertHexIDs re-encodes the hex IDs of the OTLP/JSON request in base64.
+func convertHexIDs(body []byte) ([]byte, error) {
+	for _, p := range hexIDPaths {
+		converted, err := convertHexID(body, p)
+		if err != nil {
+			return nil, err
+		}
+		body = converted
+	}
+	return body, nil
+}
+
+func convertHexID(raw json.RawMessage, path []string) (json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return raw, nil
+	}
+	if len(path) == 0 {
+		var s string
+		if json.Unmarshal(raw, &s) != nil {
+			return raw, nil
+		}
+		id, err := hex.DecodeString(s)
+		if err != nil {
+			// Not hex: left for the protobuf JSON decoder.
+			return raw, nil
+		}
+		return json.Marshal(base64.StdEncoding.EncodeToString(id))
+	}
+	switch raw[0] {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		for i := range items {
+			item, err := convertHexID(items[i], path)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		var found bool
+		// The protobuf JSON mapping accepts both the
+		// lowerCamelCase and the original field names.
+		for _, name := range []string{path[0], snakeCase(path[0])} {
+			v, ok := fields[name]
+			if !ok {
+				continue
+			}
+			found = true
+			converted, err := convertHexID(v, path[1:])
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = converted
+		}
+		if !found {
+			return raw, nil
+		}
+		return json.Marshal(fields)
+	default:
+		return raw, nil
+	}
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// writeHTTPError writes the error as google.rpc.Status,
+// as required by the OTLP/HTTP specification.
+func (h *ingestHandler) writeHTTPError(w http.ResponseWriter, contentType string, err error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		// Errors returned by the push service are connect errors.
+		s = status.New(codes.Code(connect.CodeOf(err)), err.Error())
+	}
+	code := connectgrpc.CodeToHTTP(connect.Code(s.Code()))
+	h.writeHTTPResponse(w, contentType, int(code), s.Proto())
+}
+
+func (h *ingestHandler) writeHTTPResponse(w http.ResponseWriter, contentType string, code int, m proto.Message) {
+	var data []byte
+	var err error
+	switch contentType {
+	case contentTypeJSON:
+		data, err = protojson.Marshal(m)
+	default:
+		data, err = proto.Marshal(m)
+	}
+	if err != nil {
+		level.Error(h.log).Log("msg", "failed to encode response", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if _, err = w.Write(data); err != nil {
+		level.Warn(h.log).Log("msg", "failed to write response", "err", err)
+	}
+}
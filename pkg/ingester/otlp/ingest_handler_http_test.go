@@ -0,0 +1,191 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1experimental2 "go.opentelemetry.io/proto/otlp/collector/profiles/v1development"
+	v1experimental "go.opentelemetry.io/proto/otlp/profiles/v1development"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/grafana/pyroscope/pkg/test"
+	"github.com/grafana/pyroscope/pkg/test/mocks/mockotlp"
+)
+
+func newTestExportRequest(invalid ...bool) *v1experimental2.ExportProfilesServiceRequest {
+	otlpb := new(otlpbuilder)
+	otlpb.dictionary.FunctionTable = []*v1experimental.Function{{
+		NameStrindex: otlpb.addstr("main"),
+	}}
+	otlpb.dictionary.MappingTable = []*v1experimental.Mapping{{
+		MemoryStart:      0x1000,
+		MemoryLimit:      0x2000,
+		FilenameStrindex: otlpb.addstr("main.so"),
+	}}
+	otlpb.dictionary.LocationTable = []*v1experimental.Location{{
+		MappingIndex: int32ptr(0),
+		Address:      0x1100,
+		Line:         []*v1experimental.Line{{FunctionIndex: 0}},
+	}}
+	sampleType := []*v1experimental.ValueType{{
+		TypeStrindex: otlpb.addstr("samples"),
+		UnitStrindex: otlpb.addstr("count"),
+	}}
+	periodType := &v1experimental.ValueType{
+		TypeStrindex: otlpb.addstr("cpu"),
+		UnitStrindex: otlpb.addstr("nanoseconds"),
+	}
+
+	var profiles []*v1experimental.Profile
+	for _, v := range invalid {
+		p := &v1experimental.Profile{
+			SampleType:      sampleType,
+			PeriodType:      periodType,
+			Period:          10000000,
+			LocationIndices: []int32{0},
+			Sample: []*v1experimental.Sample{{
+				LocationsLength: 1,
+				Value:           []int64{1},
+			}},
+		}
+		if v {
+			p.DefaultSampleTypeIndex = 42
+		}
+		profiles = append(profiles, p)
+	}
+
+	return &v1experimental2.ExportProfilesServiceRequest{
+		ResourceProfiles: []*v1experimental.ResourceProfiles{{
+			ScopeProfiles: []*v1experimental.ScopeProfiles{{
+				Profiles: profiles,
+			}},
+		}},
+		Dictionary: &otlpb.dictionary,
+	}
+}
+
+func TestHTTPExport(t *testing.T) {
+	valid := newTestExportRequest(false)
+	protobufBody, err := proto.Marshal(valid)
+	require.NoError(t, err)
+	jsonBody, err := protojson.Marshal(valid)
+	require.NoError(t, err)
+	var gzipBody bytes.Buffer
+	gw := gzip.NewWriter(&gzipBody)
+	_, err = gw.Write(protobufBody)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	var gzipBomb bytes.Buffer
+	gw = gzip.NewWriter(&gzipBomb)
+	_, err = gw.Write(make([]byte, maxHTTPBodySize+1))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	for _, tc := range []struct {
+		name            string
+		method          string
+		contentType     string
+		contentEncoding string
+		body            []byte
+		pushes          int
+		status          int
+	}{
+		{name: "protobuf", contentType: contentTypeProtobuf, body: protobufBody, pushes: 1, status: http.StatusOK},
+		{name: "json", contentType: contentTypeJSON, body: jsonBody, pushes: 1, status: http.StatusOK},
+		{name: "gzip", contentType: contentTypeProtobuf, contentEncoding: "gzip", body: gzipBody.Bytes(), pushes: 1, status: http.StatusOK},
+		{name: "too large decompressed", contentType: contentTypeProtobuf, contentEncoding: "gzip", body: gzipBomb.Bytes(), status: http.StatusRequestEntityTooLarge},
+		{name: "unsupported content type", contentType: "text/plain", body: protobufBody, status: http.StatusUnsupportedMediaType},
+		{name: "unsupported content encoding", contentType: contentTypeProtobuf, contentEncoding: "br", body: protobufBody, status: http.StatusBadRequest},
+		{name: "malformed body", contentType: contentTypeJSON, body: []byte("{"), status: http.StatusBadRequest},
+		{name: "method not allowed", method: http.MethodGet, contentType: contentTypeProtobuf, status: http.StatusMethodNotAllowed},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mockotlp.NewMockPushService(t)
+			if tc.pushes > 0 {
+				svc.On("PushParsed", mock.Anything, mock.Anything).Return(nil, nil).Times(tc.pushes)
+			}
+			h := NewOTLPIngestHandler(svc, test.NewTestingLogger(t), false)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, httpPath, bytes.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			if tc.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tc.contentEncoding)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+			if tc.status == http.StatusOK {
+				require.Equal(t, tc.contentType, rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestHTTPExportRejectedProfiles(t *testing.T) {
+	t.Run("partial success", func(t *testing.T) {
+		svc := mockotlp.NewMockPushService(t)
+		svc.On("PushParsed", mock.Anything, mock.Anything).Return(nil, nil).Once()
+		h := NewOTLPIngestHandler(svc, test.NewTestingLogger(t), false)
+
+		body, err := proto.Marshal(newTestExportRequest(false, true))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, httpPath, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentTypeProtobuf)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp v1experimental2.ExportProfilesServiceResponse
+		require.NoError(t, proto.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.PartialSuccess)
+		require.Equal(t, int64(1), resp.PartialSuccess.RejectedProfiles)
+		require.NotEmpty(t, resp.PartialSuccess.ErrorMessage)
+	})
+
+	t.Run("all rejected", func(t *testing.T) {
+		svc := mockotlp.NewMockPushService(t)
+		h := NewOTLPIngestHandler(svc, test.NewTestingLogger(t), false)
+
+		body, err := protojson.Marshal(newTestExportRequest(true, true))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, httpPath, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentTypeJSON)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		require.Equal(t, contentTypeJSON, rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Body.String(), "failed to convert otel profile")
+	})
+}
+
+func TestConvertHexIDs(t *testing.T) {
+	body := []byte(`{
+  "resourceProfiles": [{"scopeProfiles": [{"profiles": [{"profileId": "0102030405060708090a0b0c0d0e0f10", "period": "10"}]}]}],
+  "dictionary": {
+    "link_table": [{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7ec3c1b174"}],
+    "stringTable": [""]
+  }
+}`)
+	converted, err := convertHexIDs(body)
+	require.NoError(t, err)
+
+	var req v1experimental2.ExportProfilesServiceRequest
+	require.NoError(t, protojson.Unmarshal(converted, &req))
+	p := req.ResourceProfiles[0].ScopeProfiles[0].Profiles[0]
+	require.Equal(t, "0102030405060708090a0b0c0d0e0f10", hex.EncodeToString(p.ProfileId))
+	require.Equal(t, int64(10), p.Period)
+	link := req.Dictionary.LinkTable[0]
+	require.Equal(t, "5b8efff798038103d269b633813fc60c", hex.EncodeToString(link.TraceId))
+	require.Equal(t, "eee19b7ec3c1b174", hex.EncodeToString(link.SpanId))
+}
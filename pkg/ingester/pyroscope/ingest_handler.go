@@ -23,7 +23,10 @@ import (
 
 	"github.com/grafana/pyroscope/api/model/labelset"
 	"github.com/grafana/pyroscope/pkg/og/agent/types"
+	"github.com/grafana/pyroscope/pkg/og/convert/chrome"
+	"github.com/grafana/pyroscope/pkg/og/convert/firefox"
 	"github.com/grafana/pyroscope/pkg/og/convert/jfr"
+	"github.com/grafana/pyroscope/pkg/og/convert/perf"
 	"github.com/grafana/pyroscope/pkg/og/convert/pprof"
 	"github.com/grafana/pyroscope/pkg/og/convert/profile"
 	"github.com/grafana/pyroscope/pkg/og/ingestion"
@@ -197,6 +200,24 @@ func readInputRawDataFromRequest(ctx context.Context, r *http.Request, input *in
 			RawData: b,
 		}
 
+	case format == "perf_script":
+		input.Format = ingestion.FormatPerfScript
+		input.Profile = &perf.RawProfile{
+			RawData: b,
+		}
+
+	case format == "cpuprofile":
+		input.Format = ingestion.FormatCPUProfile
+		input.Profile = &chrome.RawProfile{
+			RawData: b,
+		}
+
+	case format == "firefox":
+		input.Format = ingestion.FormatFirefox
+		input.Profile = &firefox.RawProfile{
+			RawData: b,
+		}
+
 	case strings.Contains(contentType, "multipart/form-data"):
 		input.Profile = &pprof.RawProfile{
 			FormDataContentType: contentType,
@@ -163,6 +163,79 @@ func TestCorruptedJFR422(t *testing.T) {
 	require.Equal(t, 422, res.Code)
 }
 
+func TestIngestPerfScript(t *testing.T) {
+	l := log.NewSyncLogger(log.NewLogfmtLogger(os.Stderr))
+	script := "perf 617960/617961 [004] 116825.359144:         16   cycles: \n" +
+		"        ffffffffb460009b entry_SYSCALL_64_after_hwframe+0x63 (/lib/modules/5.19.0/build/vmlinux)\n" +
+		"                  27ae79 main+0x6a9 (/usr/bin/perf)\n" +
+		"\n" +
+		"perf 617960/617961 [004] 116825.359150:     250000 cpu-clock: \n" +
+		"                  31ecd0 run_builtin+0x70 (/usr/bin/perf)\n" +
+		"                  27ae79 main+0x6a9 (/usr/bin/perf)\n" +
+		"\n"
+
+	svc := &MockPushService{Keep: true, T: t}
+	h := NewPyroscopeIngestHandler(svc, l)
+
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/ingest?name=host{env=test}&format=perf_script", bytes.NewReader([]byte(script)))
+	h.ServeHTTP(res, req)
+	require.Equal(t, 200, res.Code)
+	require.Len(t, svc.reqPprof, 1)
+
+	ls := phlaremodel.Labels(svc.reqPprof[0].Labels)
+	assert.Equal(t, "perf", ls.Get(labels.MetricName))
+	assert.Equal(t, "host", ls.Get(phlaremodel.LabelNameServiceName))
+	assert.Equal(t, "test", ls.Get("env"))
+
+	p := svc.reqPprof[0].Profile
+	require.Len(t, p.SampleType, 2)
+	assert.Equal(t, []string{"main;entry_SYSCALL_64_after_hwframe 16"}, bench.StackCollapseProto(p, 0, 1.0))
+	assert.Equal(t, []string{"main;run_builtin 250000"}, bench.StackCollapseProto(p, 1, 1.0))
+}
+
+func TestIngestChromeAndFirefoxProfiles(t *testing.T) {
+	l := log.NewSyncLogger(log.NewLogfmtLogger(os.Stderr))
+	for _, tc := range []struct {
+		format   string
+		fixture  string
+		expected []string
+	}{
+		{
+			format:   "cpuprofile",
+			fixture:  "../../og/convert/chrome/testdata/profile.cpuprofile",
+			expected: []string{"(garbage collector) 1", "main;(anonymous) 1", "main;foo 2"},
+		},
+		{
+			format:   "firefox",
+			fixture:  "../../og/convert/firefox/testdata/profile.json",
+			expected: []string{"XRE_main;bar 1", "XRE_main;foo 2", "worker_loop 3"},
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			data, err := os.ReadFile(tc.fixture)
+			require.NoError(t, err)
+
+			svc := &MockPushService{Keep: true, T: t}
+			h := NewPyroscopeIngestHandler(svc, l)
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/ingest?name=app{env=test}&format="+tc.format, bytes.NewReader(data))
+			h.ServeHTTP(res, req)
+			require.Equal(t, 200, res.Code)
+			require.Len(t, svc.reqPprof, 1)
+
+			ls := phlaremodel.Labels(svc.reqPprof[0].Labels)
+			assert.Equal(t, "process_cpu", ls.Get(labels.MetricName))
+			assert.Equal(t, "app", ls.Get(phlaremodel.LabelNameServiceName))
+
+			stacks := bench.StackCollapseProto(svc.reqPprof[0].Profile, 0, 1.0)
+			sort.Strings(stacks)
+			assert.Equal(t, tc.expected, stacks)
+		})
+	}
+}
+
 func createJFRRequestBody(t *testing.T, jfr, labels []byte) ([]byte, string) {
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
@@ -68,6 +68,18 @@ func (i *Ingester) MergeSpanProfile(ctx context.Context, stream *connect.BidiStr
 	})
 }
 
+func (i *Ingester) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return forInstanceStream(ctx, i, stream, func(instance *instance) error {
+		return instance.MergeProfilesTopFunctions(ctx, stream)
+	})
+}
+
+func (i *Ingester) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	return forInstanceStream(ctx, i, stream, func(instance *instance) error {
+		return instance.SelectProfileTrees(ctx, stream)
+	})
+}
+
 func (i *Ingester) GetProfileStats(ctx context.Context, req *connect.Request[typesv1.GetProfileStatsRequest]) (*connect.Response[typesv1.GetProfileStatsResponse], error) {
 	return forInstanceUnary(ctx, i, func(instance *instance) (*connect.Response[typesv1.GetProfileStatsResponse], error) {
 		return instance.GetProfileStats(ctx, req)
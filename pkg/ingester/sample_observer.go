@@ -0,0 +1,89 @@
+package ingester
+
+import (
+	"slices"
+	"time"
+
+	"github.com/grafana/dskit/ring"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/distributor"
+	"github.com/grafana/pyroscope/pkg/experiment/metrics"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+// dbOptions returns the tenant database options. If the metrics exporter
+// is enabled, recording rules are evaluated when the tenant head is flushed.
+func (i *Ingester) dbOptions(tenantID string) []phlaredb.Option {
+	if !i.cfg.MetricsExporter.Enabled || i.ruler == nil || i.exporter == nil {
+		return nil
+	}
+	return []phlaredb.Option{
+		phlaredb.WithSampleObserver(func(meta *block.Meta) phlaredb.SampleObserver {
+			return &tenantSampleObserver{
+				tenant:   tenantID,
+				observer: i.newSampleObserver(meta),
+			}
+		}),
+	}
+}
+
+// sampleObserverResolution is the time resolution of the samples recorded
+// for a block: profiles are aggregated into windows of this size.
+const sampleObserverResolution = time.Minute
+
+// newSampleObserver creates an observer for the block flushed from the head.
+// Samples are recorded at the end of each window, but not after the block max
+// time. Every ingester exports its own series, distinguished by the
+// pyroscope_instance label, and only observes series it is the first replica
+// of: see observeLabels.
+func (i *Ingester) newSampleObserver(meta *block.Meta) *metrics.SampleObserver {
+	pyroscopeInstanceLabel := labels.Label{
+		Name:  "pyroscope_instance",
+		Value: i.lifecycler.ID,
+	}
+	return metrics.NewWindowedSampleObserver(int64(meta.MaxTime), sampleObserverResolution, i.exporter, i.ruler, pyroscopeInstanceLabel)
+}
+
+// observeLabels returns the labels the series profiles are ingested with.
+// Profiles are replicated across ingesters: in order to not record a sample
+// multiple times, only the first replica of the series observes it, and the
+// other replicas ingest the profiles with the __observe__="false" label.
+func (i *Ingester) observeLabels(tenantID string, lbls []*typesv1.LabelPair) []*typesv1.LabelPair {
+	if !i.cfg.MetricsExporter.Enabled || i.ingestersRing == nil {
+		return lbls
+	}
+	// The replication set must be identical to the one the distributor
+	// has chosen for the series.
+	const maxExpectedReplicationSet = 5
+	var descs [maxExpectedReplicationSet]ring.InstanceDesc
+	token := distributor.TokenFor(tenantID, phlaremodel.LabelPairsString(lbls))
+	subRing := i.ingestersRing.ShuffleShard(tenantID, i.limits.IngestionTenantShardSize(tenantID))
+	replicationSet, err := subRing.Get(token, ring.Write, descs[:0], nil, nil)
+	if err != nil || len(replicationSet.Instances) == 0 || replicationSet.Instances[0].Id == i.lifecycler.ID {
+		return lbls
+	}
+	return append(slices.Clip(lbls), &typesv1.LabelPair{Name: phlaremodel.LabelNameObserve, Value: "false"})
+}
+
+type tenantSampleObserver struct {
+	tenant   string
+	observer *metrics.SampleObserver
+}
+
+func (o *tenantSampleObserver) ObserveProfile(
+	symbols symdb.SymbolsReader,
+	fp model.Fingerprint,
+	lbls phlaremodel.Labels,
+	row schemav1.ProfileRow,
+) {
+	o.observer.ObserveProfile(o.tenant, symbols, fp, lbls, row)
+}
+
+func (o *tenantSampleObserver) Close() { o.observer.Close() }
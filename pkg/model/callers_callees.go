@@ -0,0 +1,51 @@
+package model
+
+// CallersCallees returns the callers and callees trees of the function
+// with the given name (butterfly view), merged across all the stacks.
+//
+// Both trees are rooted at the function. In the callers tree, children
+// of a node are its callers: the stacks are reversed, and the value of
+// a stack is the total of the function node. The callees tree includes
+// the sub-trees of the function nodes. In case of recursion, only the
+// outermost call of the function is taken into account, therefore the
+// totals of the trees are equal and do not exceed the total of the tree.
+func (t *Tree) CallersCallees(function string) (callers, callees *Tree) {
+	callers, callees = new(Tree), new(Tree)
+	type entry struct {
+		node  *node
+		depth int
+	}
+	nodes := make([]entry, 0, defaultDFSSize)
+	for _, n := range t.root {
+		nodes = append(nodes, entry{node: n})
+	}
+	// path holds the names of the nodes from the root
+	// to the current one; at is the depth of the
+	// outermost function node in the path, if any.
+	path := make([]string, 0, 64)
+	reversed := make([]string, 0, 64)
+	at := -1
+	var e entry
+	for len(nodes) > 0 {
+		e, nodes = nodes[len(nodes)-1], nodes[:len(nodes)-1]
+		if e.depth <= at {
+			at = -1
+		}
+		path = append(path[:e.depth], e.node.name)
+		if at < 0 && e.node.name == function {
+			at = e.depth
+			reversed = reversed[:0]
+			for i := len(path) - 1; i >= 0; i-- {
+				reversed = append(reversed, path[i])
+			}
+			callers.InsertStack(e.node.total, reversed...)
+		}
+		if at >= 0 && e.node.self > 0 {
+			callees.InsertStack(e.node.self, path[at:]...)
+		}
+		for _, c := range e.node.children {
+			nodes = append(nodes, entry{node: c, depth: e.depth + 1})
+		}
+	}
+	return callers, callees
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tree_CallersCallees(t *testing.T) {
+	tree := newTree([]stacktraces{
+		{locations: []string{"c", "f", "b", "a"}, value: 1},
+		{locations: []string{"f", "a"}, value: 2},
+		{locations: []string{"d", "f", "c", "f", "a"}, value: 4},
+		{locations: []string{"e", "b", "a"}, value: 8},
+	})
+
+	callers, callees := tree.CallersCallees("f")
+	assert.Equal(t, newTree([]stacktraces{
+		{locations: []string{"a", "b", "f"}, value: 1},
+		{locations: []string{"a", "f"}, value: 6},
+	}).String(), callers.String())
+	// The recursive call is included in the sub-tree of the outermost call.
+	assert.Equal(t, newTree([]stacktraces{
+		{locations: []string{"c", "f"}, value: 1},
+		{locations: []string{"f"}, value: 2},
+		{locations: []string{"d", "f", "c", "f"}, value: 4},
+	}).String(), callees.String())
+	assert.Equal(t, callers.Total(), callees.Total())
+
+	callers, callees = tree.CallersCallees("x")
+	assert.Equal(t, int64(0), callers.Total())
+	assert.Equal(t, int64(0), callees.Total())
+}
@@ -0,0 +1,201 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+)
+
+// MaxDiffBaselineWindows limits the number
+// of the time windows a diff baseline may span.
+const MaxDiffBaselineWindows = 31
+
+// DiffBaselineWindows returns the selections of the baseline time windows:
+// the first one is the left selection, every next one precedes the previous
+// one by the baseline interval.
+func DiffBaselineWindows(left *querierv1.SelectMergeStacktracesRequest, baseline *querierv1.DiffBaseline) ([]*querierv1.SelectMergeStacktracesRequest, error) {
+	if left == nil {
+		return nil, errors.New("left selection is required")
+	}
+	if baseline.Windows < 1 || baseline.Windows > MaxDiffBaselineWindows {
+		return nil, fmt.Errorf("baseline windows must be between 1 and %d", MaxDiffBaselineWindows)
+	}
+	if baseline.Interval < 0 {
+		return nil, errors.New("baseline interval must not be negative")
+	}
+	if baseline.Threshold < 0 || baseline.Threshold > 1 {
+		return nil, errors.New("baseline threshold must be between 0 and 1")
+	}
+	interval := baseline.Interval
+	if interval == 0 {
+		interval = left.End - left.Start
+	}
+	windows := make([]*querierv1.SelectMergeStacktracesRequest, baseline.Windows)
+	for i := range windows {
+		w := left.CloneVT()
+		w.Start -= int64(i) * interval
+		w.End -= int64(i) * interval
+		windows[i] = w
+	}
+	return windows, nil
+}
+
+// NewBaselineTree returns the average of the trees, each normalized
+// by its total: every non-empty tree contributes equally to the result,
+// regardless of its total. The total of the resulting tree is the average
+// total of the non-empty trees. Empty trees are not taken into account.
+func NewBaselineTree(trees ...*Tree) *Tree {
+	var sum, n int64
+	for _, t := range trees {
+		if v := t.Total(); v > 0 {
+			sum += v
+			n++
+		}
+	}
+	r := new(Tree)
+	if n == 0 {
+		return r
+	}
+	// The normalized self values are summed up per stack and rounded
+	// once: rounding the values of every tree would drop the small ones.
+	type stackValue struct {
+		stack []string
+		value float64
+	}
+	values := make(map[string]*stackValue)
+	avg := float64(sum) / float64(n)
+	for _, t := range trees {
+		v := t.Total()
+		if v <= 0 {
+			continue
+		}
+		f := avg / float64(v) / float64(n)
+		t.iterateSelf(func(self int64, stack []string) {
+			k := strings.Join(stack, "\x00")
+			sv, ok := values[k]
+			if !ok {
+				sv = &stackValue{stack: slices.Clone(stack)}
+				values[k] = sv
+			}
+			sv.value += float64(self) * f
+		})
+	}
+	for _, sv := range values {
+		r.InsertStack(int64(math.Round(sv.value)), sv.stack...)
+	}
+	return r
+}
+
+// iterateSelf calls cb for every node with a non-zero self value.
+// The stack includes the names of the node ancestors and the node,
+// starting from the root. The stack must not be retained.
+func (t *Tree) iterateSelf(cb func(self int64, stack []string)) {
+	type entry struct {
+		node  *node
+		depth int
+	}
+	nodes := make([]entry, 0, defaultDFSSize)
+	for _, n := range t.root {
+		nodes = append(nodes, entry{node: n})
+	}
+	path := make([]string, 0, 64)
+	var e entry
+	for len(nodes) > 0 {
+		e, nodes = nodes[len(nodes)-1], nodes[:len(nodes)-1]
+		path = append(path[:e.depth], e.node.name)
+		if e.node.self > 0 {
+			cb(e.node.self, path)
+		}
+		for _, c := range e.node.children {
+			nodes = append(nodes, entry{node: c, depth: e.depth + 1})
+		}
+	}
+}
+
+// NewDiffChanges returns the nodes whose share of the tree total changed
+// by more than the threshold, ordered by the absolute change of the share.
+// If limit is positive, at most limit nodes are returned.
+func NewDiffChanges(left, right *Tree, threshold float64, limit int) []*querierv1.DiffNodeChange {
+	if threshold <= 0 {
+		return nil
+	}
+	leftTotal, rightTotal := left.Total(), right.Total()
+	share := func(n *node, total int64) float64 {
+		if n == nil || total == 0 {
+			return 0
+		}
+		return float64(n.total) / float64(total)
+	}
+
+	type entry struct {
+		left, right *node
+		depth       int
+	}
+	nodes := make([]entry, 0, defaultDFSSize)
+	pushChildren := func(l, r []*node, depth int) {
+		// Children are ordered by name.
+		for len(l) > 0 || len(r) > 0 {
+			switch {
+			case len(r) == 0 || len(l) > 0 && l[0].name < r[0].name:
+				nodes = append(nodes, entry{left: l[0], depth: depth})
+				l = l[1:]
+			case len(l) == 0 || r[0].name < l[0].name:
+				nodes = append(nodes, entry{right: r[0], depth: depth})
+				r = r[1:]
+			default:
+				nodes = append(nodes, entry{left: l[0], right: r[0], depth: depth})
+				l, r = l[1:], r[1:]
+			}
+		}
+	}
+	childrenOf := func(n *node) []*node {
+		if n == nil {
+			return nil
+		}
+		return n.children
+	}
+
+	var changes []*querierv1.DiffNodeChange
+	path := make([]string, 0, 64)
+	pushChildren(left.root, right.root, 0)
+	var e entry
+	for len(nodes) > 0 {
+		e, nodes = nodes[len(nodes)-1], nodes[:len(nodes)-1]
+		n := e.left
+		if n == nil {
+			n = e.right
+		}
+		path = append(path[:e.depth], n.name)
+		l, r := share(e.left, leftTotal), share(e.right, rightTotal)
+		if math.Abs(r-l) > threshold {
+			changes = append(changes, &querierv1.DiffNodeChange{
+				Stack:      append([]string(nil), path...),
+				LeftShare:  l,
+				RightShare: r,
+			})
+		}
+		// The share of a descendant can't exceed the share
+		// of the node, and so the change of the share.
+		if max(l, r) > threshold {
+			pushChildren(childrenOf(e.left), childrenOf(e.right), e.depth+1)
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a := math.Abs(changes[i].RightShare - changes[i].LeftShare)
+		b := math.Abs(changes[j].RightShare - changes[j].LeftShare)
+		if a != b {
+			return a > b
+		}
+		return strings.Join(changes[i].Stack, ";") < strings.Join(changes[j].Stack, ";")
+	})
+	if limit > 0 && len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/pkg/testhelper"
+)
+
+func Test_DiffBaselineWindows(t *testing.T) {
+	left := &querierv1.SelectMergeStacktracesRequest{LabelSelector: "{}", Start: 100, End: 110}
+	windows, err := DiffBaselineWindows(left, &querierv1.DiffBaseline{Windows: 3, Interval: 30})
+	require.NoError(t, err)
+	testhelper.EqualProto(t, []*querierv1.SelectMergeStacktracesRequest{
+		{LabelSelector: "{}", Start: 100, End: 110},
+		{LabelSelector: "{}", Start: 70, End: 80},
+		{LabelSelector: "{}", Start: 40, End: 50},
+	}, windows)
+
+	// Adjacent windows.
+	windows, err = DiffBaselineWindows(left, &querierv1.DiffBaseline{Windows: 2})
+	require.NoError(t, err)
+	testhelper.EqualProto(t, []*querierv1.SelectMergeStacktracesRequest{
+		{LabelSelector: "{}", Start: 100, End: 110},
+		{LabelSelector: "{}", Start: 90, End: 100},
+	}, windows)
+
+	for _, b := range []*querierv1.DiffBaseline{
+		{Windows: 0},
+		{Windows: MaxDiffBaselineWindows + 1},
+		{Windows: 1, Interval: -1},
+		{Windows: 1, Threshold: 2},
+	} {
+		_, err = DiffBaselineWindows(left, b)
+		assert.Error(t, err, b.String())
+	}
+}
+
+func Test_NewBaselineTree(t *testing.T) {
+	a := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 25},
+		{locations: []string{"c", "a"}, value: 75},
+	})
+	b := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 225},
+		{locations: []string{"c", "a"}, value: 75},
+	})
+	// The windows contribute equally: the shares of both b and c
+	// are (0.25+0.75)/2 of the average total 200.
+	expected := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 100},
+		{locations: []string{"c", "a"}, value: 100},
+	})
+	assert.Equal(t, expected.String(), NewBaselineTree(a, b, new(Tree)).String())
+	assert.Equal(t, int64(0), NewBaselineTree(new(Tree)).Total())
+
+	// Small values are not lost to rounding: every window
+	// contributes 3/7 to b, which adds up to 3.
+	windows := make([]*Tree, 7)
+	for i := range windows {
+		windows[i] = newTree([]stacktraces{
+			{locations: []string{"b", "a"}, value: 3},
+			{locations: []string{"c", "a"}, value: 997},
+		})
+	}
+	assert.Equal(t, windows[0].String(), NewBaselineTree(windows...).String())
+}
+
+func Test_NewDiffChanges(t *testing.T) {
+	left := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 50},
+		{locations: []string{"c", "a"}, value: 40},
+		{locations: []string{"d"}, value: 10},
+	})
+	right := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 100},
+		{locations: []string{"c", "a"}, value: 40},
+		{locations: []string{"e"}, value: 60},
+	})
+
+	testhelper.EqualProto(t, []*querierv1.DiffNodeChange{
+		{Stack: []string{"e"}, LeftShare: 0, RightShare: 0.3},
+		{Stack: []string{"a"}, LeftShare: 0.9, RightShare: 0.7},
+		{Stack: []string{"a", "c"}, LeftShare: 0.4, RightShare: 0.2},
+	}, NewDiffChanges(left, right, 0.15, 0))
+
+	assert.Len(t, NewDiffChanges(left, right, 0.15, 1), 1)
+	assert.Empty(t, NewDiffChanges(left, right, 0, 0))
+}
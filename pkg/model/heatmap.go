@@ -0,0 +1,185 @@
+package model
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sync"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+// The value buckets are exponential and do not depend on the data,
+// which makes it possible to merge heatmaps built independently.
+const heatmapBucketsPerPowerOfTwo = 4
+
+// HeatmapValueBucket returns the index of the value bucket that
+// includes the value: (HeatmapBucketBound(i-1), HeatmapBucketBound(i)].
+func HeatmapValueBucket(v float64) int32 {
+	b := int32(math.Ceil(math.Log2(v) * heatmapBucketsPerPowerOfTwo))
+	// Log2 is not exact: make sure the value
+	// is greater than the bucket lower bound.
+	if v <= HeatmapBucketBound(b-1) {
+		b--
+	}
+	return b
+}
+
+// HeatmapBucketBound returns the upper bound of the value bucket.
+func HeatmapBucketBound(b int32) float64 {
+	return math.Exp2(float64(b) / heatmapBucketsPerPowerOfTwo)
+}
+
+type heatmapCellKey struct {
+	timestamp int64
+	bucket    int32
+}
+
+type HeatmapMerger struct {
+	mu       sync.Mutex
+	profiles int
+	cells    map[heatmapCellKey]*typesv1.HeatmapCell
+}
+
+// NewHeatmapMerger creates a new heatmap merger. Up to profilesPerCell
+// profiles with the highest values are retained in each cell.
+func NewHeatmapMerger(profilesPerCell int) *HeatmapMerger {
+	return &HeatmapMerger{
+		profiles: profilesPerCell,
+		cells:    make(map[heatmapCellKey]*typesv1.HeatmapCell),
+	}
+}
+
+func (m *HeatmapMerger) MergeHeatmap(cells []*typesv1.HeatmapCell) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range cells {
+		k := heatmapCellKey{timestamp: c.Timestamp, bucket: c.Bucket}
+		d, ok := m.cells[k]
+		if !ok {
+			m.cells[k] = c
+			c.Profiles = m.truncateProfiles(c.Profiles, 2*m.profiles)
+			continue
+		}
+		d.Count += c.Count
+		d.Profiles = m.truncateProfiles(append(d.Profiles, c.Profiles...), 2*m.profiles)
+	}
+}
+
+func (m *HeatmapMerger) IsEmpty() bool {
+	return len(m.cells) == 0
+}
+
+// Heatmap returns non-empty cells ordered by timestamp and value bucket.
+func (m *HeatmapMerger) Heatmap() []*typesv1.HeatmapCell {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.cells) == 0 {
+		return nil
+	}
+	cells := make([]*typesv1.HeatmapCell, 0, len(m.cells))
+	for _, c := range m.cells {
+		c.Profiles = m.truncateProfiles(c.Profiles, m.profiles)
+		cells = append(cells, c)
+	}
+	slices.SortFunc(cells, func(a, b *typesv1.HeatmapCell) int {
+		if c := cmp.Compare(a.Timestamp, b.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Bucket, b.Bucket)
+	})
+	return cells
+}
+
+// truncateProfiles retains n profiles with the highest values, if the
+// number of profiles exceeds the limit. The limit should be greater
+// than the number of profiles per cell to amortize the sorting.
+func (m *HeatmapMerger) truncateProfiles(p []*typesv1.ProfileReference, limit int) []*typesv1.ProfileReference {
+	if len(p) <= limit {
+		if limit == m.profiles {
+			slices.SortFunc(p, compareProfileReferences)
+		}
+		return p
+	}
+	slices.SortFunc(p, compareProfileReferences)
+	return p[:m.profiles]
+}
+
+func compareProfileReferences(a, b *typesv1.ProfileReference) int {
+	if c := cmp.Compare(b.Value, a.Value); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Timestamp, b.Timestamp); c != 0 {
+		return c
+	}
+	return CompareLabelPairs(a.Labels, b.Labels)
+}
+
+// HeatmapBuilder builds a heatmap of the profile total values. Time buckets
+// are aligned to multiples of the step, and a bucket includes profiles in the
+// (timestamp-step, timestamp] interval. Only buckets within the [start, end]
+// range are built: the caller is expected to provide the profiles starting
+// from start-step, as with time series.
+type HeatmapBuilder struct {
+	merger HeatmapMerger
+	first  int64
+	last   int64
+	step   int64
+}
+
+func NewHeatmapBuilder(start, end, step int64, profilesPerCell int) *HeatmapBuilder {
+	step = max(step, 1)
+	return &HeatmapBuilder{
+		merger: HeatmapMerger{
+			profiles: profilesPerCell,
+			cells:    make(map[heatmapCellKey]*typesv1.HeatmapCell),
+		},
+		first: alignHeatmapTimestamp(start, step),
+		last:  end - end%step,
+		step:  step,
+	}
+}
+
+func alignHeatmapTimestamp(ts, step int64) int64 {
+	if r := ts % step; r != 0 {
+		return ts - r + step
+	}
+	return ts
+}
+
+// Add adds the profile to the heatmap. Profiles with non-positive values
+// are ignored. The labels are only retained if profile references are
+// requested, and must not be modified afterwards.
+func (b *HeatmapBuilder) Add(lbs Labels, ts int64, value float64) {
+	if value <= 0 {
+		return
+	}
+	t := alignHeatmapTimestamp(ts, b.step)
+	if t < b.first || t > b.last {
+		return
+	}
+	k := heatmapCellKey{timestamp: t, bucket: HeatmapValueBucket(value)}
+	c, ok := b.merger.cells[k]
+	if !ok {
+		c = &typesv1.HeatmapCell{
+			Timestamp:  k.timestamp,
+			Bucket:     k.bucket,
+			LowerBound: HeatmapBucketBound(k.bucket - 1),
+			UpperBound: HeatmapBucketBound(k.bucket),
+		}
+		b.merger.cells[k] = c
+	}
+	c.Count++
+	if b.merger.profiles > 0 {
+		c.Profiles = append(c.Profiles, &typesv1.ProfileReference{
+			Labels:    lbs,
+			Timestamp: ts,
+			Value:     value,
+		})
+		c.Profiles = b.merger.truncateProfiles(c.Profiles, 2*b.merger.profiles)
+	}
+}
+
+func (b *HeatmapBuilder) Build() []*typesv1.HeatmapCell {
+	return b.merger.Heatmap()
+}
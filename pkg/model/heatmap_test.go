@@ -0,0 +1,118 @@
+package model
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+func Test_HeatmapValueBucket(t *testing.T) {
+	for _, v := range []float64{0.1, 1, 1.5, 2, math.Pow(2, 0.25), 3, 100, 1e9, 12345678} {
+		b := HeatmapValueBucket(v)
+		assert.Greater(t, v, HeatmapBucketBound(b-1), v)
+		assert.LessOrEqual(t, v, HeatmapBucketBound(b), v)
+	}
+	assert.Equal(t, int32(0), HeatmapValueBucket(1))
+	assert.Equal(t, int32(4), HeatmapValueBucket(2))
+	assert.Equal(t, int32(5), HeatmapValueBucket(2.1))
+	assert.Equal(t, 2.0, HeatmapBucketBound(4))
+}
+
+func Test_HeatmapBuilder(t *testing.T) {
+	a := LabelsFromStrings("service_name", "a")
+	b := LabelsFromStrings("service_name", "b")
+
+	builder := NewHeatmapBuilder(1000, 3500, 1000, 2)
+	builder.Add(a, 0, 2)    // Before the first bucket.
+	builder.Add(a, 1, 2)    // (0, 1000]
+	builder.Add(b, 1000, 2) // (0, 1000]
+	builder.Add(a, 1500, 2) // (1000, 2000]
+	builder.Add(b, 1600, 3) // (1000, 2000]
+	builder.Add(a, 1700, 0) // Ignored.
+	builder.Add(a, 2000, 8) // (1000, 2000]
+	builder.Add(a, 2100, 7) // (2000, 3000]
+	builder.Add(b, 2200, 8) // (2000, 3000]
+	builder.Add(a, 2300, 6) // (2000, 3000]
+	builder.Add(a, 3100, 1) // After the last bucket.
+
+	expected := []*typesv1.HeatmapCell{
+		{
+			Timestamp: 1000, Bucket: 4, LowerBound: HeatmapBucketBound(3), UpperBound: 2, Count: 2,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: a, Timestamp: 1, Value: 2},
+				{Labels: b, Timestamp: 1000, Value: 2},
+			},
+		},
+		{
+			Timestamp: 2000, Bucket: 4, LowerBound: HeatmapBucketBound(3), UpperBound: 2, Count: 1,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: a, Timestamp: 1500, Value: 2},
+			},
+		},
+		{
+			Timestamp: 2000, Bucket: 7, LowerBound: HeatmapBucketBound(6), UpperBound: HeatmapBucketBound(7), Count: 1,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: b, Timestamp: 1600, Value: 3},
+			},
+		},
+		{
+			Timestamp: 2000, Bucket: 12, LowerBound: HeatmapBucketBound(11), UpperBound: 8, Count: 1,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: a, Timestamp: 2000, Value: 8},
+			},
+		},
+		{
+			Timestamp: 3000, Bucket: 11, LowerBound: HeatmapBucketBound(10), UpperBound: HeatmapBucketBound(11), Count: 1,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: a, Timestamp: 2300, Value: 6},
+			},
+		},
+		{
+			Timestamp: 3000, Bucket: 12, LowerBound: HeatmapBucketBound(11), UpperBound: 8, Count: 2,
+			Profiles: []*typesv1.ProfileReference{
+				{Labels: b, Timestamp: 2200, Value: 8},
+				{Labels: a, Timestamp: 2100, Value: 7},
+			},
+		},
+	}
+	assert.Equal(t, expected, builder.Build())
+}
+
+func Test_HeatmapMerger(t *testing.T) {
+	build := func(start, end int64, values ...float64) []*typesv1.HeatmapCell {
+		b := NewHeatmapBuilder(start, end, 1000, 1)
+		for i, v := range values {
+			b.Add(LabelsFromStrings("i", string(rune('a'+i))), start-999+int64(i)*100, v)
+		}
+		return b.Build()
+	}
+
+	m := NewHeatmapMerger(1)
+	m.MergeHeatmap(build(1000, 1999, 3, 3, 5))
+	m.MergeHeatmap(build(2000, 2999, 3))
+	m.MergeHeatmap(build(1000, 1999, 3, 3, 3, 3))
+
+	cells := m.Heatmap()
+	require.Len(t, cells, 3)
+
+	assert.Equal(t, int64(1000), cells[0].Timestamp)
+	assert.Equal(t, HeatmapValueBucket(3), cells[0].Bucket)
+	assert.Equal(t, int64(6), cells[0].Count)
+	require.Len(t, cells[0].Profiles, 1)
+	assert.Equal(t, int64(1), cells[0].Profiles[0].Timestamp)
+
+	assert.Equal(t, int64(1000), cells[1].Timestamp)
+	assert.Equal(t, HeatmapValueBucket(5), cells[1].Bucket)
+	assert.Equal(t, int64(1), cells[1].Count)
+	require.Len(t, cells[1].Profiles, 1)
+	assert.Equal(t, 5.0, cells[1].Profiles[0].Value)
+
+	assert.Equal(t, int64(2000), cells[2].Timestamp)
+	assert.Equal(t, int64(1), cells[2].Count)
+
+	assert.Nil(t, NewHeatmapMerger(0).Heatmap())
+}
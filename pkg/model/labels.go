@@ -26,6 +26,7 @@ const (
 	LabelNameServiceNamePrivate = "__service_name__"
 	LabelNameDelta              = "__delta__"
 	LabelNameOTEL               = "__otel__"
+	LabelNameObserve            = "__observe__"
 	LabelNameProfileName        = pmodel.MetricNameLabel
 	LabelNamePeriodType         = "__period_type__"
 	LabelNamePeriodUnit         = "__period_unit__"
@@ -8,12 +8,20 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 
 	settingsv1 "github.com/grafana/pyroscope/api/gen/proto/go/settings/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 )
 
 type RecordingRule struct {
 	Matchers       []*labels.Matcher
 	GroupBy        []string
 	ExternalLabels labels.Labels
+
+	// StackTraceSelector, if not nil, limits the recorded value
+	// to the samples of the stack traces with the call site prefix.
+	StackTraceSelector *typesv1.StackTraceSelector
+	// FunctionName, if not empty, limits the recorded value to the
+	// samples of the stack traces that include the function.
+	FunctionName string
 }
 
 func NewRecordingRule(rule *settingsv1.RecordingRule) (*RecordingRule, error) {
@@ -38,10 +46,18 @@ func NewRecordingRule(rule *settingsv1.RecordingRule) (*RecordingRule, error) {
 		return nil, fmt.Errorf("no __profile_type__ matcher present")
 	}
 
+	if err = ValidateRecordingRuleStackTraceFilter(rule.StackTraceSelector, rule.FunctionName); err != nil {
+		return nil, err
+	}
+
 	r := &RecordingRule{
 		Matchers:       matchers,
 		GroupBy:        rule.GroupBy,
 		ExternalLabels: make(labels.Labels, 0, len(rule.ExternalLabels)+1),
+		FunctionName:   rule.FunctionName,
+	}
+	if len(rule.StackTraceSelector.GetCallSite()) > 0 {
+		r.StackTraceSelector = rule.StackTraceSelector
 	}
 
 	// ensure __name__ is unique
@@ -63,6 +79,24 @@ func NewRecordingRule(rule *settingsv1.RecordingRule) (*RecordingRule, error) {
 	return r, nil
 }
 
+// ValidateRecordingRuleStackTraceFilter checks that at most one stack trace
+// filter is specified, and that the call site locations are not empty.
+func ValidateRecordingRuleStackTraceFilter(selector *typesv1.StackTraceSelector, functionName string) error {
+	if selector.GetGoPgo() != nil {
+		return fmt.Errorf("go_pgo stack trace selector is not supported")
+	}
+	callSite := selector.GetCallSite()
+	if len(callSite) > 0 && functionName != "" {
+		return fmt.Errorf("stack_trace_selector and function_name are mutually exclusive")
+	}
+	for i, loc := range callSite {
+		if loc.GetName() == "" {
+			return fmt.Errorf("stack_trace_selector call site location %d has no name", i)
+		}
+	}
+	return nil
+}
+
 func parseMatchers(matchers []string) ([]*labels.Matcher, error) {
 	parsed := make([]*labels.Matcher, 0, len(matchers))
 	for _, m := range matchers {
@@ -17,6 +17,7 @@ type TimeSeriesValue struct {
 	LabelsHash  uint64
 	Value       float64
 	Annotations []*typesv1.ProfileAnnotation
+	Exemplars   []*typesv1.Exemplar
 }
 
 func (p TimeSeriesValue) Labels() Labels        { return p.Lbs }
@@ -47,6 +48,7 @@ func (s *TimeSeriesIterator) Next() bool {
 	s.curr.Ts = p.Timestamp
 	s.curr.Value = p.Value
 	s.curr.Annotations = p.Annotations
+	s.curr.Exemplars = p.Exemplars
 	return true
 }
 
@@ -69,16 +71,100 @@ type TimeSeriesAggregator interface {
 	GetTimestamp() int64
 }
 
-func NewTimeSeriesAggregator(aggregation *typesv1.TimeSeriesAggregationType) TimeSeriesAggregator {
+// NewTimeSeriesAggregator creates an aggregator of the values within
+// a step. The step duration (in milliseconds) is only used by the rate
+// aggregation. Regardless of the aggregation, the exemplar with the
+// highest value is retained.
+func NewTimeSeriesAggregator(aggregation *typesv1.TimeSeriesAggregationType, step int64) TimeSeriesAggregator {
+	return &exemplarTimeSeriesAggregator{TimeSeriesAggregator: newTimeSeriesAggregator(aggregation, step)}
+}
+
+func newTimeSeriesAggregator(aggregation *typesv1.TimeSeriesAggregationType, step int64) TimeSeriesAggregator {
 	if aggregation == nil {
 		return &sumTimeSeriesAggregator{ts: -1}
 	}
-	if *aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE {
+	switch *aggregation {
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE:
 		return &avgTimeSeriesAggregator{ts: -1}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN:
+		return &minMaxTimeSeriesAggregator{ts: -1}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX:
+		return &minMaxTimeSeriesAggregator{ts: -1, max: true}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT:
+		return &countTimeSeriesAggregator{ts: -1}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE:
+		return &rateTimeSeriesAggregator{sumTimeSeriesAggregator: sumTimeSeriesAggregator{ts: -1}, step: step}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50:
+		return &quantileTimeSeriesAggregator{ts: -1, q: 0.5}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90:
+		return &quantileTimeSeriesAggregator{ts: -1, q: 0.9}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99:
+		return &quantileTimeSeriesAggregator{ts: -1, q: 0.99}
 	}
 	return &sumTimeSeriesAggregator{ts: -1}
 }
 
+// IsSummableAggregation reports whether the points with matching
+// timestamps can be summed before the aggregation is applied. Otherwise,
+// all the points must be retained until the series are aggregated.
+func IsSummableAggregation(aggregation *typesv1.TimeSeriesAggregationType) bool {
+	if aggregation == nil {
+		return true
+	}
+	switch *aggregation {
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+		typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE:
+		return true
+	}
+	return false
+}
+
+type exemplarTimeSeriesAggregator struct {
+	TimeSeriesAggregator
+	exemplar *typesv1.Exemplar
+}
+
+func (a *exemplarTimeSeriesAggregator) Add(ts int64, point *TimeSeriesValue) {
+	a.TimeSeriesAggregator.Add(ts, point)
+	for _, e := range point.Exemplars {
+		a.exemplar = maxExemplar(a.exemplar, e)
+	}
+}
+
+func (a *exemplarTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	p := a.TimeSeriesAggregator.GetAndReset()
+	if a.exemplar != nil {
+		p.Exemplars = []*typesv1.Exemplar{a.exemplar}
+		a.exemplar = nil
+	}
+	return p
+}
+
+// maxExemplar returns the exemplar with the highest value. Ties are
+// broken by the timestamp and the profile ID, so that the choice does
+// not depend on the order the exemplars are observed in.
+func maxExemplar(a, b *typesv1.Exemplar) *typesv1.Exemplar {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.Value != b.Value:
+		if a.Value > b.Value {
+			return a
+		}
+		return b
+	case a.Timestamp != b.Timestamp:
+		if a.Timestamp < b.Timestamp {
+			return a
+		}
+		return b
+	case a.ProfileId <= b.ProfileId:
+		return a
+	}
+	return b
+}
+
 type sumTimeSeriesAggregator struct {
 	ts          int64
 	sum         float64
@@ -142,6 +228,128 @@ func (a *avgTimeSeriesAggregator) GetAndReset() *typesv1.Point {
 func (a *avgTimeSeriesAggregator) IsEmpty() bool       { return a.ts == -1 }
 func (a *avgTimeSeriesAggregator) GetTimestamp() int64 { return a.ts }
 
+type minMaxTimeSeriesAggregator struct {
+	ts          int64
+	value       float64
+	max         bool
+	annotations []*typesv1.ProfileAnnotation
+}
+
+func (a *minMaxTimeSeriesAggregator) Add(ts int64, point *TimeSeriesValue) {
+	if a.ts == -1 || (a.max && point.Value > a.value) || (!a.max && point.Value < a.value) {
+		a.value = point.Value
+	}
+	a.ts = ts
+	a.annotations = append(a.annotations, point.Annotations...)
+}
+
+func (a *minMaxTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	annotationsCopy := make([]*typesv1.ProfileAnnotation, len(a.annotations))
+	copy(annotationsCopy, a.annotations)
+	a.ts = -1
+	a.annotations = a.annotations[:0]
+	return &typesv1.Point{
+		Timestamp:   tsCopy,
+		Value:       a.value,
+		Annotations: annotationsCopy,
+	}
+}
+
+func (a *minMaxTimeSeriesAggregator) IsEmpty() bool       { return a.ts == -1 }
+func (a *minMaxTimeSeriesAggregator) GetTimestamp() int64 { return a.ts }
+
+type countTimeSeriesAggregator struct {
+	ts          int64
+	count       int64
+	annotations []*typesv1.ProfileAnnotation
+}
+
+func (a *countTimeSeriesAggregator) Add(ts int64, point *TimeSeriesValue) {
+	a.ts = ts
+	a.count++
+	a.annotations = append(a.annotations, point.Annotations...)
+}
+
+func (a *countTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	countCopy := a.count
+	annotationsCopy := make([]*typesv1.ProfileAnnotation, len(a.annotations))
+	copy(annotationsCopy, a.annotations)
+	a.ts = -1
+	a.count = 0
+	a.annotations = a.annotations[:0]
+	return &typesv1.Point{
+		Timestamp:   tsCopy,
+		Value:       float64(countCopy),
+		Annotations: annotationsCopy,
+	}
+}
+
+func (a *countTimeSeriesAggregator) IsEmpty() bool       { return a.ts == -1 }
+func (a *countTimeSeriesAggregator) GetTimestamp() int64 { return a.ts }
+
+// rateTimeSeriesAggregator divides the sum of the values
+// by the step duration in seconds.
+type rateTimeSeriesAggregator struct {
+	sumTimeSeriesAggregator
+	step int64
+}
+
+func (a *rateTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	p := a.sumTimeSeriesAggregator.GetAndReset()
+	if a.step > 0 {
+		p.Value /= float64(a.step) / 1e3
+	}
+	return p
+}
+
+type quantileTimeSeriesAggregator struct {
+	ts          int64
+	q           float64
+	values      []float64
+	annotations []*typesv1.ProfileAnnotation
+}
+
+func (a *quantileTimeSeriesAggregator) Add(ts int64, point *TimeSeriesValue) {
+	a.ts = ts
+	a.values = append(a.values, point.Value)
+	a.annotations = append(a.annotations, point.Annotations...)
+}
+
+func (a *quantileTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	annotationsCopy := make([]*typesv1.ProfileAnnotation, len(a.annotations))
+	copy(annotationsCopy, a.annotations)
+	value := quantile(a.q, a.values)
+	a.ts = -1
+	a.values = a.values[:0]
+	a.annotations = a.annotations[:0]
+	return &typesv1.Point{
+		Timestamp:   tsCopy,
+		Value:       value,
+		Annotations: annotationsCopy,
+	}
+}
+
+func (a *quantileTimeSeriesAggregator) IsEmpty() bool       { return a.ts == -1 }
+func (a *quantileTimeSeriesAggregator) GetTimestamp() int64 { return a.ts }
+
+// quantile calculates the q-quantile of the values using linear
+// interpolation between the closest ranks, the same way as Prometheus
+// quantile_over_time does. The values are sorted in place.
+func quantile(q float64, values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sort.Float64s(values)
+	rank := q * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return values[lower]*(1-weight) + values[upper]*weight
+}
+
 // RangeSeries aggregates profiles into series.
 // Series contains points spaced by step from start to end.
 // Profiles from the same step are aggregated into one point.
@@ -161,7 +369,7 @@ Outer:
 			point := it.At()
 			aggregator, ok := aggregators[point.LabelsHash]
 			if !ok {
-				aggregator = NewTimeSeriesAggregator(aggregation)
+				aggregator = NewTimeSeriesAggregator(aggregation, step)
 				aggregators[point.LabelsHash] = aggregator
 			}
 			if point.Ts > currentStep {
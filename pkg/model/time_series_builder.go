@@ -31,7 +31,10 @@ func (s *TimeSeriesBuilder) Init(by ...string) {
 	s.by = by
 }
 
-func (s *TimeSeriesBuilder) Add(fp model.Fingerprint, lbs Labels, ts int64, value float64, annotations schemav1.Annotations) {
+// Add adds the profile value to the series. If the profile ID is not
+// empty, the point references the profile as an exemplar; the fingerprint
+// must identify the profile series then, and not the group.
+func (s *TimeSeriesBuilder) Add(fp model.Fingerprint, lbs Labels, ts int64, value float64, annotations schemav1.Annotations, profileID string) {
 	labelsByString, ok := s.labelsByFingerprint[fp]
 	pAnnotations := make([]*typesv1.ProfileAnnotation, 0, len(annotations.Keys))
 	for i := range len(annotations.Keys) {
@@ -40,6 +43,15 @@ func (s *TimeSeriesBuilder) Add(fp model.Fingerprint, lbs Labels, ts int64, valu
 			Value: annotations.Values[i],
 		})
 	}
+	var exemplars []*typesv1.Exemplar
+	if profileID != "" {
+		exemplars = []*typesv1.Exemplar{{
+			Timestamp:         ts,
+			ProfileId:         profileID,
+			SeriesFingerprint: uint64(fp),
+			Value:             value,
+		}}
+	}
 	if !ok {
 		s.labelBuf = lbs.BytesWithLabels(s.labelBuf, s.by...)
 		labelsByString = string(s.labelBuf)
@@ -52,6 +64,7 @@ func (s *TimeSeriesBuilder) Add(fp model.Fingerprint, lbs Labels, ts int64, valu
 						Timestamp:   ts,
 						Value:       value,
 						Annotations: pAnnotations,
+						Exemplars:   exemplars,
 					},
 				},
 			}
@@ -63,6 +76,7 @@ func (s *TimeSeriesBuilder) Add(fp model.Fingerprint, lbs Labels, ts int64, valu
 		Timestamp:   ts,
 		Value:       value,
 		Annotations: pAnnotations,
+		Exemplars:   exemplars,
 	})
 }
 
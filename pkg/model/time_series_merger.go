@@ -10,12 +10,7 @@ import (
 )
 
 func MergeSeries(aggregation *typesv1.TimeSeriesAggregationType, series ...[]*typesv1.Series) []*typesv1.Series {
-	var m *TimeSeriesMerger
-	if aggregation == nil || *aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM {
-		m = NewTimeSeriesMerger(true)
-	} else {
-		m = NewTimeSeriesMerger(false)
-	}
+	m := NewTimeSeriesMerger(IsSummableAggregation(aggregation))
 	for _, s := range series {
 		m.MergeTimeSeries(s)
 	}
@@ -135,7 +130,24 @@ func (m *TimeSeriesMerger) mergePoints(points []*typesv1.Point) int {
 			// Duplicate annotations are semantically correct and provide useful information.
 			// Users of the data can decide whether to discard or make use of duplicates.
 			points[j].Annotations = append(points[j].Annotations, points[i].Annotations...)
+			points[j].Exemplars = mergeExemplars(points[j].Exemplars, points[i].Exemplars)
 		}
 	}
 	return j + 1
 }
+
+// mergeExemplars retains the exemplar with the highest value
+// of the summed points.
+func mergeExemplars(a, b []*typesv1.Exemplar) []*typesv1.Exemplar {
+	if len(b) == 0 {
+		return a
+	}
+	var m *typesv1.Exemplar
+	for _, e := range a {
+		m = maxExemplar(m, e)
+	}
+	for _, e := range b {
+		m = maxExemplar(m, e)
+	}
+	return []*typesv1.Exemplar{m}
+}
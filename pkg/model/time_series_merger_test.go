@@ -193,3 +193,23 @@ func Test_SeriesMerger_Top(t *testing.T) {
 		})
 	}
 }
+
+func Test_SeriesMerger_Exemplars(t *testing.T) {
+	in := [][]*typesv1.Series{
+		{{Labels: LabelsFromStrings("foo", "bar"), Points: []*typesv1.Point{
+			{Timestamp: 1, Value: 1, Exemplars: []*typesv1.Exemplar{{Timestamp: 1, ProfileId: "a", Value: 1}}},
+			{Timestamp: 2, Value: 3, Exemplars: []*typesv1.Exemplar{{Timestamp: 2, ProfileId: "b", Value: 3}}},
+		}}},
+		{{Labels: LabelsFromStrings("foo", "bar"), Points: []*typesv1.Point{
+			{Timestamp: 1, Value: 2, Exemplars: []*typesv1.Exemplar{{Timestamp: 1, ProfileId: "c", Value: 2}}},
+			{Timestamp: 2, Value: 1},
+		}}},
+	}
+	out := []*typesv1.Series{
+		{Labels: LabelsFromStrings("foo", "bar"), Points: []*typesv1.Point{
+			{Timestamp: 1, Value: 3, Exemplars: []*typesv1.Exemplar{{Timestamp: 1, ProfileId: "c", Value: 2}}},
+			{Timestamp: 2, Value: 4, Exemplars: []*typesv1.Exemplar{{Timestamp: 2, ProfileId: "b", Value: 3}}},
+		}},
+	}
+	testhelper.EqualProto(t, out, MergeSeries(nil, in...))
+}
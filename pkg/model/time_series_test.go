@@ -3,6 +3,9 @@ package model
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/iter"
 	"github.com/grafana/pyroscope/pkg/testhelper"
@@ -154,3 +157,62 @@ func Test_RangeSeriesAvg(t *testing.T) {
 		})
 	}
 }
+
+func Test_RangeSeriesAggregations(t *testing.T) {
+	in := []TimeSeriesValue{
+		{Ts: 1000, Value: 1},
+		{Ts: 1000, Value: 4},
+		{Ts: 1000, Value: 2},
+		{Ts: 1000, Value: 3},
+		{Ts: 3000, Value: 10},
+	}
+	for _, tc := range []struct {
+		aggregation typesv1.TimeSeriesAggregationType
+		values      []float64
+	}{
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN, values: []float64{1, 10}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX, values: []float64{4, 10}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT, values: []float64{4, 1}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE, values: []float64{5, 5}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50, values: []float64{2.5, 10}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90, values: []float64{3.7, 10}},
+		{aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99, values: []float64{3.97, 10}},
+	} {
+		t.Run(tc.aggregation.String(), func(t *testing.T) {
+			out := RangeSeries(iter.NewSliceIterator(in), 1000, 3000, 2000, &tc.aggregation)
+			require.Len(t, out, 1)
+			require.Len(t, out[0].Points, len(tc.values))
+			for i, p := range out[0].Points {
+				assert.InDelta(t, tc.values[i], p.Value, 1e-9)
+			}
+		})
+	}
+}
+
+func Test_IsSummableAggregation(t *testing.T) {
+	assert.True(t, IsSummableAggregation(nil))
+	for a := range typesv1.TimeSeriesAggregationType_name {
+		aggregation := typesv1.TimeSeriesAggregationType(a)
+		expected := aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM ||
+			aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE
+		assert.Equal(t, expected, IsSummableAggregation(&aggregation), aggregation.String())
+	}
+}
+
+func Test_RangeSeriesExemplars(t *testing.T) {
+	in := []TimeSeriesValue{
+		{Ts: 1000, Value: 1, Exemplars: []*typesv1.Exemplar{{Timestamp: 1000, ProfileId: "a", Value: 1}}},
+		{Ts: 1000, Value: 4, Exemplars: []*typesv1.Exemplar{{Timestamp: 1000, ProfileId: "b", Value: 4}}},
+		{Ts: 1000, Value: 2, Exemplars: []*typesv1.Exemplar{{Timestamp: 1000, ProfileId: "c", Value: 2}}},
+		{Ts: 3000, Value: 5, Exemplars: []*typesv1.Exemplar{{Timestamp: 3000, ProfileId: "e", Value: 5}}},
+		{Ts: 3000, Value: 5, Exemplars: []*typesv1.Exemplar{{Timestamp: 3000, ProfileId: "d", Value: 5}}},
+		{Ts: 5000, Value: 1},
+	}
+	out := RangeSeries(iter.NewSliceIterator(in), 1000, 5000, 2000, nil)
+	require.Len(t, out, 1)
+	testhelper.EqualProto(t, []*typesv1.Point{
+		{Timestamp: 1000, Value: 7, Annotations: []*typesv1.ProfileAnnotation{}, Exemplars: []*typesv1.Exemplar{{Timestamp: 1000, ProfileId: "b", Value: 4}}},
+		{Timestamp: 3000, Value: 10, Annotations: []*typesv1.ProfileAnnotation{}, Exemplars: []*typesv1.Exemplar{{Timestamp: 3000, ProfileId: "d", Value: 5}}},
+		{Timestamp: 5000, Value: 1, Annotations: []*typesv1.ProfileAnnotation{}},
+	}, out[0].Points)
+}
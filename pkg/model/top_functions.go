@@ -0,0 +1,224 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+// FunctionValues accumulates self and total values of the functions
+// of stack traces. The key identifies a function (or a source line)
+// in the symbol table the stack traces refer to.
+type FunctionValues[K comparable] struct {
+	index  map[K]int
+	keys   []K
+	values []functionValue
+	stack  int
+}
+
+type functionValue struct {
+	self  int64
+	total int64
+	// The last stack trace the value is accounted in:
+	// recursive calls must not inflate the total.
+	stack int
+}
+
+func NewFunctionValues[K comparable]() *FunctionValues[K] {
+	return &FunctionValues[K]{index: make(map[K]int)}
+}
+
+// Add adds the stack trace value to the functions of the stack trace.
+// The first element of the stack trace is the leaf frame.
+func (f *FunctionValues[K]) Add(stack []K, value int64) {
+	if len(stack) == 0 || value == 0 {
+		return
+	}
+	f.stack++
+	for i, k := range stack {
+		j, ok := f.index[k]
+		if !ok {
+			j = len(f.keys)
+			f.index[k] = j
+			f.keys = append(f.keys, k)
+			f.values = append(f.values, functionValue{})
+		}
+		v := &f.values[j]
+		if i == 0 {
+			v.self += value
+		}
+		if v.stack != f.stack {
+			v.stack = f.stack
+			v.total += value
+		}
+	}
+}
+
+func (f *FunctionValues[K]) Len() int { return len(f.keys) }
+
+// Range calls fn for each of the functions, in the order
+// the functions have been observed.
+func (f *FunctionValues[K]) Range(fn func(k K, self, total int64)) {
+	for i, k := range f.keys {
+		fn(k, f.values[i].self, f.values[i].total)
+	}
+}
+
+type pprofFunctionKey struct {
+	name     int64
+	filename int64
+	line     int64
+}
+
+// NewTopFunctionsFromProfile computes values of the profile functions
+// at the given granularity. Only the first sample type is considered.
+func NewTopFunctionsFromProfile(p *profilev1.Profile, granularity typesv1.FunctionGranularity) []*typesv1.TopFunction {
+	functions := make(map[uint64]*profilev1.Function, len(p.Function))
+	for _, f := range p.Function {
+		functions[f.Id] = f
+	}
+	locations := make(map[uint64]*profilev1.Location, len(p.Location))
+	for _, l := range p.Location {
+		locations[l.Id] = l
+	}
+	values := NewFunctionValues[pprofFunctionKey]()
+	var stack []pprofFunctionKey
+	for _, s := range p.Sample {
+		if len(s.Value) == 0 {
+			continue
+		}
+		stack = stack[:0]
+		for _, id := range s.LocationId {
+			loc, ok := locations[id]
+			if !ok {
+				continue
+			}
+			for _, line := range loc.Line {
+				fn, ok := functions[line.FunctionId]
+				if !ok {
+					continue
+				}
+				k := pprofFunctionKey{name: fn.Name}
+				switch granularity {
+				case typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE:
+					k.line = line.Line
+					fallthrough
+				case typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FILE:
+					k.filename = fn.Filename
+				}
+				stack = append(stack, k)
+			}
+		}
+		values.Add(stack, s.Value[0])
+	}
+	top := make([]*typesv1.TopFunction, 0, values.Len())
+	values.Range(func(k pprofFunctionKey, self, total int64) {
+		top = append(top, &typesv1.TopFunction{
+			Name:     p.StringTable[k.name],
+			Filename: p.StringTable[k.filename],
+			Line:     k.line,
+			Self:     self,
+			Total:    total,
+		})
+	})
+	return top
+}
+
+type topFunctionKey struct {
+	name     string
+	filename string
+	line     int64
+}
+
+// TopFunctionsMerger merges function values of multiple sources,
+// such as partitions, blocks, and time ranges.
+type TopFunctionsMerger struct {
+	mu        sync.Mutex
+	functions map[topFunctionKey]*typesv1.TopFunction
+}
+
+func NewTopFunctionsMerger() *TopFunctionsMerger {
+	return &TopFunctionsMerger{functions: make(map[topFunctionKey]*typesv1.TopFunction)}
+}
+
+func (m *TopFunctionsMerger) MergeTopFunctions(functions []*typesv1.TopFunction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, f := range functions {
+		d := m.function(f)
+		d.Self += f.Self
+		d.Total += f.Total
+		d.BaselineSelf += f.BaselineSelf
+		d.BaselineTotal += f.BaselineTotal
+	}
+}
+
+// MergeBaseline merges the functions as the baseline:
+// their values are accounted as the baseline values.
+func (m *TopFunctionsMerger) MergeBaseline(functions []*typesv1.TopFunction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, f := range functions {
+		d := m.function(f)
+		d.BaselineSelf += f.Self
+		d.BaselineTotal += f.Total
+	}
+}
+
+func (m *TopFunctionsMerger) function(f *typesv1.TopFunction) *typesv1.TopFunction {
+	k := topFunctionKey{name: f.Name, filename: f.Filename, line: f.Line}
+	d, ok := m.functions[k]
+	if !ok {
+		d = &typesv1.TopFunction{Name: f.Name, Filename: f.Filename, Line: f.Line}
+		m.functions[k] = d
+	}
+	return d
+}
+
+// TopFunctions returns all the merged functions, in no particular order.
+func (m *TopFunctionsMerger) TopFunctions() []*typesv1.TopFunction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	functions := make([]*typesv1.TopFunction, 0, len(m.functions))
+	for _, f := range m.functions {
+		functions = append(functions, f)
+	}
+	return functions
+}
+
+// Top returns up to limit functions with the highest values. In the diff
+// mode, the functions are ordered by the absolute difference between the
+// values and the baseline values. If limit is zero, all the functions are
+// returned.
+func (m *TopFunctionsMerger) Top(order querierv1.TopFunctionsOrder, diff bool, limit int) []*typesv1.TopFunction {
+	functions := m.TopFunctions()
+	value := func(f *typesv1.TopFunction) int64 {
+		v, b := f.Self, f.BaselineSelf
+		if order == querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL {
+			v, b = f.Total, f.BaselineTotal
+		}
+		if diff {
+			v -= b
+			if v < 0 {
+				v = -v
+			}
+		}
+		return v
+	}
+	slices.SortFunc(functions, func(a, b *typesv1.TopFunction) int {
+		return cmp.Or(
+			cmp.Compare(value(b), value(a)),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Filename, b.Filename),
+			cmp.Compare(a.Line, b.Line),
+		)
+	})
+	if limit > 0 && len(functions) > limit {
+		functions = functions[:limit]
+	}
+	return functions
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/testhelper"
+)
+
+func Test_FunctionValues(t *testing.T) {
+	values := NewFunctionValues[string]()
+	values.Add([]string{"c", "b", "a"}, 1)
+	values.Add([]string{"b", "a"}, 2)
+	// Recursive calls are accounted once.
+	values.Add([]string{"b", "a", "b", "a"}, 4)
+	values.Add([]string{"c"}, 0)
+	values.Add(nil, 8)
+
+	type value struct{ self, total int64 }
+	actual := make(map[string]value)
+	values.Range(func(k string, self, total int64) {
+		actual[k] = value{self: self, total: total}
+	})
+	assert.Equal(t, map[string]value{
+		"a": {self: 0, total: 7},
+		"b": {self: 6, total: 7},
+		"c": {self: 1, total: 1},
+	}, actual)
+}
+
+func Test_NewTopFunctionsFromProfile(t *testing.T) {
+	p := &profilev1.Profile{
+		StringTable: []string{"", "foo", "bar", "foo.go", "bar.go"},
+		Function: []*profilev1.Function{
+			{Id: 1, Name: 1, Filename: 3},
+			{Id: 2, Name: 2, Filename: 4},
+		},
+		Location: []*profilev1.Location{
+			{Id: 1, Line: []*profilev1.Line{{FunctionId: 1, Line: 10}}},
+			{Id: 2, Line: []*profilev1.Line{{FunctionId: 1, Line: 20}}},
+			// foo is inlined into bar.
+			{Id: 3, Line: []*profilev1.Line{{FunctionId: 1, Line: 30}, {FunctionId: 2, Line: 5}}},
+		},
+		Sample: []*profilev1.Sample{
+			{LocationId: []uint64{1, 3}, Value: []int64{1}},
+			{LocationId: []uint64{2, 3}, Value: []int64{2}},
+			{LocationId: []uint64{3}, Value: []int64{4}},
+		},
+	}
+
+	byName := func(functions []*typesv1.TopFunction) []*typesv1.TopFunction {
+		m := NewTopFunctionsMerger()
+		m.MergeTopFunctions(functions)
+		return m.Top(querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF, false, 0)
+	}
+
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "foo", Self: 7, Total: 7},
+		{Name: "bar", Self: 0, Total: 7},
+	}, byName(NewTopFunctionsFromProfile(p, typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FUNCTION)))
+
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "foo", Filename: "foo.go", Self: 7, Total: 7},
+		{Name: "bar", Filename: "bar.go", Self: 0, Total: 7},
+	}, byName(NewTopFunctionsFromProfile(p, typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FILE)))
+
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "foo", Filename: "foo.go", Line: 30, Self: 4, Total: 7},
+		{Name: "foo", Filename: "foo.go", Line: 20, Self: 2, Total: 2},
+		{Name: "foo", Filename: "foo.go", Line: 10, Self: 1, Total: 1},
+		{Name: "bar", Filename: "bar.go", Line: 5, Self: 0, Total: 7},
+	}, byName(NewTopFunctionsFromProfile(p, typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE)))
+}
+
+func Test_TopFunctionsMerger(t *testing.T) {
+	m := NewTopFunctionsMerger()
+	m.MergeTopFunctions([]*typesv1.TopFunction{
+		{Name: "a", Self: 1, Total: 10},
+		{Name: "b", Self: 5, Total: 5},
+	})
+	m.MergeTopFunctions([]*typesv1.TopFunction{
+		{Name: "a", Self: 1, Total: 2},
+		{Name: "c", Self: 3, Total: 3},
+	})
+
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "b", Self: 5, Total: 5},
+		{Name: "c", Self: 3, Total: 3},
+	}, m.Top(querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF, false, 2))
+
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "a", Self: 2, Total: 12},
+	}, m.Top(querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL, false, 1))
+
+	m.MergeBaseline([]*typesv1.TopFunction{
+		{Name: "a", Self: 2, Total: 2},
+		{Name: "b", Self: 1, Total: 1},
+		{Name: "d", Self: 6, Total: 6},
+	})
+	functions := m.Top(querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF, true, 0)
+	require.Len(t, functions, 4)
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "d", BaselineSelf: 6, BaselineTotal: 6},
+		{Name: "b", Self: 5, Total: 5, BaselineSelf: 1, BaselineTotal: 1},
+		{Name: "c", Self: 3, Total: 3},
+		{Name: "a", Self: 2, Total: 12, BaselineSelf: 2, BaselineTotal: 2},
+	}, functions)
+}
@@ -0,0 +1,219 @@
+package objstore
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/pyroscope/pkg/util/cache"
+)
+
+// bucketCache is a two-tier cache: the in-memory cache is checked
+// first, then the local disk cache. Both tiers are LRU caches bounded
+// by the total size of the values. Either tier may be disabled.
+type bucketCache struct {
+	memory *cache.LRU
+	disk   *diskCache
+}
+
+func (c *bucketCache) get(key string) ([]byte, bool) {
+	if c.memory != nil {
+		if v, ok := c.memory.Get(key); ok {
+			return v, true
+		}
+	}
+	if c.disk != nil {
+		if v, expiresAt, ok := c.disk.get(key); ok {
+			if c.memory != nil {
+				c.memory.Set(key, v, expiresAt)
+			}
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (c *bucketCache) set(key string, value []byte, ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl)
+	if c.memory != nil {
+		c.memory.Set(key, value, expiresAt)
+	}
+	if c.disk != nil {
+		c.disk.set(key, value, expiresAt)
+	}
+}
+
+func (c *bucketCache) delete(key string) {
+	if c.memory != nil {
+		c.memory.Delete(key)
+	}
+	if c.disk != nil {
+		c.disk.delete(key)
+	}
+}
+
+// diskCache stores every value in a file named after the key hash.
+// The file starts with the expiration time, followed by the value.
+// The index of the files is kept in memory and is rebuilt from the
+// directory content when the cache is opened.
+type diskCache struct {
+	dir       string
+	mu        sync.Mutex
+	lru       *lru.LRU[string, int64]
+	size      int64
+	maxSize   int64
+	sizeGauge prometheus.Gauge
+}
+
+const (
+	diskCacheHeaderSize  = 8
+	diskCacheTempSuffix  = ".tmp"
+	diskCacheFileNameLen = sha256.Size * 2
+)
+
+func openDiskCache(dir string, maxSize int64, sizeGauge prometheus.Gauge) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	c := &diskCache{dir: dir, maxSize: maxSize, sizeGauge: sizeGauge}
+	c.lru, _ = lru.NewLRU[string, int64](math.MaxInt, func(name string, size int64) {
+		c.size -= size
+		_ = os.Remove(filepath.Join(c.dir, name))
+	})
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type file struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	files := make([]file, 0, len(entries))
+	for _, e := range entries {
+		// Files not written by the cache are left intact.
+		if e.IsDir() || !isDiskCacheFileName(e.Name()) {
+			continue
+		}
+		if strings.HasSuffix(e.Name(), diskCacheTempSuffix) {
+			_ = os.Remove(filepath.Join(dir, e.Name()))
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file{name: e.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+	// The least recently written files are evicted first.
+	slices.SortFunc(files, func(a, b file) int { return a.modTime.Compare(b.modTime) })
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range files {
+		c.add(f.name, f.size)
+	}
+	return c, nil
+}
+
+func (c *diskCache) fileName(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+// isDiskCacheFileName reports whether the file name starts with
+// a key hash: both the cache files and their temporary files do.
+func isDiskCacheFileName(name string) bool {
+	if len(name) < diskCacheFileNameLen {
+		return false
+	}
+	for _, c := range []byte(name[:diskCacheFileNameLen]) {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return len(name) == diskCacheFileNameLen || strings.HasSuffix(name, diskCacheTempSuffix)
+}
+
+func (c *diskCache) get(key string) ([]byte, time.Time, bool) {
+	name := c.fileName(key)
+	c.mu.Lock()
+	_, ok := c.lru.Get(name)
+	c.mu.Unlock()
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	b, err := os.ReadFile(filepath.Join(c.dir, name))
+	if err != nil || len(b) < diskCacheHeaderSize {
+		c.remove(name)
+		return nil, time.Time{}, false
+	}
+	expiresAt := time.Unix(0, int64(binary.LittleEndian.Uint64(b)))
+	if expiresAt.Before(time.Now()) {
+		c.remove(name)
+		return nil, time.Time{}, false
+	}
+	return b[diskCacheHeaderSize:], expiresAt, true
+}
+
+func (c *diskCache) set(key string, value []byte, expiresAt time.Time) {
+	size := int64(diskCacheHeaderSize + len(value))
+	if size > c.maxSize {
+		return
+	}
+	name := c.fileName(key)
+	f, err := os.CreateTemp(c.dir, name+"-*"+diskCacheTempSuffix)
+	if err != nil {
+		return
+	}
+	var header [diskCacheHeaderSize]byte
+	binary.LittleEndian.PutUint64(header[:], uint64(expiresAt.UnixNano()))
+	if _, err = f.Write(header[:]); err == nil {
+		_, err = f.Write(value)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(c.dir, name))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return
+	}
+	c.add(name, size)
+}
+
+// add must be called with the mutex held.
+func (c *diskCache) add(name string, size int64) {
+	if prev, ok := c.lru.Peek(name); ok {
+		// The file has been overwritten.
+		c.size -= prev
+	}
+	c.lru.Add(name, size)
+	c.size += size
+	for c.size > c.maxSize {
+		c.lru.RemoveOldest()
+	}
+	c.sizeGauge.Set(float64(c.size))
+}
+
+func (c *diskCache) delete(key string) { c.remove(c.fileName(key)) }
+
+func (c *diskCache) remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Remove(name)
+	c.sizeGauge.Set(float64(c.size))
+}
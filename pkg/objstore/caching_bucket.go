@@ -0,0 +1,419 @@
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/grafana/dskit/flagext"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/thanos-io/objstore"
+
+	"github.com/grafana/pyroscope/pkg/util/cache"
+)
+
+// DefaultCachedObjectsPattern matches the immutable block files:
+// block metadata, TSDB indexes, symbols, and parquet tables.
+const DefaultCachedObjectsPattern = `(/meta\.json|\.tsdb|\.symdb|\.parquet|/block\.bin)$`
+
+// DefaultCachedBlockSections lists the sections of the v2 block objects.
+var DefaultCachedBlockSections = []string{"profiles", "tsdb", "symbols", "dataset_tsdb_index"}
+
+const (
+	cacheSectionAttributes = "attributes"
+	cacheSectionObjects    = "objects"
+	cacheSectionRanges     = "ranges"
+
+	cacheTierMemory = "memory"
+	cacheTierDisk   = "disk"
+)
+
+type CachingBucketConfig struct {
+	MemorySizeBytes int64  `yaml:"memory_size_bytes"`
+	DiskDir         string `yaml:"disk_dir"`
+	DiskSizeBytes   int64  `yaml:"disk_size_bytes"`
+
+	Attributes CacheSectionConfig        `yaml:"attributes"`
+	Objects    ObjectsCacheSectionConfig `yaml:"objects"`
+	Ranges     RangesCacheSectionConfig  `yaml:"ranges"`
+}
+
+type CacheSectionConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	TTL         time.Duration `yaml:"ttl"`
+	NamePattern string        `yaml:"name_pattern" category:"advanced"`
+}
+
+type ObjectsCacheSectionConfig struct {
+	CacheSectionConfig `yaml:",inline"`
+	MaxSizeBytes       int64 `yaml:"max_size_bytes"`
+}
+
+type RangesCacheSectionConfig struct {
+	CacheSectionConfig `yaml:",inline"`
+	MaxSizeBytes       int64                  `yaml:"max_size_bytes"`
+	SubrangeSizeBytes  int64                  `yaml:"subrange_size_bytes" category:"advanced"`
+	BlockSections      flagext.StringSliceCSV `yaml:"block_sections" category:"advanced"`
+}
+
+func (cfg *CachingBucketConfig) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
+	f.Int64Var(&cfg.MemorySizeBytes, prefix+"memory-size-bytes", 256<<20, "Maximum size of the in-memory bucket cache in bytes. 0 to disable the in-memory cache.")
+	f.StringVar(&cfg.DiskDir, prefix+"disk-dir", "", "Directory of the local disk bucket cache. If empty, the disk cache is disabled.")
+	f.Int64Var(&cfg.DiskSizeBytes, prefix+"disk-size-bytes", 10<<30, "Maximum size of the local disk bucket cache in bytes.")
+	cfg.Attributes.RegisterFlagsWithPrefix(prefix+"attributes.", "object attributes", f)
+	cfg.Objects.RegisterFlagsWithPrefix(prefix+"objects.", "small objects", f)
+	f.Int64Var(&cfg.Objects.MaxSizeBytes, prefix+"objects.max-size-bytes", 1<<20, "Objects larger than this size are not cached.")
+	cfg.Ranges.RegisterFlagsWithPrefix(prefix+"ranges.", "object byte ranges", f)
+	f.Int64Var(&cfg.Ranges.MaxSizeBytes, prefix+"ranges.max-size-bytes", 1<<20, "Byte ranges larger than this size are not cached.")
+	f.Int64Var(&cfg.Ranges.SubrangeSizeBytes, prefix+"ranges.subrange-size-bytes", 16<<10, "Byte ranges are cached in aligned sub-ranges of this size.")
+	cfg.Ranges.BlockSections = slices.Clone(DefaultCachedBlockSections)
+	f.Var(&cfg.Ranges.BlockSections, prefix+"ranges.block-sections", "Comma separated list of the v2 block sections, whose byte ranges are cached: profiles, tsdb, symbols, dataset_tsdb_index. Byte ranges read outside of the sections, such as the block metadata, are cached regardless.")
+}
+
+func (cfg *CacheSectionConfig) RegisterFlagsWithPrefix(prefix, section string, f *flag.FlagSet) {
+	f.BoolVar(&cfg.Enabled, prefix+"enabled", false, fmt.Sprintf("Cache %s.", section))
+	f.DurationVar(&cfg.TTL, prefix+"ttl", 24*time.Hour, fmt.Sprintf("How long to keep cached %s.", section))
+	f.StringVar(&cfg.NamePattern, prefix+"name-pattern", DefaultCachedObjectsPattern, fmt.Sprintf("Regular expression matching the names of the objects, whose %s are cached. Only immutable objects should be cached.", section))
+}
+
+// Enabled reports whether caching of any section is enabled.
+func (cfg *CachingBucketConfig) Enabled() bool {
+	return cfg.Attributes.Enabled || cfg.Objects.Enabled || cfg.Ranges.Enabled
+}
+
+func (cfg *CachingBucketConfig) Validate() error {
+	if !cfg.Enabled() {
+		return nil
+	}
+	if cfg.MemorySizeBytes <= 0 && (cfg.DiskDir == "" || cfg.DiskSizeBytes <= 0) {
+		return errors.New("bucket cache: either memory or disk cache size must be positive")
+	}
+	for name, section := range map[string]CacheSectionConfig{
+		cacheSectionAttributes: cfg.Attributes,
+		cacheSectionObjects:    cfg.Objects.CacheSectionConfig,
+		cacheSectionRanges:     cfg.Ranges.CacheSectionConfig,
+	} {
+		if _, err := regexp.Compile(section.NamePattern); section.Enabled && err != nil {
+			return fmt.Errorf("bucket cache: invalid %s name pattern: %w", name, err)
+		}
+	}
+	if cfg.Ranges.Enabled && cfg.Ranges.SubrangeSizeBytes <= 0 {
+		return errors.New("bucket cache: sub-range size must be positive")
+	}
+	return nil
+}
+
+// CachingBucket caches object attributes, small objects, and byte ranges
+// of the objects. The cache is not invalidated on changes made bypassing
+// the bucket, therefore only immutable objects should be cached.
+type CachingBucket struct {
+	Bucket
+
+	cfg     CachingBucketConfig
+	cache   *bucketCache
+	metrics *cachingBucketMetrics
+
+	attributes *regexp.Regexp
+	objects    *regexp.Regexp
+	ranges     *regexp.Regexp
+}
+
+type cachingBucketMetrics struct {
+	requests  *prometheus.CounterVec
+	hits      *prometheus.CounterVec
+	sizeBytes *prometheus.GaugeVec
+}
+
+func newCachingBucketMetrics(reg prometheus.Registerer) *cachingBucketMetrics {
+	return &cachingBucketMetrics{
+		requests: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_bucket_cache_requests_total",
+			Help: "Total number of requests to the bucket cache.",
+		}, []string{"section"}),
+		hits: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_bucket_cache_hits_total",
+			Help: "Total number of requests to the bucket cache that were a hit.",
+		}, []string{"section"}),
+		sizeBytes: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "pyroscope_bucket_cache_size_bytes",
+			Help: "Size of the bucket cache in bytes.",
+		}, []string{"tier"}),
+	}
+}
+
+// NewCachingBucket wraps the bucket with a cache. If caching
+// is not enabled, the bucket is returned as is.
+func NewCachingBucket(bkt Bucket, cfg CachingBucketConfig, reg prometheus.Registerer) (Bucket, error) {
+	if !cfg.Enabled() {
+		return bkt, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	b := &CachingBucket{
+		Bucket:  bkt,
+		cfg:     cfg,
+		cache:   new(bucketCache),
+		metrics: newCachingBucketMetrics(reg),
+	}
+	if cfg.MemorySizeBytes > 0 {
+		b.cache.memory = cache.NewLRU(cfg.MemorySizeBytes, b.metrics.sizeBytes.WithLabelValues(cacheTierMemory))
+	}
+	if cfg.DiskDir != "" && cfg.DiskSizeBytes > 0 {
+		var err error
+		b.cache.disk, err = openDiskCache(cfg.DiskDir, cfg.DiskSizeBytes, b.metrics.sizeBytes.WithLabelValues(cacheTierDisk))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open bucket disk cache: %w", err)
+		}
+	}
+	if cfg.Attributes.Enabled {
+		b.attributes = regexp.MustCompile(cfg.Attributes.NamePattern)
+	}
+	if cfg.Objects.Enabled {
+		b.objects = regexp.MustCompile(cfg.Objects.NamePattern)
+	}
+	if cfg.Ranges.Enabled {
+		b.ranges = regexp.MustCompile(cfg.Ranges.NamePattern)
+	}
+	return b, nil
+}
+
+func matches(r *regexp.Regexp, name string) bool { return r != nil && r.MatchString(name) }
+
+func attributesKey(name string) string { return "attributes:" + name }
+
+func objectKey(name string) string { return "object:" + name }
+
+func subrangeKey(name string, off, size int64) string {
+	return "range:" + name + ":" + strconv.FormatInt(off, 10) + ":" + strconv.FormatInt(size, 10)
+}
+
+func (b *CachingBucket) fetch(section, key string) ([]byte, bool) {
+	b.metrics.requests.WithLabelValues(section).Inc()
+	v, ok := b.cache.get(key)
+	if ok {
+		b.metrics.hits.WithLabelValues(section).Inc()
+	}
+	return v, ok
+}
+
+// Attributes returns information about the specified object.
+func (b *CachingBucket) Attributes(ctx context.Context, name string) (objstore.ObjectAttributes, error) {
+	if !matches(b.attributes, name) {
+		return b.Bucket.Attributes(ctx, name)
+	}
+	key := attributesKey(name)
+	if v, ok := b.fetch(cacheSectionAttributes, key); ok && len(v) == 16 {
+		return objstore.ObjectAttributes{
+			Size:         int64(binary.LittleEndian.Uint64(v)),
+			LastModified: time.Unix(0, int64(binary.LittleEndian.Uint64(v[8:]))),
+		}, nil
+	}
+	attrs, err := b.Bucket.Attributes(ctx, name)
+	if err != nil {
+		return attrs, err
+	}
+	v := make([]byte, 16)
+	binary.LittleEndian.PutUint64(v, uint64(attrs.Size))
+	binary.LittleEndian.PutUint64(v[8:], uint64(attrs.LastModified.UnixNano()))
+	b.cache.set(key, v, b.cfg.Attributes.TTL)
+	return attrs, nil
+}
+
+// Get returns a reader for the given object name.
+func (b *CachingBucket) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	if !matches(b.objects, name) {
+		return b.Bucket.Get(ctx, name)
+	}
+	key := objectKey(name)
+	if v, ok := b.fetch(cacheSectionObjects, key); ok {
+		return io.NopCloser(bytes.NewReader(v)), nil
+	}
+	rc, err := b.Bucket.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// One byte more than the limit is read to tell
+	// whether the object is small enough to be cached.
+	v, err := io.ReadAll(io.LimitReader(rc, b.cfg.Objects.MaxSizeBytes+1))
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	if int64(len(v)) > b.cfg.Objects.MaxSizeBytes {
+		return &readCloser{Reader: io.MultiReader(bytes.NewReader(v), rc), Closer: rc}, nil
+	}
+	_ = rc.Close()
+	b.cache.set(key, v, b.cfg.Objects.TTL)
+	return io.NopCloser(bytes.NewReader(v)), nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// GetRange returns a new range reader for the given object name and range.
+// The range is served from the cached sub-ranges; missing sub-ranges are
+// fetched from the bucket. Ranges that extend to the end of the object
+// (negative length) are not cached.
+func (b *CachingBucket) GetRange(ctx context.Context, name string, off, length int64) (io.ReadCloser, error) {
+	if !matches(b.ranges, name) || off < 0 || length <= 0 || length > b.cfg.Ranges.MaxSizeBytes {
+		return b.Bucket.GetRange(ctx, name, off, length)
+	}
+	v, err := b.getRange(ctx, name, off, off+length)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(v)), nil
+}
+
+// getRange reads the range from the cached sub-ranges. The object size
+// is not known in advance: a sub-range shorter than the sub-range size
+// is the last one of the object, and the range is truncated there.
+func (b *CachingBucket) getRange(ctx context.Context, name string, off, end int64) ([]byte, error) {
+	subrangeSize := b.cfg.Ranges.SubrangeSizeBytes
+	first := off / subrangeSize * subrangeSize
+	subranges := make(map[int64][]byte)
+	var missing []int64
+	for s := first; s < end; s += subrangeSize {
+		v, ok := b.fetch(cacheSectionRanges, subrangeKey(name, s, subrangeSize))
+		if !ok {
+			missing = append(missing, s)
+			continue
+		}
+		subranges[s] = v
+		if int64(len(v)) < subrangeSize {
+			break
+		}
+	}
+
+	// Adjacent missing sub-ranges are fetched with a single request.
+	for i := 0; i < len(missing); {
+		j := i + 1
+		for j < len(missing) && missing[j] == missing[j-1]+subrangeSize {
+			j++
+		}
+		start := missing[i]
+		v, err := b.readRange(ctx, name, start, missing[j-1]+subrangeSize-start)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range missing[i:j] {
+			if s-start >= int64(len(v)) {
+				// Past the end of the object.
+				break
+			}
+			// The sub-range is copied in order not to retain the whole buffer.
+			sub := bytes.Clone(v[s-start : min(s+subrangeSize-start, int64(len(v)))])
+			b.cache.set(subrangeKey(name, s, subrangeSize), sub, b.cfg.Ranges.TTL)
+			subranges[s] = sub
+		}
+		i = j
+	}
+
+	buf := make([]byte, 0, end-off)
+	for s := first; s < end; s += subrangeSize {
+		v, ok := subranges[s]
+		if !ok {
+			break
+		}
+		size := int64(len(v))
+		buf = append(buf, v[min(max(off-s, 0), size):min(end-s, size)]...)
+		if size < subrangeSize {
+			break
+		}
+	}
+	return buf, nil
+}
+
+// readRange reads the range from the bucket. The result is shorter
+// than the requested length if the range exceeds the object size.
+func (b *CachingBucket) readRange(ctx context.Context, name string, off, length int64) ([]byte, error) {
+	rc, err := b.Bucket.GetRange(ctx, name, off, length)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// WithSection returns the bucket that reads the given section of the objects.
+// Byte ranges of the section are only cached, if the section is listed in
+// the block sections of the ranges cache.
+func (b *CachingBucket) WithSection(section string) BucketReader {
+	c := *b
+	if !slices.Contains(b.cfg.Ranges.BlockSections, section) {
+		c.ranges = nil
+	}
+	return &c
+}
+
+// ReaderWithSection returns the bucket that reads the given section of the
+// objects. The bucket is returned as is, unless it's a caching bucket.
+func ReaderWithSection(b BucketReader, section string) BucketReader {
+	if cb, ok := b.(*CachingBucket); ok {
+		return cb.WithSection(section)
+	}
+	return b
+}
+
+func (b *CachingBucket) ReaderAt(ctx context.Context, name string) (ReaderAtCloser, error) {
+	if !matches(b.ranges, name) {
+		return b.Bucket.ReaderAt(ctx, name)
+	}
+	return &ReaderAt{GetRangeReader: b, name: name, ctx: ctx}, nil
+}
+
+// Upload the contents of the reader as an object into the bucket.
+// Cached attributes, contents, and byte ranges of the object are invalidated.
+func (b *CachingBucket) Upload(ctx context.Context, name string, r io.Reader) error {
+	b.invalidate(ctx, name)
+	return b.Bucket.Upload(ctx, name, r)
+}
+
+// Delete removes the object with the given name.
+// Cached attributes, contents, and byte ranges of the object are invalidated.
+func (b *CachingBucket) Delete(ctx context.Context, name string) error {
+	b.invalidate(ctx, name)
+	return b.Bucket.Delete(ctx, name)
+}
+
+func (b *CachingBucket) invalidate(ctx context.Context, name string) {
+	if matches(b.ranges, name) {
+		// The sub-ranges of the object are found by its current size,
+		// therefore they must be dropped before the attributes.
+		if attrs, err := b.Attributes(ctx, name); err == nil {
+			subrangeSize := b.cfg.Ranges.SubrangeSizeBytes
+			for s := int64(0); s < attrs.Size; s += subrangeSize {
+				b.cache.delete(subrangeKey(name, s, subrangeSize))
+			}
+		}
+	}
+	b.cache.delete(attributesKey(name))
+	b.cache.delete(objectKey(name))
+}
+
+// ReaderWithExpectedErrs implements objstore.Bucket.
+func (b *CachingBucket) ReaderWithExpectedErrs(fn IsOpFailureExpectedFunc) BucketReader {
+	return b.WithExpectedErrs(fn)
+}
+
+// WithExpectedErrs implements objstore.Bucket.
+func (b *CachingBucket) WithExpectedErrs(fn IsOpFailureExpectedFunc) Bucket {
+	if ib, ok := b.Bucket.(InstrumentedBucket); ok {
+		c := *b
+		c.Bucket = ib.WithExpectedErrs(fn)
+		return &c
+	}
+	return b
+}
@@ -0,0 +1,290 @@
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thanos-io/objstore"
+	"go.uber.org/atomic"
+
+	"github.com/grafana/pyroscope/pkg/objstore/providers/memory"
+)
+
+type countingBucket struct {
+	objstore.Bucket
+	attributes atomic.Int64
+	gets       atomic.Int64
+	getRanges  atomic.Int64
+}
+
+func (b *countingBucket) Attributes(ctx context.Context, name string) (objstore.ObjectAttributes, error) {
+	b.attributes.Inc()
+	return b.Bucket.Attributes(ctx, name)
+}
+
+func (b *countingBucket) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	b.gets.Inc()
+	return b.Bucket.Get(ctx, name)
+}
+
+func (b *countingBucket) GetRange(ctx context.Context, name string, off, length int64) (io.ReadCloser, error) {
+	b.getRanges.Inc()
+	return b.Bucket.GetRange(ctx, name, off, length)
+}
+
+func defaultCachingBucketTestConfig(t *testing.T) CachingBucketConfig {
+	var cfg CachingBucketConfig
+	cfg.RegisterFlagsWithPrefix("", flag.NewFlagSet("", flag.PanicOnError))
+	cfg.Attributes.Enabled = true
+	cfg.Objects.Enabled = true
+	cfg.Objects.MaxSizeBytes = 1 << 10
+	cfg.Ranges.Enabled = true
+	cfg.Ranges.SubrangeSizeBytes = 16
+	cfg.DiskDir = t.TempDir()
+	return cfg
+}
+
+func newCachingBucketTest(t *testing.T, cfg CachingBucketConfig) (*CachingBucket, *countingBucket, []byte) {
+	inner := &countingBucket{Bucket: memory.NewInMemBucket()}
+	data := make([]byte, 100)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	require.NoError(t, inner.Upload(context.Background(), "block/profiles.parquet", bytes.NewReader(data)))
+	require.NoError(t, inner.Upload(context.Background(), "block/meta.json", bytes.NewReader(data[:10])))
+	require.NoError(t, inner.Upload(context.Background(), "bucket-index.json", bytes.NewReader(data[:10])))
+	b, err := NewCachingBucket(NewBucket(inner), cfg, prometheus.NewRegistry())
+	require.NoError(t, err)
+	return b.(*CachingBucket), inner, data
+}
+
+func readRange(t *testing.T, b BucketReader, name string, off, length int64) []byte {
+	rc, err := b.GetRange(context.Background(), name, off, length)
+	require.NoError(t, err)
+	defer rc.Close()
+	v, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return v
+}
+
+func TestCachingBucket_Attributes(t *testing.T) {
+	b, inner, _ := newCachingBucketTest(t, defaultCachingBucketTestConfig(t))
+	ctx := context.Background()
+	expected, err := inner.Bucket.Attributes(ctx, "block/profiles.parquet")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		attrs, err := b.Attributes(ctx, "block/profiles.parquet")
+		require.NoError(t, err)
+		assert.Equal(t, expected.Size, attrs.Size)
+		assert.True(t, expected.LastModified.Equal(attrs.LastModified))
+	}
+	assert.Equal(t, int64(1), inner.attributes.Load())
+
+	// Objects not matching the pattern are not cached.
+	for i := 0; i < 3; i++ {
+		_, err = b.Attributes(ctx, "bucket-index.json")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(4), inner.attributes.Load())
+
+	_, err = b.Attributes(ctx, "block/missing.parquet")
+	assert.True(t, b.IsObjNotFoundErr(err))
+	assert.Equal(t, 2.0, testutil.ToFloat64(b.metrics.hits.WithLabelValues(cacheSectionAttributes)))
+	assert.Equal(t, 4.0, testutil.ToFloat64(b.metrics.requests.WithLabelValues(cacheSectionAttributes)))
+}
+
+func TestCachingBucket_Get(t *testing.T) {
+	b, inner, data := newCachingBucketTest(t, defaultCachingBucketTestConfig(t))
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		rc, err := b.Get(ctx, "block/meta.json")
+		require.NoError(t, err)
+		v, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, data[:10], v)
+	}
+	assert.Equal(t, int64(1), inner.gets.Load())
+
+	// Objects larger than the limit are not cached.
+	b.cfg.Objects.MaxSizeBytes = 50
+	for i := 0; i < 3; i++ {
+		rc, err := b.Get(ctx, "block/profiles.parquet")
+		require.NoError(t, err)
+		v, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, data, v)
+	}
+	assert.Equal(t, int64(4), inner.gets.Load())
+
+	// Upload invalidates the cached object.
+	require.NoError(t, b.Upload(ctx, "block/meta.json", bytes.NewReader(data[:20])))
+	rc, err := b.Get(ctx, "block/meta.json")
+	require.NoError(t, err)
+	v, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data[:20], v)
+}
+
+func TestCachingBucket_GetRange(t *testing.T) {
+	b, inner, data := newCachingBucketTest(t, defaultCachingBucketTestConfig(t))
+	const name = "block/profiles.parquet"
+
+	// The range spans sub-ranges [16, 32), [32, 48), [48, 64).
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Equal(t, int64(1), inner.getRanges.Load())
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Equal(t, data[32:48], readRange(t, b, name, 32, 16))
+	assert.Equal(t, int64(1), inner.getRanges.Load())
+
+	// Only the missing sub-ranges are fetched:
+	// [0, 16) and [64, 100), the last one is short.
+	assert.Equal(t, data, readRange(t, b, name, 0, 100))
+	assert.Equal(t, int64(3), inner.getRanges.Load())
+	assert.Equal(t, data[90:], readRange(t, b, name, 90, 100))
+	assert.Equal(t, data, readRange(t, b, name, 0, 100))
+	assert.Equal(t, int64(3), inner.getRanges.Load())
+
+	// ReaderAt reads through the cache.
+	r, err := b.ReaderAt(context.Background(), name)
+	require.NoError(t, err)
+	buf := make([]byte, 10)
+	n, err := r.ReadAt(buf, 70)
+	require.NoError(t, err)
+	assert.Equal(t, 10, n)
+	assert.Equal(t, data[70:80], buf)
+	assert.Equal(t, int64(3), inner.getRanges.Load())
+
+	// Ranges to the end of the object are not cached.
+	assert.Equal(t, data, readRange(t, b, name, 0, -1))
+	assert.Equal(t, int64(4), inner.getRanges.Load())
+
+	// Ranges larger than the limit are not cached.
+	b.cfg.Ranges.MaxSizeBytes = 10
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Equal(t, int64(5), inner.getRanges.Load())
+
+	// Object size is never looked up.
+	assert.Zero(t, inner.attributes.Load())
+}
+
+func TestCachingBucket_WithSection(t *testing.T) {
+	cfg := defaultCachingBucketTestConfig(t)
+	cfg.Ranges.BlockSections = []string{"tsdb"}
+	b, inner, data := newCachingBucketTest(t, cfg)
+	const name = "block/profiles.parquet"
+
+	// Byte ranges of the sections not listed are not cached.
+	profiles := ReaderWithSection(b, "profiles")
+	for i := 1; i <= 2; i++ {
+		assert.Equal(t, data[20:50], readRange(t, profiles, name, 20, 30))
+		assert.Equal(t, int64(i), inner.getRanges.Load())
+	}
+	tsdb := ReaderWithSection(b, "tsdb")
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, data[20:50], readRange(t, tsdb, name, 20, 30))
+		assert.Equal(t, int64(3), inner.getRanges.Load())
+	}
+	// Byte ranges read outside of the sections are cached.
+	assert.Equal(t, data[60:70], readRange(t, b, name, 60, 10))
+	assert.Equal(t, data[60:70], readRange(t, b, name, 60, 10))
+	assert.Equal(t, int64(4), inner.getRanges.Load())
+}
+
+func TestCachingBucket_GetRange_Invalidation(t *testing.T) {
+	b, inner, data := newCachingBucketTest(t, defaultCachingBucketTestConfig(t))
+	ctx := context.Background()
+	const name = "block/profiles.parquet"
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Equal(t, int64(1), inner.getRanges.Load())
+
+	// Upload drops the cached sub-ranges.
+	updated := bytes.Repeat([]byte{0xff}, 40)
+	require.NoError(t, b.Upload(ctx, name, bytes.NewReader(updated)))
+	assert.Equal(t, updated[20:40], readRange(t, b, name, 20, 30))
+	assert.Equal(t, int64(2), inner.getRanges.Load())
+
+	require.NoError(t, b.Delete(ctx, name))
+	_, err := b.GetRange(ctx, name, 20, 10)
+	assert.True(t, b.IsObjNotFoundErr(err))
+}
+
+func TestCachingBucket_DiskCache(t *testing.T) {
+	cfg := defaultCachingBucketTestConfig(t)
+	cfg.MemorySizeBytes = 0
+	b, inner, data := newCachingBucketTest(t, cfg)
+	const name = "block/profiles.parquet"
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Equal(t, int64(1), inner.getRanges.Load())
+
+	// The cache is persisted between restarts.
+	b, inner, _ = newCachingBucketTest(t, cfg)
+	assert.Equal(t, data[20:50], readRange(t, b, name, 20, 30))
+	assert.Zero(t, inner.getRanges.Load())
+	assert.Zero(t, inner.attributes.Load())
+}
+
+func TestDiskCache_Eviction(t *testing.T) {
+	dir := t.TempDir()
+	size := prometheus.NewGauge(prometheus.GaugeOpts{Name: "size"})
+	c, err := openDiskCache(dir, 3*(diskCacheHeaderSize+4), size)
+	require.NoError(t, err)
+	for _, k := range []string{"a", "b", "c"} {
+		c.set(k, []byte("1234"), time.Now().Add(time.Hour))
+	}
+	_, _, ok := c.get("a")
+	require.True(t, ok)
+	// "b" is the least recently used: it's evicted.
+	c.set("d", []byte("1234"), time.Now().Add(time.Hour))
+	_, _, ok = c.get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 3.0*(diskCacheHeaderSize+4), testutil.ToFloat64(size))
+
+	// Expired.
+	c.set("a", []byte("12"), time.Now().Add(-time.Second))
+	_, _, ok = c.get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2.0*(diskCacheHeaderSize+4), testutil.ToFloat64(size))
+
+	c, err = openDiskCache(dir, 3*(diskCacheHeaderSize+4), size)
+	require.NoError(t, err)
+	for _, k := range []string{"c", "d"} {
+		v, _, ok := c.get(k)
+		require.True(t, ok)
+		assert.Equal(t, []byte("1234"), v)
+	}
+	assert.Equal(t, 2.0*(diskCacheHeaderSize+4), testutil.ToFloat64(size))
+}
+
+func TestDiskCache_ForeignFiles(t *testing.T) {
+	dir := t.TempDir()
+	foreign := []string{"data.bin", "data.tmp", strings.Repeat("A", diskCacheFileNameLen)}
+	for _, name := range foreign {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), make([]byte, 100), 0o644))
+	}
+	size := prometheus.NewGauge(prometheus.GaugeOpts{Name: "size"})
+	c, err := openDiskCache(dir, 2*(diskCacheHeaderSize+4), size)
+	require.NoError(t, err)
+	assert.Zero(t, testutil.ToFloat64(size))
+	for _, k := range []string{"a", "b", "c"} {
+		c.set(k, []byte("1234"), time.Now().Add(time.Hour))
+	}
+
+	// The files not written by the cache are neither adopted nor evicted.
+	_, err = openDiskCache(dir, 2*(diskCacheHeaderSize+4), size)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0*(diskCacheHeaderSize+4), testutil.ToFloat64(size))
+	for _, name := range foreign {
+		assert.FileExists(t, filepath.Join(dir, name))
+	}
+}
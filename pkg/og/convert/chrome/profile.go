@@ -0,0 +1,213 @@
+// Package chrome converts Chrome DevTools and V8 CPU profiles
+// (.cpuprofile) to pprof.
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	"github.com/grafana/pyroscope/pkg/og/convert/internal/pprofbuilder"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+	"github.com/grafana/pyroscope/pkg/og/storage"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+const metricName = "process_cpu"
+
+// Samples of these nodes are not CPU time of the program: (idle) is
+// the time the thread was waiting for work, and (program) is the time
+// spent by the engine outside of any code execution.
+var nonCPUFunctions = map[string]struct{}{
+	"(idle)":    {},
+	"(program)": {},
+}
+
+// cpuProfile is the Profile type of the Chrome DevTools protocol:
+// https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-Profile
+type cpuProfile struct {
+	Nodes []node `json:"nodes"`
+	// Start and end time in microseconds.
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
+	// Samples are identifiers of the nodes; the deltas
+	// are intervals between samples in microseconds.
+	Samples    []int64 `json:"samples"`
+	TimeDeltas []int64 `json:"timeDeltas"`
+}
+
+type node struct {
+	ID        int64     `json:"id"`
+	CallFrame callFrame `json:"callFrame"`
+	HitCount  int64     `json:"hitCount"`
+	Children  []int64   `json:"children"`
+	// Parent is used instead of children by some tools.
+	Parent int64 `json:"parent"`
+}
+
+type callFrame struct {
+	FunctionName string `json:"functionName"`
+	URL          string `json:"url"`
+	// 0-based line number; -1, if unknown.
+	LineNumber int64 `json:"lineNumber"`
+}
+
+// RawProfile implements ingestion.RawProfile for Chrome CPU profiles.
+type RawProfile struct {
+	RawData []byte
+}
+
+func (p *RawProfile) Bytes() ([]byte, error) { return p.RawData, nil }
+
+func (*RawProfile) ContentType() string { return "application/json" }
+
+func (p *RawProfile) Parse(context.Context, storage.Putter, storage.MetricsExporter, ingestion.Metadata) error {
+	return fmt.Errorf("parsing Chrome CPU profile to Tree/storage.Putter is not supported")
+}
+
+func (p *RawProfile) ParseToPprof(_ context.Context, md ingestion.Metadata) (*distributormodel.PushRequest, error) {
+	profile, err := ToPprof(p.RawData)
+	if err != nil {
+		return nil, err
+	}
+	res := &distributormodel.PushRequest{
+		RawProfileSize: len(p.RawData),
+		RawProfileType: distributormodel.RawProfileTypeChrome,
+	}
+	if len(profile.Sample) == 0 {
+		return res, nil
+	}
+	res.Series = []*distributormodel.ProfileSeries{{
+		Labels: ingestion.SeriesLabels(md, metricName),
+		Samples: []*distributormodel.ProfileSample{{
+			Profile: pprof.RawFromProto(profile),
+		}},
+	}}
+	return res, nil
+}
+
+// IsCPUProfile reports whether the data is a Chrome CPU profile.
+func IsCPUProfile(data []byte) bool {
+	var p struct {
+		Nodes     []json.RawMessage `json:"nodes"`
+		StartTime *int64            `json:"startTime"`
+	}
+	return json.Unmarshal(data, &p) == nil && len(p.Nodes) > 0 && p.StartTime != nil
+}
+
+// ToPprof converts the Chrome CPU profile to pprof.
+func ToPprof(data []byte) (*profilev1.Profile, error) {
+	var p cpuProfile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse Chrome CPU profile: %w", err)
+	}
+	if len(p.Nodes) == 0 {
+		return nil, fmt.Errorf("invalid Chrome CPU profile: no nodes")
+	}
+
+	nodes := make(map[int64]*node, len(p.Nodes))
+	for i := range p.Nodes {
+		nodes[p.Nodes[i].ID] = &p.Nodes[i]
+	}
+	parents := make(map[int64]int64, len(p.Nodes))
+	for _, n := range p.Nodes {
+		if n.Parent != 0 {
+			parents[n.ID] = n.Parent
+		}
+		for _, c := range n.Children {
+			parents[c] = n.ID
+		}
+	}
+
+	start := time.UnixMicro(p.StartTime)
+	end := time.UnixMicro(p.EndTime)
+	var period time.Duration
+	if len(p.Samples) > 0 {
+		period = end.Sub(start) / time.Duration(len(p.Samples))
+	}
+	b := pprofbuilder.NewCPUProfile(period, start, end)
+
+	stacks := make(map[int64][]uint64, len(p.Nodes))
+	stack := func(id int64) []uint64 {
+		if s, ok := stacks[id]; ok {
+			return s
+		}
+		var s []uint64
+		// The root node (the one without a parent) is not
+		// included. The depth is limited to not loop forever
+		// on malformed profiles.
+		for n := id; len(s) < len(p.Nodes); {
+			parent, ok := parents[n]
+			if !ok || nodes[n] == nil {
+				break
+			}
+			s = append(s, b.Location(frame(nodes[n].CallFrame)))
+			n = parent
+		}
+		stacks[id] = s
+		return s
+	}
+
+	if len(p.Samples) == 0 {
+		// Legacy profiles only have hit counts.
+		var hits int64
+		for _, n := range p.Nodes {
+			hits += n.HitCount
+		}
+		if hits == 0 {
+			return b.Profile(), nil
+		}
+		interval := end.Sub(start).Nanoseconds() / hits
+		for _, n := range p.Nodes {
+			if !isNonCPU(&n) {
+				b.AddSample(stack(n.ID), n.HitCount, n.HitCount*interval)
+			}
+		}
+		return b.Profile(), nil
+	}
+
+	if len(p.TimeDeltas) != len(p.Samples) {
+		return nil, fmt.Errorf("invalid Chrome CPU profile: %d samples and %d time deltas", len(p.Samples), len(p.TimeDeltas))
+	}
+	// The sample value is the time until the next sample.
+	ts := p.StartTime
+	for i, id := range p.Samples {
+		ts += p.TimeDeltas[i]
+		next := p.EndTime
+		if i+1 < len(p.Samples) {
+			next = ts + p.TimeDeltas[i+1]
+		}
+		if isNonCPU(nodes[id]) {
+			continue
+		}
+		var nanos int64
+		if next > ts {
+			nanos = (next - ts) * int64(time.Microsecond)
+		}
+		b.AddSample(stack(id), 1, nanos)
+	}
+	return b.Profile(), nil
+}
+
+func isNonCPU(n *node) bool {
+	if n == nil {
+		return false
+	}
+	_, ok := nonCPUFunctions[n.CallFrame.FunctionName]
+	return ok
+}
+
+func frame(f callFrame) pprofbuilder.Frame {
+	name := f.FunctionName
+	if name == "" {
+		name = "(anonymous)"
+	}
+	var line int64
+	if f.LineNumber >= 0 {
+		line = f.LineNumber + 1
+	}
+	return pprofbuilder.Frame{Name: name, File: f.URL, Line: line}
+}
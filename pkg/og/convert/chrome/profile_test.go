@@ -0,0 +1,131 @@
+package chrome
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	"github.com/grafana/pyroscope/api/model/labelset"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+)
+
+type sample struct {
+	stack  []string
+	values []int64
+}
+
+func samples(p *profilev1.Profile) []sample {
+	var samples []sample
+	for _, s := range p.Sample {
+		x := sample{values: s.Value}
+		for _, id := range s.LocationId {
+			loc := p.Location[id-1]
+			fn := p.Function[loc.Line[0].FunctionId-1]
+			x.stack = append(x.stack, p.StringTable[fn.Name])
+		}
+		samples = append(samples, x)
+	}
+	return samples
+}
+
+func TestToPprof(t *testing.T) {
+	data, err := os.ReadFile("testdata/profile.cpuprofile")
+	require.NoError(t, err)
+	require.True(t, IsCPUProfile(data))
+
+	p, err := ToPprof(data)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMicro(1700000000000000).UnixNano(), p.TimeNanos)
+	assert.Equal(t, int64(4*time.Millisecond), p.DurationNanos)
+	assert.Equal(t, int64(time.Millisecond), p.Period)
+	assert.Equal(t, []sample{
+		{stack: []string{"foo", "main"}, values: []int64{2, 2e6}},
+		{stack: []string{"(anonymous)", "main"}, values: []int64{1, 1e6}},
+		{stack: []string{"(garbage collector)"}, values: []int64{1, 1e6}},
+	}, samples(p))
+
+	// Line numbers are zero-based in Chrome profiles.
+	for _, loc := range p.Location {
+		fn := p.Function[loc.Line[0].FunctionId-1]
+		if p.StringTable[fn.Name] == "foo" {
+			assert.Equal(t, int64(10), loc.Line[0].Line)
+			assert.Equal(t, "file:///app/index.js", p.StringTable[fn.Filename])
+		}
+	}
+}
+
+func TestToPprof_HitCount(t *testing.T) {
+	const data = `{
+  "nodes": [
+    {"id": 1, "callFrame": {"functionName": "(root)", "lineNumber": -1}, "hitCount": 0},
+    {"id": 2, "parent": 1, "callFrame": {"functionName": "main", "lineNumber": 0}, "hitCount": 1},
+    {"id": 3, "parent": 2, "callFrame": {"functionName": "foo", "lineNumber": 9}, "hitCount": 3}
+  ],
+  "startTime": 0,
+  "endTime": 4000
+}`
+	p, err := ToPprof([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, []sample{
+		{stack: []string{"main"}, values: []int64{1, 1e6}},
+		{stack: []string{"foo", "main"}, values: []int64{3, 3e6}},
+	}, samples(p))
+}
+
+func TestToPprof_NonCPU(t *testing.T) {
+	const data = `{
+  "nodes": [
+    {"id": 1, "callFrame": {"functionName": "(root)", "lineNumber": -1}, "children": [2, 3, 4]},
+    {"id": 2, "callFrame": {"functionName": "main", "lineNumber": 0}},
+    {"id": 3, "callFrame": {"functionName": "(idle)", "lineNumber": -1}},
+    {"id": 4, "callFrame": {"functionName": "(program)", "lineNumber": -1}}
+  ],
+  "startTime": 0,
+  "endTime": 4000,
+  "samples": [2, 3, 4, 2],
+  "timeDeltas": [0, 1000, 1000, 1000]
+}`
+	p, err := ToPprof([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, []sample{
+		{stack: []string{"main"}, values: []int64{2, 2e6}},
+	}, samples(p))
+}
+
+func TestToPprof_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"not json": `nodes`,
+		"no nodes": `{"startTime": 0, "endTime": 1}`,
+		"deltas":   `{"nodes": [{"id": 1}], "startTime": 0, "samples": [1, 1], "timeDeltas": [0]}`,
+	} {
+		_, err := ToPprof([]byte(data))
+		assert.Error(t, err, name)
+	}
+	assert.False(t, IsCPUProfile([]byte(`{"meta": {}, "threads": []}`)))
+}
+
+func TestParseToPprof(t *testing.T) {
+	data, err := os.ReadFile("testdata/profile.cpuprofile")
+	require.NoError(t, err)
+	ls, err := labelset.Parse("app{env=test}")
+	require.NoError(t, err)
+
+	req, err := (&RawProfile{RawData: data}).ParseToPprof(context.Background(), ingestion.Metadata{
+		LabelSet: ls,
+		SpyName:  "cpuprofile",
+	})
+	require.NoError(t, err)
+	require.Len(t, req.Series, 1)
+
+	series := phlaremodel.Labels(req.Series[0].Labels)
+	assert.Equal(t, "process_cpu", series.Get("__name__"))
+	assert.Equal(t, "app", series.Get(phlaremodel.LabelNameServiceName))
+	assert.Equal(t, "test", series.Get("env"))
+	assert.Len(t, req.Series[0].Samples[0].Profile.Sample, 3)
+}
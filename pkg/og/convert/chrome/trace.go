@@ -0,0 +1,75 @@
+package chrome
+
+import (
+	"encoding/json"
+
+	"github.com/grafana/pyroscope/pkg/og/storage/tree"
+)
+
+// trace is the JSON object form of the Trace Event Format, which is
+// supported by chrome://tracing, Perfetto UI, and speedscope.
+//
+// See https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+type trace struct {
+	TraceEvents     []traceEvent      `json:"traceEvents"`
+	DisplayTimeUnit string            `json:"displayTimeUnit,omitempty"`
+	OtherData       map[string]string `json:"otherData,omitempty"`
+}
+
+type traceEvent struct {
+	Name string `json:"name"`
+	Ph   string `json:"ph"`
+	// Timestamps and durations are in microseconds.
+	Ts  float64 `json:"ts"`
+	Dur float64 `json:"dur"`
+	Pid int     `json:"pid"`
+	Tid int     `json:"tid"`
+}
+
+const phaseComplete = "X"
+
+// ExportTrace converts the tree to the Trace Event Format. The tree is
+// laid out as a flame graph: every node becomes a complete event with
+// the duration of its total value (see tree.WalkFlameGraph).
+//
+// The unit is a pprof sample unit, e.g. "nanoseconds". Values in time
+// units are converted to microseconds, other values are used as is.
+func ExportTrace(t *tree.Tree, sampleUnit string) ([]byte, error) {
+	toMicroseconds := microseconds(sampleUnit)
+	events := make([]traceEvent, 0)
+	var starts []uint64
+	t.WalkFlameGraph(func(_ string, offset uint64) {
+		starts = append(starts, offset)
+	}, func(name string, offset uint64) {
+		start := starts[len(starts)-1]
+		starts = starts[:len(starts)-1]
+		events = append(events, traceEvent{
+			Name: name,
+			Ph:   phaseComplete,
+			Ts:   toMicroseconds(start),
+			Dur:  toMicroseconds(offset - start),
+			Pid:  1,
+			Tid:  1,
+		})
+	})
+
+	return json.Marshal(trace{
+		TraceEvents: events,
+		OtherData:   map[string]string{"unit": sampleUnit},
+	})
+}
+
+// microseconds returns the function converting values
+// in the unit to microseconds.
+func microseconds(sampleUnit string) func(uint64) float64 {
+	switch sampleUnit {
+	case "nanoseconds":
+		return func(v uint64) float64 { return float64(v) / 1e3 }
+	case "milliseconds":
+		return func(v uint64) float64 { return float64(v) * 1e3 }
+	case "seconds":
+		return func(v uint64) float64 { return float64(v) * 1e6 }
+	default:
+		return func(v uint64) float64 { return float64(v) }
+	}
+}
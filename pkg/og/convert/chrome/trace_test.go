@@ -0,0 +1,37 @@
+package chrome
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/pkg/og/storage/tree"
+)
+
+func TestExportTrace(t *testing.T) {
+	tr := tree.New()
+	tr.Insert([]byte("a;b"), 500)
+	tr.Insert([]byte("a;b;c"), 500)
+	tr.Insert([]byte("a;b;d"), 400)
+	tr.Insert([]byte("e"), 1000)
+
+	data, err := ExportTrace(tr, "nanoseconds")
+	require.NoError(t, err)
+
+	var actual trace
+	require.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, map[string]string{"unit": "nanoseconds"}, actual.OtherData)
+
+	event := func(name string, ts, dur float64) traceEvent {
+		return traceEvent{Name: name, Ph: phaseComplete, Ts: ts, Dur: dur, Pid: 1, Tid: 1}
+	}
+	assert.Equal(t, []traceEvent{
+		event("c", 0.5, 0.5),
+		event("d", 1, 0.4),
+		event("b", 0, 1.4),
+		event("a", 0, 1.4),
+		event("e", 1.4, 1),
+	}, actual.TraceEvents)
+}
@@ -0,0 +1,284 @@
+// Package firefox converts Firefox Profiler processed profiles to pprof.
+//
+// See https://github.com/firefox-devtools/profiler/blob/main/docs-developer/CHANGELOG-formats.md
+package firefox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	"github.com/grafana/pyroscope/pkg/og/convert/internal/pprofbuilder"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+	"github.com/grafana/pyroscope/pkg/og/storage"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+const (
+	metricName = "process_cpu"
+
+	labelNameThreadName = "thread_name"
+
+	weightTypeSamples   = "samples"
+	weightTypeTracingMs = "tracing-ms"
+
+	// maxDecompressedSize limits the size of a gzip compressed profile
+	// after decompression: JSON profiles compress very well, and the
+	// compressed request body size is not a sufficient bound.
+	maxDecompressedSize = 100 << 20
+)
+
+type processedProfile struct {
+	Meta struct {
+		// Sampling interval and start time in milliseconds.
+		Interval  float64 `json:"interval"`
+		StartTime float64 `json:"startTime"`
+
+		PreprocessedProfileVersion int `json:"preprocessedProfileVersion"`
+	} `json:"meta"`
+	Shared struct {
+		StringArray []string `json:"stringArray"`
+	} `json:"shared"`
+	Threads []thread `json:"threads"`
+}
+
+type thread struct {
+	Name    string `json:"name"`
+	Samples struct {
+		Stack []*int64 `json:"stack"`
+		// Time or TimeDeltas are in milliseconds.
+		Time       []float64 `json:"time"`
+		TimeDeltas []float64 `json:"timeDeltas"`
+		Weight     []float64 `json:"weight"`
+		WeightType string    `json:"weightType"`
+	} `json:"samples"`
+	StackTable struct {
+		Frame  []int64  `json:"frame"`
+		Prefix []*int64 `json:"prefix"`
+	} `json:"stackTable"`
+	FrameTable struct {
+		Func []int64  `json:"func"`
+		Line []*int64 `json:"line"`
+	} `json:"frameTable"`
+	FuncTable struct {
+		Name       []int64  `json:"name"`
+		FileName   []*int64 `json:"fileName"`
+		LineNumber []*int64 `json:"lineNumber"`
+	} `json:"funcTable"`
+	// Older versions of the format have per-thread string tables.
+	StringArray []string `json:"stringArray"`
+	StringTable []string `json:"stringTable"`
+}
+
+// RawProfile implements ingestion.RawProfile for Firefox Profiler
+// processed profiles, optionally gzip compressed.
+type RawProfile struct {
+	RawData []byte
+}
+
+func (p *RawProfile) Bytes() ([]byte, error) { return p.RawData, nil }
+
+func (*RawProfile) ContentType() string { return "application/json" }
+
+func (p *RawProfile) Parse(context.Context, storage.Putter, storage.MetricsExporter, ingestion.Metadata) error {
+	return fmt.Errorf("parsing Firefox profile to Tree/storage.Putter is not supported")
+}
+
+func (p *RawProfile) ParseToPprof(_ context.Context, md ingestion.Metadata) (*distributormodel.PushRequest, error) {
+	profile, err := ToPprof(p.RawData)
+	if err != nil {
+		return nil, err
+	}
+	res := &distributormodel.PushRequest{
+		RawProfileSize: len(p.RawData),
+		RawProfileType: distributormodel.RawProfileTypeFirefox,
+	}
+	if len(profile.Sample) == 0 {
+		return res, nil
+	}
+	res.Series = []*distributormodel.ProfileSeries{{
+		Labels: ingestion.SeriesLabels(md, metricName),
+		Samples: []*distributormodel.ProfileSample{{
+			Profile: pprof.RawFromProto(profile),
+		}},
+	}}
+	return res, nil
+}
+
+// IsProfile reports whether the data is a Firefox Profiler processed profile.
+func IsProfile(data []byte) bool {
+	data, err := decompress(data)
+	if err != nil {
+		return false
+	}
+	var p struct {
+		Meta *struct {
+			PreprocessedProfileVersion int `json:"preprocessedProfileVersion"`
+		} `json:"meta"`
+		Threads []json.RawMessage `json:"threads"`
+	}
+	return json.Unmarshal(data, &p) == nil && p.Meta != nil && p.Meta.PreprocessedProfileVersion > 0
+}
+
+// ToPprof converts the Firefox Profiler processed profile to pprof.
+// Samples of all threads are merged; the thread name is added as a
+// sample label.
+func ToPprof(data []byte) (*profilev1.Profile, error) {
+	data, err := decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	var p processedProfile
+	if err = json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse Firefox profile: %w", err)
+	}
+	if p.Meta.PreprocessedProfileVersion == 0 {
+		return nil, fmt.Errorf("invalid Firefox profile: only the processed format is supported")
+	}
+
+	interval := time.Duration(p.Meta.Interval * float64(time.Millisecond))
+	start := msToTime(p.Meta.StartTime)
+	end := start
+	for i := range p.Threads {
+		if t := msToTime(p.Meta.StartTime + p.Threads[i].lastSampleTime()).Add(interval); t.After(end) {
+			end = t
+		}
+	}
+	b := pprofbuilder.NewCPUProfile(interval, start, end)
+	for i := range p.Threads {
+		t := &p.Threads[i]
+		if len(t.StringArray) == 0 {
+			t.StringArray = t.StringTable
+		}
+		if len(t.StringArray) == 0 {
+			t.StringArray = p.Shared.StringArray
+		}
+		if err = t.convert(b, interval); err != nil {
+			return nil, fmt.Errorf("invalid Firefox profile: thread %q: %w", t.Name, err)
+		}
+	}
+	return b.Profile(), nil
+}
+
+func (t *thread) lastSampleTime() float64 {
+	if n := len(t.Samples.Time); n > 0 {
+		return t.Samples.Time[n-1]
+	}
+	var ts float64
+	for _, d := range t.Samples.TimeDeltas {
+		ts += d
+	}
+	return ts
+}
+
+func (t *thread) convert(b *pprofbuilder.Builder, interval time.Duration) error {
+	switch t.Samples.WeightType {
+	case "", weightTypeSamples, weightTypeTracingMs:
+	default:
+		return fmt.Errorf("unsupported weight type %q", t.Samples.WeightType)
+	}
+	stacks := make(map[int64][]uint64)
+	for i, s := range t.Samples.Stack {
+		if s == nil {
+			continue
+		}
+		stack, ok := stacks[*s]
+		if !ok {
+			var err error
+			if stack, err = t.stack(b, *s); err != nil {
+				return err
+			}
+			stacks[*s] = stack
+		}
+		weight := 1.0
+		if i < len(t.Samples.Weight) {
+			weight = t.Samples.Weight[i]
+		}
+		samples := int64(weight)
+		nanos := int64(weight * float64(interval))
+		if t.Samples.WeightType == weightTypeTracingMs {
+			samples = 1
+			nanos = int64(weight * float64(time.Millisecond))
+		}
+		b.AddSample(stack, samples, nanos, labelNameThreadName, t.Name)
+	}
+	return nil
+}
+
+func (t *thread) stack(b *pprofbuilder.Builder, s int64) ([]uint64, error) {
+	var stack []uint64
+	for len(stack) <= len(t.StackTable.Frame) {
+		if s < 0 || s >= int64(len(t.StackTable.Frame)) || s >= int64(len(t.StackTable.Prefix)) {
+			return nil, fmt.Errorf("stack index %d out of range", s)
+		}
+		f, err := t.frame(t.StackTable.Frame[s])
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, b.Location(f))
+		prefix := t.StackTable.Prefix[s]
+		if prefix == nil {
+			return stack, nil
+		}
+		s = *prefix
+	}
+	return nil, fmt.Errorf("stack table has a cycle")
+}
+
+func (t *thread) frame(f int64) (pprofbuilder.Frame, error) {
+	if f < 0 || f >= int64(len(t.FrameTable.Func)) {
+		return pprofbuilder.Frame{}, fmt.Errorf("frame index %d out of range", f)
+	}
+	fn := t.FrameTable.Func[f]
+	if fn < 0 || fn >= int64(len(t.FuncTable.Name)) {
+		return pprofbuilder.Frame{}, fmt.Errorf("function index %d out of range", fn)
+	}
+	var frame pprofbuilder.Frame
+	frame.Name = t.string(&t.FuncTable.Name[fn])
+	if fn < int64(len(t.FuncTable.FileName)) {
+		frame.File = t.string(t.FuncTable.FileName[fn])
+	}
+	if f < int64(len(t.FrameTable.Line)) && t.FrameTable.Line[f] != nil {
+		frame.Line = *t.FrameTable.Line[f]
+	} else if fn < int64(len(t.FuncTable.LineNumber)) && t.FuncTable.LineNumber[fn] != nil {
+		frame.Line = *t.FuncTable.LineNumber[fn]
+	}
+	return frame, nil
+}
+
+func (t *thread) string(i *int64) string {
+	if i == nil || *i < 0 || *i >= int64(len(t.StringArray)) {
+		return ""
+	}
+	return t.StringArray[*i]
+}
+
+func msToTime(ms float64) time.Time {
+	return time.UnixMicro(int64(math.Round(ms * 1e3)))
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gzip header: %w", err)
+	}
+	defer r.Close()
+	data, err = io.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress Firefox profile: %w", err)
+	}
+	if len(data) > maxDecompressedSize {
+		return nil, fmt.Errorf("decompressed Firefox profile exceeds the limit of %d bytes", maxDecompressedSize)
+	}
+	return data, nil
+}
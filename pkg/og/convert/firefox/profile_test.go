@@ -0,0 +1,123 @@
+package firefox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	"github.com/grafana/pyroscope/api/model/labelset"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+)
+
+type sample struct {
+	stack  []string
+	thread string
+	values []int64
+}
+
+func samples(p *profilev1.Profile) []sample {
+	var samples []sample
+	for _, s := range p.Sample {
+		x := sample{values: s.Value}
+		for _, id := range s.LocationId {
+			loc := p.Location[id-1]
+			fn := p.Function[loc.Line[0].FunctionId-1]
+			x.stack = append(x.stack, p.StringTable[fn.Name])
+		}
+		for _, l := range s.Label {
+			if p.StringTable[l.Key] == labelNameThreadName {
+				x.thread = p.StringTable[l.Str]
+			}
+		}
+		samples = append(samples, x)
+	}
+	return samples
+}
+
+func TestToPprof(t *testing.T) {
+	data, err := os.ReadFile("testdata/profile.json")
+	require.NoError(t, err)
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	for name, data := range map[string][]byte{
+		"json": data,
+		"gzip": gz.Bytes(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.True(t, IsProfile(data))
+			p, err := ToPprof(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, time.UnixMilli(1700000000000).UnixNano(), p.TimeNanos)
+			assert.Equal(t, int64(6*time.Millisecond), p.DurationNanos)
+			assert.Equal(t, int64(time.Millisecond), p.Period)
+			assert.Equal(t, []sample{
+				{stack: []string{"foo", "XRE_main"}, thread: "GeckoMain", values: []int64{2, 2e6}},
+				{stack: []string{"bar", "XRE_main"}, thread: "GeckoMain", values: []int64{1, 1e6}},
+				{stack: []string{"worker_loop"}, thread: "DOM Worker", values: []int64{3, 3e6}},
+			}, samples(p))
+
+			lines := make(map[string]int64)
+			for _, loc := range p.Location {
+				fn := p.Function[loc.Line[0].FunctionId-1]
+				lines[p.StringTable[fn.Name]] = loc.Line[0].Line
+			}
+			// The frame line takes precedence over the function line.
+			assert.Equal(t, int64(12), lines["foo"])
+			assert.Equal(t, int64(20), lines["bar"])
+		})
+	}
+}
+
+func TestToPprof_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"not json":     `threads`,
+		"unprocessed":  `{"meta": {"version": 27}, "threads": []}`,
+		"bad stack":    `{"meta": {"preprocessedProfileVersion": 47}, "threads": [{"samples": {"stack": [3]}}]}`,
+		"weight type":  `{"meta": {"preprocessedProfileVersion": 47}, "threads": [{"samples": {"stack": [], "weightType": "bytes"}}]}`,
+		"stack cycle":  `{"meta": {"preprocessedProfileVersion": 47}, "threads": [{"samples": {"stack": [0]}, "stackTable": {"frame": [0], "prefix": [0]}, "frameTable": {"func": [0]}, "funcTable": {"name": [0]}}]}`,
+		"bad function": `{"meta": {"preprocessedProfileVersion": 47}, "threads": [{"samples": {"stack": [0]}, "stackTable": {"frame": [0], "prefix": [null]}, "frameTable": {"func": [1]}, "funcTable": {"name": [0]}}]}`,
+	} {
+		_, err := ToPprof([]byte(data))
+		assert.Error(t, err, name)
+	}
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err := w.Write(make([]byte, maxDecompressedSize+1))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	_, err = ToPprof(gz.Bytes())
+	assert.ErrorContains(t, err, "exceeds the limit")
+}
+
+func TestParseToPprof(t *testing.T) {
+	data, err := os.ReadFile("testdata/profile.json")
+	require.NoError(t, err)
+	ls, err := labelset.Parse("app{env=test}")
+	require.NoError(t, err)
+
+	req, err := (&RawProfile{RawData: data}).ParseToPprof(context.Background(), ingestion.Metadata{
+		LabelSet: ls,
+		SpyName:  "firefox",
+	})
+	require.NoError(t, err)
+	require.Len(t, req.Series, 1)
+
+	series := phlaremodel.Labels(req.Series[0].Labels)
+	assert.Equal(t, "process_cpu", series.Get("__name__"))
+	assert.Equal(t, "app", series.Get(phlaremodel.LabelNameServiceName))
+	assert.Len(t, req.Series[0].Samples[0].Profile.Sample, 3)
+}
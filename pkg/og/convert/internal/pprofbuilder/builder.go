@@ -0,0 +1,136 @@
+// Package pprofbuilder helps to convert CPU profiles of sampling
+// profilers that report symbolized stack frames to pprof.
+package pprofbuilder
+
+import (
+	"strconv"
+	"time"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+)
+
+// Frame is a symbolized stack frame.
+type Frame struct {
+	Name string
+	File string
+	// Line is the 1-based line number; 0, if unknown.
+	Line int64
+}
+
+type functionKey struct {
+	name string
+	file string
+}
+
+type locationKey struct {
+	function uint64
+	line     int64
+}
+
+// Builder builds a CPU profile: samples have two values, the number
+// of samples, and the CPU time in nanoseconds.
+type Builder struct {
+	profile *profilev1.Profile
+
+	strings   map[string]int64
+	functions map[functionKey]uint64
+	locations map[locationKey]uint64
+	samples   map[string]*profilev1.Sample
+
+	key []byte
+}
+
+func NewCPUProfile(period time.Duration, start, end time.Time) *Builder {
+	b := &Builder{
+		profile: &profilev1.Profile{
+			StringTable: []string{""},
+			Mapping:     []*profilev1.Mapping{{Id: 1, HasFunctions: true, HasLineNumbers: true}},
+			Period:      period.Nanoseconds(),
+			TimeNanos:   start.UnixNano(),
+		},
+		strings:   map[string]int64{"": 0},
+		functions: make(map[functionKey]uint64),
+		locations: make(map[locationKey]uint64),
+		samples:   make(map[string]*profilev1.Sample),
+	}
+	if end.After(start) {
+		b.profile.DurationNanos = end.Sub(start).Nanoseconds()
+	}
+	b.profile.SampleType = []*profilev1.ValueType{
+		{Type: b.string("samples"), Unit: b.string("count")},
+		{Type: b.string("cpu"), Unit: b.string("nanoseconds")},
+	}
+	b.profile.PeriodType = &profilev1.ValueType{Type: b.string("cpu"), Unit: b.string("nanoseconds")}
+	return b
+}
+
+// Location returns the identifier of the location of the frame.
+func (b *Builder) Location(f Frame) uint64 {
+	fk := functionKey{name: f.Name, file: f.File}
+	fn, ok := b.functions[fk]
+	if !ok {
+		fn = uint64(len(b.profile.Function) + 1)
+		b.profile.Function = append(b.profile.Function, &profilev1.Function{
+			Id:         fn,
+			Name:       b.string(f.Name),
+			SystemName: b.string(f.Name),
+			Filename:   b.string(f.File),
+		})
+		b.functions[fk] = fn
+	}
+	lk := locationKey{function: fn, line: f.Line}
+	if id, ok := b.locations[lk]; ok {
+		return id
+	}
+	id := uint64(len(b.profile.Location) + 1)
+	b.profile.Location = append(b.profile.Location, &profilev1.Location{
+		Id:        id,
+		MappingId: 1,
+		Line:      []*profilev1.Line{{FunctionId: fn, Line: f.Line}},
+	})
+	b.locations[lk] = id
+	return id
+}
+
+// AddSample adds the sample values to the stack trace. Locations
+// are ordered from the leaf to the root; labels are key-value pairs.
+func (b *Builder) AddSample(locations []uint64, samples, nanos int64, labels ...string) {
+	if len(locations) == 0 || (samples == 0 && nanos == 0) {
+		return
+	}
+	b.key = b.key[:0]
+	for _, id := range locations {
+		b.key = strconv.AppendUint(b.key, id, 16)
+		b.key = append(b.key, ',')
+	}
+	for _, l := range labels {
+		b.key = append(b.key, 0)
+		b.key = append(b.key, l...)
+	}
+	s, ok := b.samples[string(b.key)]
+	if !ok {
+		s = &profilev1.Sample{
+			LocationId: append([]uint64(nil), locations...),
+			Value:      make([]int64, 2),
+		}
+		for i := 0; i+1 < len(labels); i += 2 {
+			s.Label = append(s.Label, &profilev1.Label{Key: b.string(labels[i]), Str: b.string(labels[i+1])})
+		}
+		b.samples[string(b.key)] = s
+		b.profile.Sample = append(b.profile.Sample, s)
+	}
+	s.Value[0] += samples
+	s.Value[1] += nanos
+}
+
+func (b *Builder) Profile() *profilev1.Profile { return b.profile }
+
+func (b *Builder) string(s string) int64 {
+	if i, ok := b.strings[s]; ok {
+		return i
+	}
+	i := int64(len(b.profile.StringTable))
+	b.profile.StringTable = append(b.profile.StringTable, s)
+	b.strings[s] = i
+	return i
+}
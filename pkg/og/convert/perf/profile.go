@@ -0,0 +1,273 @@
+package perf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+	"github.com/grafana/pyroscope/pkg/og/storage"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+const (
+	metricName = "perf"
+
+	labelNameComm = "comm"
+	labelNamePID  = "pid"
+	labelNameTID  = "tid"
+
+	// defaultEventName is used when the output
+	// does not include the event field.
+	defaultEventName = "samples"
+)
+
+// RawProfile implements ingestion.RawProfile for the `perf script` output.
+type RawProfile struct {
+	RawData []byte
+}
+
+func (p *RawProfile) Bytes() ([]byte, error) { return p.RawData, nil }
+
+func (*RawProfile) ContentType() string { return "text/plain" }
+
+func (p *RawProfile) Parse(context.Context, storage.Putter, storage.MetricsExporter, ingestion.Metadata) error {
+	return fmt.Errorf("parsing perf script to Tree/storage.Putter is not supported")
+}
+
+// ParseToPprof converts perf events to a pprof profile: each event
+// type has its own sample type, and the event values are periods,
+// if present. The command name, the process and thread IDs of the
+// events are added as sample labels.
+func (p *RawProfile) ParseToPprof(_ context.Context, md ingestion.Metadata) (*distributormodel.PushRequest, error) {
+	b := newProfileBuilder(md)
+	parser := NewScriptParser(p.RawData)
+	for {
+		e, err := parser.NextEvent()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse perf script: %w", err)
+		}
+		b.addEvent(e)
+	}
+	res := &distributormodel.PushRequest{
+		RawProfileSize: len(p.RawData),
+		RawProfileType: distributormodel.RawProfileTypePerfScript,
+	}
+	if len(b.profile.Sample) == 0 {
+		return res, nil
+	}
+	res.Series = []*distributormodel.ProfileSeries{{
+		Labels: ingestion.SeriesLabels(md, metricName),
+		Samples: []*distributormodel.ProfileSample{{
+			Profile: pprof.RawFromProto(b.build()),
+		}},
+	}}
+	return res, nil
+}
+
+type locationKey struct {
+	addr   string
+	sym    string
+	module string
+}
+
+type profileBuilder struct {
+	profile *profilev1.Profile
+
+	strings   map[string]int64
+	events    map[string]int
+	functions map[string]uint64
+	mappings  map[string]uint64
+	locations map[locationKey]uint64
+	samples   map[string]*profilev1.Sample
+
+	key []byte
+}
+
+func newProfileBuilder(md ingestion.Metadata) *profileBuilder {
+	b := &profileBuilder{
+		profile: &profilev1.Profile{
+			StringTable:   []string{""},
+			TimeNanos:     md.StartTime.UnixNano(),
+			DurationNanos: md.EndTime.Sub(md.StartTime).Nanoseconds(),
+		},
+		strings:   map[string]int64{"": 0},
+		events:    make(map[string]int),
+		functions: make(map[string]uint64),
+		mappings:  make(map[string]uint64),
+		locations: make(map[locationKey]uint64),
+		samples:   make(map[string]*profilev1.Sample),
+	}
+	if b.profile.DurationNanos < 0 {
+		b.profile.DurationNanos = 0
+	}
+	return b
+}
+
+func (b *profileBuilder) addEvent(e *Event) {
+	idx, period := b.eventIndex(e)
+	b.key = b.key[:0]
+	locations := make([]uint64, 0, len(e.Frames))
+	for _, f := range e.Frames {
+		id := b.location(f)
+		locations = append(locations, id)
+		b.key = strconv.AppendUint(b.key, id, 16)
+		b.key = append(b.key, ',')
+	}
+	b.key = append(b.key, 0)
+	b.key = append(b.key, e.Comm...)
+	b.key = append(b.key, 0)
+	b.key = strconv.AppendInt(b.key, int64(e.PID), 10)
+	b.key = append(b.key, 0)
+	b.key = strconv.AppendInt(b.key, int64(e.TID), 10)
+	s, ok := b.samples[string(b.key)]
+	if !ok {
+		s = &profilev1.Sample{LocationId: locations}
+		s.Label = append(s.Label, &profilev1.Label{Key: b.string(labelNameComm), Str: b.string(string(e.Comm))})
+		if e.TID != 0 {
+			s.Label = append(s.Label,
+				&profilev1.Label{Key: b.string(labelNamePID), Str: b.string(strconv.Itoa(e.PID))},
+				&profilev1.Label{Key: b.string(labelNameTID), Str: b.string(strconv.Itoa(e.TID))},
+			)
+		} else {
+			// A single number is the thread ID: perf script
+			// only prints the process ID if asked to do so.
+			s.Label = append(s.Label, &profilev1.Label{Key: b.string(labelNameTID), Str: b.string(strconv.Itoa(e.PID))})
+		}
+		b.samples[string(b.key)] = s
+		b.profile.Sample = append(b.profile.Sample, s)
+	}
+	for len(s.Value) < len(b.profile.SampleType) {
+		s.Value = append(s.Value, 0)
+	}
+	s.Value[idx] += period
+}
+
+// eventIndex returns the sample type index of the event, and its value.
+func (b *profileBuilder) eventIndex(e *Event) (int, int64) {
+	name := eventName(e.Name)
+	period := e.Period
+	if period == 0 {
+		period = 1
+	}
+	idx, ok := b.events[name]
+	if !ok {
+		idx = len(b.profile.SampleType)
+		b.events[name] = idx
+		b.profile.SampleType = append(b.profile.SampleType, &profilev1.ValueType{
+			Type: b.string(name),
+			Unit: b.string(eventUnit(name, e.Period)),
+		})
+		if idx == 0 {
+			b.profile.PeriodType = b.profile.SampleType[0].CloneVT()
+			b.profile.Period = period
+		}
+	}
+	return idx, period
+}
+
+func (b *profileBuilder) location(f Frame) uint64 {
+	k := locationKey{addr: string(f.Addr), sym: string(f.Sym), module: string(f.Module)}
+	if id, ok := b.locations[k]; ok {
+		return id
+	}
+	loc := &profilev1.Location{
+		Id:        uint64(len(b.profile.Location) + 1),
+		MappingId: b.mapping(k.module),
+		Line:      []*profilev1.Line{{FunctionId: b.function(functionName(f.Sym))}},
+	}
+	loc.Address, _ = strconv.ParseUint(k.addr, 16, 64)
+	b.profile.Location = append(b.profile.Location, loc)
+	b.locations[k] = loc.Id
+	return loc.Id
+}
+
+func (b *profileBuilder) function(name string) uint64 {
+	if id, ok := b.functions[name]; ok {
+		return id
+	}
+	fn := &profilev1.Function{
+		Id:         uint64(len(b.profile.Function) + 1),
+		Name:       b.string(name),
+		SystemName: b.string(name),
+	}
+	b.profile.Function = append(b.profile.Function, fn)
+	b.functions[name] = fn.Id
+	return fn.Id
+}
+
+func (b *profileBuilder) mapping(module string) uint64 {
+	if id, ok := b.mappings[module]; ok {
+		return id
+	}
+	m := &profilev1.Mapping{
+		Id:           uint64(len(b.profile.Mapping) + 1),
+		Filename:     b.string(module),
+		HasFunctions: true,
+	}
+	b.profile.Mapping = append(b.profile.Mapping, m)
+	b.mappings[module] = m.Id
+	return m.Id
+}
+
+func (b *profileBuilder) string(s string) int64 {
+	if i, ok := b.strings[s]; ok {
+		return i
+	}
+	i := int64(len(b.profile.StringTable))
+	b.profile.StringTable = append(b.profile.StringTable, s)
+	b.strings[s] = i
+	return i
+}
+
+func (b *profileBuilder) build() *profilev1.Profile {
+	// Event types may be discovered after the sample is created.
+	for _, s := range b.profile.Sample {
+		for len(s.Value) < len(b.profile.SampleType) {
+			s.Value = append(s.Value, 0)
+		}
+	}
+	return b.profile
+}
+
+var reEventModifiers = regexp.MustCompile("^[ukhIGHpPSDWeb]+$")
+
+// eventName returns the event name without modifiers,
+// e.g., cycles for cycles:u and cpu-clock for cpu-clock:ppp.
+func eventName(name []byte) string {
+	if len(name) == 0 {
+		return defaultEventName
+	}
+	if i := bytes.LastIndexByte(name, ':'); i > 0 && reEventModifiers.Match(name[i+1:]) {
+		name = name[:i]
+	}
+	return string(name)
+}
+
+func eventUnit(name string, period int64) string {
+	switch name {
+	case "cpu-clock", "task-clock":
+		if period > 0 {
+			return "nanoseconds"
+		}
+	}
+	return "count"
+}
+
+// functionName strips the offset of the instruction
+// from the symbol name: main+0x6a9 becomes main.
+func functionName(sym []byte) string {
+	if i := bytes.LastIndex(sym, []byte("+0x")); i > 0 {
+		sym = sym[:i]
+	}
+	return string(sym)
+}
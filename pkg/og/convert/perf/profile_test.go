@@ -0,0 +1,124 @@
+package perf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/api/model/labelset"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+)
+
+const testScript = "java 12688/12764 [002] 6544038.708352:     250000 cpu-clock:pppH: \n" +
+	"        ffffffffb43f9179 do_syscall_64+0x69 (/lib/modules/5.19.0/build/vmlinux)\n" +
+	"                  27ae79 main+0x6a9 (/usr/bin/java)\n" +
+	"\n" +
+	"java 12688/12764 [002] 6544038.709352:     250000 cpu-clock:pppH: \n" +
+	"        ffffffffb43f9179 do_syscall_64+0x69 (/lib/modules/5.19.0/build/vmlinux)\n" +
+	"                  27ae79 main+0x6a9 (/usr/bin/java)\n" +
+	"\n" +
+	"java 12688/12765 [003] 6544038.709352:         16 cycles:u: \n" +
+	"                  27ae80 main+0x6b0 (/usr/bin/java)\n" +
+	"\n"
+
+func TestParseToPprof(t *testing.T) {
+	ls, err := labelset.Parse("app{env=test}")
+	require.NoError(t, err)
+	md := ingestion.Metadata{
+		StartTime: time.Unix(10, 0),
+		EndTime:   time.Unix(20, 0),
+		LabelSet:  ls,
+		SpyName:   "perf_script",
+	}
+	req, err := (&RawProfile{RawData: []byte(testScript)}).ParseToPprof(context.Background(), md)
+	require.NoError(t, err)
+	require.Len(t, req.Series, 1)
+
+	series := phlaremodel.Labels(req.Series[0].Labels)
+	assert.Equal(t, "perf", series.Get("__name__"))
+	assert.Equal(t, "app", series.Get(phlaremodel.LabelNameServiceName))
+	assert.Equal(t, "test", series.Get("env"))
+
+	p := req.Series[0].Samples[0].Profile.Profile
+	assert.Equal(t, int64(10e9), p.TimeNanos)
+	assert.Equal(t, int64(10e9), p.DurationNanos)
+
+	sampleTypes := make([]string, 0, len(p.SampleType))
+	for _, st := range p.SampleType {
+		sampleTypes = append(sampleTypes, p.StringTable[st.Type]+"/"+p.StringTable[st.Unit])
+	}
+	assert.Equal(t, []string{"cpu-clock/nanoseconds", "cycles/count"}, sampleTypes)
+	assert.Equal(t, "cpu-clock", p.StringTable[p.PeriodType.Type])
+	assert.Equal(t, int64(250000), p.Period)
+
+	type sample struct {
+		stack  []string
+		labels map[string]string
+		values []int64
+	}
+	var samples []sample
+	for _, s := range p.Sample {
+		x := sample{labels: make(map[string]string), values: s.Value}
+		for _, id := range s.LocationId {
+			loc := p.Location[id-1]
+			fn := p.Function[loc.Line[0].FunctionId-1]
+			x.stack = append(x.stack, p.StringTable[fn.Name])
+		}
+		for _, l := range s.Label {
+			x.labels[p.StringTable[l.Key]] = p.StringTable[l.Str]
+		}
+		samples = append(samples, x)
+	}
+	assert.Equal(t, []sample{
+		{
+			stack:  []string{"do_syscall_64", "main"},
+			labels: map[string]string{"comm": "java", "pid": "12688", "tid": "12764"},
+			values: []int64{500000, 0},
+		},
+		{
+			stack:  []string{"main"},
+			labels: map[string]string{"comm": "java", "pid": "12688", "tid": "12765"},
+			values: []int64{0, 16},
+		},
+	}, samples)
+}
+
+func TestParseToPprof_ThreadID(t *testing.T) {
+	const script = "java 12764 [002] 6544038.708352: cpu-clock:\n" +
+		"                  27ae79 main+0x6a9 (/usr/bin/java)\n" +
+		"\n"
+	req, err := (&RawProfile{RawData: []byte(script)}).
+		ParseToPprof(context.Background(), ingestion.Metadata{LabelSet: labelset.New(nil)})
+	require.NoError(t, err)
+	require.Len(t, req.Series, 1)
+
+	p := req.Series[0].Samples[0].Profile.Profile
+	require.Len(t, p.Sample, 1)
+	labels := make(map[string]string)
+	for _, l := range p.Sample[0].Label {
+		labels[p.StringTable[l.Key]] = p.StringTable[l.Str]
+	}
+	assert.Equal(t, map[string]string{"comm": "java", "tid": "12764"}, labels)
+}
+
+func TestParseToPprof_Invalid(t *testing.T) {
+	_, err := (&RawProfile{RawData: []byte("java 12688 [002] 6544038.708352: cpu-clock:\nnot a frame\n\n")}).
+		ParseToPprof(context.Background(), ingestion.Metadata{LabelSet: labelset.New(nil)})
+	require.Error(t, err)
+}
+
+func TestEventName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"":                   "samples",
+		"cycles":             "cycles",
+		"cycles:u":           "cycles",
+		"cpu-clock:pppH":     "cpu-clock",
+		"sched:sched_switch": "sched:sched_switch",
+	} {
+		assert.Equal(t, expected, eventName([]byte(name)), name)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 var reEventStart = regexp.MustCompile("^(\\S.+?)\\s+(\\d+)/*(\\d+)*\\s+\\S.+")
 var errEventStartRegexMismatch = fmt.Errorf("reEventStart mismatch")
+var reEventName = regexp.MustCompile("\\d+\\.\\d+:\\s+(?:(\\d+)\\s+)?(\\S+):(?:\\s|$)")
 var reStackFrame = regexp.MustCompile("^\\s*(\\w+)\\s*(.+) \\((\\S*)\\)")
 var errStackFrameRegexMismatch = fmt.Errorf("reStackFrame mismatch")
 var sep = []byte{'\n'}
@@ -49,20 +50,59 @@ func (p *ScriptParser) ParseEvents() ([][][]byte, error) {
 	return stacks, nil
 }
 
+// Event is a perf event with its call chain.
+type Event struct {
+	Comm []byte
+	PID  int
+	TID  int
+	// Name of the event, e.g., cycles or cpu-clock. Empty, if the
+	// output does not include the event field.
+	Name []byte
+	// Period of the event. Zero, if the output does not
+	// include the period field.
+	Period int64
+	// Frames of the call chain, from the leaf to the root.
+	Frames []Frame
+}
+
+type Frame struct {
+	Addr   []byte
+	Sym    []byte
+	Module []byte
+}
+
 func (p *ScriptParser) ParseEvent() ([][]byte, error) {
+	e, err := p.NextEvent()
+	if err != nil {
+		return nil, err
+	}
+	stack := make([][]byte, 0, len(e.Frames)+1)
+	stack = append(stack, e.Comm)
+	for i := len(e.Frames) - 1; i >= 0; i-- {
+		stack = append(stack, e.Frames[i].Sym)
+	}
+	return stack, nil
+}
+
+// NextEvent parses the next event. io.EOF is returned when
+// there are no more events.
+func (p *ScriptParser) NextEvent() (*Event, error) {
 	line, err := p.nextLine()
 	if err != nil {
 		return nil, err
 	}
-	stack := make([][]byte, 0, 16)
-	comm, _, _, err := parseEventStart(line)
+	var e Event
+	e.Comm, e.PID, e.TID, err = parseEventStart(line)
 	if err != nil {
 		if len(line) == 0 && p.lineIndex >= len(p.lines) {
 			return nil, io.EOF
 		}
 		return nil, err
 	}
-	var sym []byte
+	if e.Name, e.Period, err = parseEventName(line); err != nil {
+		return nil, err
+	}
+	e.Frames = make([]Frame, 0, 16)
 	for {
 		line, err = p.nextLine()
 		if err != nil {
@@ -71,17 +111,14 @@ func (p *ScriptParser) ParseEvent() ([][]byte, error) {
 		if parseEventEnd(line) {
 			break
 		}
-		_, sym, _, err = parseStackFrame(line)
+		var f Frame
+		f.Addr, f.Sym, f.Module, err = parseStackFrame(line)
 		if err != nil {
 			return nil, err
 		}
-		stack = append(stack, sym)
-	}
-	stack = append(stack, comm)
-	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
-		stack[i], stack[j] = stack[j], stack[i]
+		e.Frames = append(e.Frames, f)
 	}
-	return stack, nil
+	return &e, nil
 }
 
 func IsPerfScript(buf []byte) bool {
@@ -114,6 +151,21 @@ func parseEventStart(line []byte) ([]byte, int, int, error) {
 	return comm, pid, tid, nil
 }
 
+func parseEventName(line []byte) ([]byte, int64, error) {
+	res := reEventName.FindSubmatch(line)
+	if res == nil {
+		return nil, 0, nil
+	}
+	var period int64
+	if res[1] != nil {
+		var err error
+		if period, err = strconv.ParseInt(string(res[1]), 10, 64); err != nil {
+			return nil, 0, err
+		}
+	}
+	return res[2], period, nil
+}
+
 func parseEventEnd(line []byte) bool {
 	return len(line) == 0
 }
@@ -0,0 +1,101 @@
+package speedscope
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/grafana/pyroscope/pkg/og/storage/tree"
+)
+
+const exporter = "pyroscope"
+
+// ExportTree converts the tree to a speedscope file with a single
+// sampled profile. The unit is a pprof sample unit, e.g. "nanoseconds".
+func ExportTree(t *tree.Tree, name, sampleUnit string) ([]byte, error) {
+	var frames []frame
+	frameIndex := make(map[string]int)
+	p := profile{
+		Type: profileSampled,
+		Name: name,
+		Unit: pprofUnit(sampleUnit),
+	}
+	t.IterateStacks(func(_ string, self uint64, stack []string) {
+		// The stack is ordered from the leaf to the root.
+		s := make(sample, 0, len(stack))
+		for i := len(stack) - 1; i >= 0; i-- {
+			idx, ok := frameIndex[stack[i]]
+			if !ok {
+				idx = len(frames)
+				frameIndex[stack[i]] = idx
+				frames = append(frames, frame{Name: stack[i]})
+			}
+			s = append(s, float64(idx))
+		}
+		p.Samples = append(p.Samples, s)
+		p.Weights = append(p.Weights, float64(self))
+		p.EndValue += float64(self)
+	})
+	return json.Marshal(speedscopeFile{
+		Schema:   schema,
+		Shared:   shared{Frames: frames},
+		Profiles: []profile{p},
+		Name:     name,
+		Exporter: exporter,
+	})
+}
+
+// TimedTree is a tree of the profiles collected at the given time.
+type TimedTree struct {
+	Time time.Time
+	Tree *tree.Tree
+}
+
+// ExportTimeline converts the trees to a speedscope file with a single
+// evented profile, which can be viewed in the time order mode. The trees
+// are placed one after another, each under a root frame named after its
+// timestamp; within a tree, frames are laid out as in a flame graph.
+func ExportTimeline(trees []TimedTree, name, sampleUnit string) ([]byte, error) {
+	var frames []frame
+	frameIndex := make(map[string]int)
+	frameOf := func(fn string) float64 {
+		idx, ok := frameIndex[fn]
+		if !ok {
+			idx = len(frames)
+			frameIndex[fn] = idx
+			frames = append(frames, frame{Name: fn})
+		}
+		return float64(idx)
+	}
+
+	p := profile{
+		Type:   profileEvented,
+		Name:   name,
+		Unit:   pprofUnit(sampleUnit),
+		Events: []event{},
+	}
+	var offset uint64
+	for _, tt := range trees {
+		total := tt.Tree.Samples()
+		if total == 0 {
+			continue
+		}
+		root := frameOf(tt.Time.UTC().Format(time.RFC3339))
+		p.Events = append(p.Events, event{Type: eventOpen, At: float64(offset), Frame: root})
+		tt.Tree.WalkFlameGraph(func(fn string, at uint64) {
+			p.Events = append(p.Events, event{Type: eventOpen, At: float64(offset + at), Frame: frameOf(fn)})
+		}, func(fn string, at uint64) {
+			p.Events = append(p.Events, event{Type: eventClose, At: float64(offset + at), Frame: frameOf(fn)})
+		})
+		offset += total
+		p.Events = append(p.Events, event{Type: eventClose, At: float64(offset), Frame: root})
+	}
+	p.EndValue = float64(offset)
+
+	return json.Marshal(speedscopeFile{
+		Schema:   schema,
+		Shared:   shared{Frames: frames},
+		Profiles: []profile{p},
+		Name:     name,
+		Exporter: exporter,
+	})
+}
@@ -3,6 +3,7 @@ package speedscope
 import (
 	"context"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -10,6 +11,7 @@ import (
 	"github.com/grafana/pyroscope/api/model/labelset"
 	"github.com/grafana/pyroscope/pkg/og/ingestion"
 	"github.com/grafana/pyroscope/pkg/og/storage/metadata"
+	"github.com/grafana/pyroscope/pkg/og/storage/tree"
 
 	"github.com/grafana/pyroscope/pkg/og/storage"
 )
@@ -75,4 +77,57 @@ a;b;d 400
 		Expect(input.Val.String()).To(Equal(expectedResult))
 		Expect(input.SampleRate).To(Equal(uint32(100)))
 	})
+
+	It("Can export a tree as a sample-format profile", func() {
+		t := tree.New()
+		t.Insert([]byte("a;b"), 500)
+		t.Insert([]byte("a;b;c"), 500)
+		t.Insert([]byte("a;b;d"), 400)
+
+		data, err := ExportTree(t, "foo", "bytes")
+		Expect(err).ToNot(HaveOccurred())
+
+		key, err := labelset.Parse("foo")
+		Expect(err).ToNot(HaveOccurred())
+
+		ingester := new(mockIngester)
+		md := ingestion.Metadata{LabelSet: key, SampleRate: 100}
+		err = (&RawProfile{RawData: data}).Parse(context.Background(), ingester, nil, md)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ingester.actual).To(HaveLen(1))
+		input := ingester.actual[0]
+		Expect(input.Units).To(Equal(metadata.BytesUnits))
+		Expect(input.Val.String()).To(Equal(t.String()))
+	})
+
+	It("Can export trees as an event-format profile", func() {
+		a := tree.New()
+		a.Insert([]byte("a;b"), 500)
+		a.Insert([]byte("a;b;c"), 500)
+		b := tree.New()
+		b.Insert([]byte("a;b;d"), 400)
+
+		data, err := ExportTimeline([]TimedTree{
+			{Time: time.Unix(10, 0), Tree: a},
+			{Time: time.Unix(20, 0), Tree: tree.New()},
+			{Time: time.Unix(30, 0), Tree: b},
+		}, "foo", "bytes")
+		Expect(err).ToNot(HaveOccurred())
+
+		key, err := labelset.Parse("foo")
+		Expect(err).ToNot(HaveOccurred())
+
+		ingester := new(mockIngester)
+		md := ingestion.Metadata{LabelSet: key, SampleRate: 100}
+		err = (&RawProfile{RawData: data}).Parse(context.Background(), ingester, nil, md)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ingester.actual).To(HaveLen(1))
+		expectedResult := `1970-01-01T00:00:10Z;a;b 500
+1970-01-01T00:00:10Z;a;b;c 500
+1970-01-01T00:00:30Z;a;b;d 400
+`
+		Expect(ingester.actual[0].Val.String()).To(Equal(expectedResult))
+	})
 })
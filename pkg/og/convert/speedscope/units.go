@@ -77,3 +77,21 @@ func (u unit) chooseKey(orig *labelset.LabelSet) *labelset.LabelSet {
 	result.Add("__name__", name)
 	return result
 }
+
+// pprofUnit maps a pprof sample unit to the speedscope value unit.
+func pprofUnit(u string) unit {
+	switch u {
+	case "nanoseconds":
+		return unitNanoseconds
+	case "microseconds":
+		return unitMicroseconds
+	case "milliseconds":
+		return unitMilliseconds
+	case "seconds":
+		return unitSeconds
+	case "bytes":
+		return unitBytes
+	default:
+		return unitNone
+	}
+}
@@ -32,6 +32,9 @@ const (
 	FormatLines      Format = "lines"
 	FormatGroups     Format = "groups"
 	FormatSpeedscope Format = "speedscope"
+	FormatPerfScript Format = "perf_script"
+	FormatCPUProfile Format = "cpuprofile"
+	FormatFirefox    Format = "firefox"
 )
 
 type RawProfile interface {
@@ -30,6 +30,33 @@ var _ = Describe("tree package", func() {
 		})
 	})
 
+	Context("WalkFlameGraph", func() {
+		tree := New()
+		tree.Insert([]byte("a;b"), uint64(1))
+		tree.Insert([]byte("a;c"), uint64(2))
+		tree.Insert([]byte("a"), uint64(3))
+		tree.Insert([]byte("d"), uint64(4))
+
+		It("visits nodes in flame graph order", func() {
+			var events []string
+			tree.WalkFlameGraph(func(name string, offset uint64) {
+				events = append(events, fmt.Sprintf("enter %s %d", name, offset))
+			}, func(name string, offset uint64) {
+				events = append(events, fmt.Sprintf("leave %s %d", name, offset))
+			})
+			Expect(events).To(Equal([]string{
+				"enter a 0",
+				"enter b 3",
+				"leave b 4",
+				"enter c 4",
+				"leave c 6",
+				"leave a 6",
+				"enter d 6",
+				"leave d 10",
+			}))
+		})
+	})
+
 	Context("Diff", func() {
 		a := New()
 		a.Insert([]byte("a;b;c"), uint64(100))
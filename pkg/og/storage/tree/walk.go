@@ -0,0 +1,26 @@
+package tree
+
+// WalkFlameGraph visits the tree nodes in the order they are laid out
+// in a flame graph: depth-first, with children ordered by name. A node
+// spans from its offset to offset+total; its self value is placed
+// before its children. The root node is not visited.
+func (t *Tree) WalkFlameGraph(enter, leave func(name string, offset uint64)) {
+	t.RLock()
+	defer t.RUnlock()
+	offset := t.root.Self
+	for _, n := range t.root.ChildrenNodes {
+		offset = walkFlameGraph(n, offset, enter, leave)
+	}
+}
+
+func walkFlameGraph(n *treeNode, offset uint64, enter, leave func(string, uint64)) uint64 {
+	name := string(n.Name)
+	enter(name, offset)
+	childOffset := offset + n.Self
+	for _, c := range n.ChildrenNodes {
+		childOffset = walkFlameGraph(c, childOffset, enter, leave)
+	}
+	end := offset + n.Total
+	leave(name, end)
+	return end
+}
@@ -14,6 +14,8 @@ import (
 	"unicode"
 
 	"github.com/grafana/pyroscope/pkg/og/agent/spy"
+	"github.com/grafana/pyroscope/pkg/og/convert/chrome"
+	"github.com/grafana/pyroscope/pkg/og/convert/firefox"
 	"github.com/grafana/pyroscope/pkg/og/convert/perf"
 	"github.com/grafana/pyroscope/pkg/og/convert/pprof"
 	"github.com/grafana/pyroscope/pkg/og/storage/metadata"
@@ -45,6 +47,8 @@ const (
 	ProfileFileTypePprof      ProfileFileType = "pprof"
 	ProfileFileTypeCollapsed  ProfileFileType = "collapsed"
 	ProfileFileTypePerfScript ProfileFileType = "perf_script"
+	ProfileFileTypeCPUProfile ProfileFileType = "cpuprofile"
+	ProfileFileTypeFirefox    ProfileFileType = "firefox"
 )
 
 type ConverterFn func(b []byte, name string, maxNodes int) ([]*flamebearer.FlamebearerProfile, error)
@@ -54,6 +58,8 @@ var formatConverters = map[ProfileFileType]ConverterFn{
 	ProfileFileTypePprof:      PprofToProfile,
 	ProfileFileTypeCollapsed:  CollapsedToProfile,
 	ProfileFileTypePerfScript: PerfScriptToProfile,
+	ProfileFileTypeCPUProfile: CPUProfileToProfile,
+	ProfileFileTypeFirefox:    FirefoxToProfile,
 }
 
 func FlamebearerFromFile(f ProfileFile, maxNodes int) ([]*flamebearer.FlamebearerProfile, error) {
@@ -107,10 +113,26 @@ func converterToFormat(f ConverterFn) ProfileFileType {
 		return ProfileFileTypeCollapsed
 	case reflect.ValueOf(PerfScriptToProfile).Pointer():
 		return ProfileFileTypePerfScript
+	case reflect.ValueOf(CPUProfileToProfile).Pointer():
+		return ProfileFileTypeCPUProfile
+	case reflect.ValueOf(FirefoxToProfile).Pointer():
+		return ProfileFileTypeFirefox
 	}
 	return "unknown"
 }
 
+// jsonConverter returns the converter for JSON profiles of other
+// profilers, or nil if the data is not recognized.
+func jsonConverter(data []byte) ConverterFn {
+	switch {
+	case chrome.IsCPUProfile(data):
+		return CPUProfileToProfile
+	case firefox.IsProfile(data):
+		return FirefoxToProfile
+	}
+	return nil
+}
+
 // TODO(kolesnikovae):
 //
 //	Consider simpler (but more reliable) logic for format identification
@@ -121,6 +143,12 @@ func converter(p ProfileFile) (ConverterFn, error) {
 		return f, nil
 	}
 	ext := strings.TrimPrefix(path.Ext(p.Name), ".")
+	if ext == string(ProfileFileTypeJSON) {
+		// Chrome and Firefox profiles are usually saved as .json files.
+		if f := jsonConverter(p.Data); f != nil {
+			return f, nil
+		}
+	}
 	if f, ok := formatConverters[ProfileFileType(ext)]; ok {
 		return f, nil
 	}
@@ -134,6 +162,9 @@ func converter(p ProfileFile) (ConverterFn, error) {
 		return nil, errors.New("profile is too short")
 	}
 	if p.Data[0] == '{' {
+		if f := jsonConverter(p.Data); f != nil {
+			return f, nil
+		}
 		return JSONToProfile, nil
 	}
 	if p.Data[0] == '\x1f' && p.Data[1] == '\x8b' {
@@ -192,6 +223,26 @@ func PprofToProfile(b []byte, name string, maxNodes int) ([]*flamebearer.Flamebe
 	if err := pprof.Decode(bytes.NewReader(b), p); err != nil {
 		return nil, fmt.Errorf("parsing pprof: %w", err)
 	}
+	return pprofToProfile(p, maxNodes)
+}
+
+func CPUProfileToProfile(b []byte, name string, maxNodes int) ([]*flamebearer.FlamebearerProfile, error) {
+	p, err := chrome.ToPprof(b)
+	if err != nil {
+		return nil, err
+	}
+	return pprofToProfile(p, maxNodes)
+}
+
+func FirefoxToProfile(b []byte, name string, maxNodes int) ([]*flamebearer.FlamebearerProfile, error) {
+	p, err := firefox.ToPprof(b)
+	if err != nil {
+		return nil, err
+	}
+	return pprofToProfile(p, maxNodes)
+}
+
+func pprofToProfile(p *profilev1.Profile, maxNodes int) ([]*flamebearer.FlamebearerProfile, error) {
 	fbs := make([]*flamebearer.FlamebearerProfile, 0)
 	for _, stype := range tree.SampleTypes(p) {
 		sampleRate := uint32(100)
@@ -604,6 +604,38 @@ var _ = Describe("Convert", func() {
 		Expect(b).ToNot(BeNil())
 	})
 
+	Describe("Chrome and Firefox profiles", func() {
+		It("detects Chrome CPU profiles", func() {
+			m := ProfileFile{
+				Data: readFile("../../../convert/chrome/testdata/profile.cpuprofile"),
+			}
+
+			f, err := converter(m)
+			Expect(err).To(BeNil())
+			Expect(reflect.ValueOf(f).Pointer()).To(Equal(reflect.ValueOf(CPUProfileToProfile).Pointer()))
+
+			b, err := f(m.Data, "appname", 1024)
+			Expect(err).To(BeNil())
+			Expect(b).ToNot(BeEmpty())
+		})
+
+		It("detects Firefox Profiler profiles", func() {
+			// The content takes precedence over the .json extension.
+			m := ProfileFile{
+				Name: "profile.json",
+				Data: readFile("../../../convert/firefox/testdata/profile.json"),
+			}
+
+			f, err := converter(m)
+			Expect(err).To(BeNil())
+			Expect(reflect.ValueOf(f).Pointer()).To(Equal(reflect.ValueOf(FirefoxToProfile).Pointer()))
+
+			b, err := f(m.Data, "appname", 1024)
+			Expect(err).To(BeNil())
+			Expect(b).ToNot(BeEmpty())
+		})
+	})
+
 	Describe("JSON", func() {
 		It("prunes tree", func() {
 			m := ProfileFile{
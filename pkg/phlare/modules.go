@@ -40,6 +40,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/compactor"
 	"github.com/grafana/pyroscope/pkg/distributor"
 	"github.com/grafana/pyroscope/pkg/embedded/grafana"
+	"github.com/grafana/pyroscope/pkg/experiment/metrics"
 	"github.com/grafana/pyroscope/pkg/experiment/query_backend"
 	"github.com/grafana/pyroscope/pkg/ingester"
 	objstoreclient "github.com/grafana/pyroscope/pkg/objstore/client"
@@ -50,6 +51,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/querier/worker"
 	"github.com/grafana/pyroscope/pkg/scheduler"
 	"github.com/grafana/pyroscope/pkg/settings"
+	recordingrulesclient "github.com/grafana/pyroscope/pkg/settings/recording/client"
 	"github.com/grafana/pyroscope/pkg/storegateway"
 	"github.com/grafana/pyroscope/pkg/usagestats"
 	"github.com/grafana/pyroscope/pkg/util"
@@ -406,6 +408,18 @@ func (f *Phlare) context() context.Context {
 func (f *Phlare) initIngester() (_ services.Service, err error) {
 	f.Cfg.Ingester.LifecyclerConfig.ListenPort = f.Cfg.Server.HTTPListenPort
 
+	var ruler metrics.Ruler
+	var exporter metrics.Exporter
+	if f.Cfg.Ingester.MetricsExporter.Enabled {
+		if ruler, err = f.ingesterRecordingRulesRuler(); err != nil {
+			return nil, err
+		}
+		exporter, err = metrics.NewExporter(f.Cfg.Ingester.MetricsExporter.RemoteWriteAddress, f.logger, f.reg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	svc, err := ingester.New(
 		f.context(),
 		f.Cfg.Ingester,
@@ -413,6 +427,9 @@ func (f *Phlare) initIngester() (_ services.Service, err error) {
 		f.storageBucket,
 		f.Overrides,
 		f.Cfg.Querier.QueryStoreAfter,
+		ruler,
+		exporter,
+		f.ingesterRing,
 	)
 	if err != nil {
 		return nil, err
@@ -424,6 +441,22 @@ func (f *Phlare) initIngester() (_ services.Service, err error) {
 	return svc, nil
 }
 
+// ingesterRecordingRulesRuler returns the ruler of the ingester metrics
+// exporter. Rules are fetched from the recording rules service, if its
+// address is configured; otherwise, static rules from overrides are used.
+func (f *Phlare) ingesterRecordingRulesRuler() (metrics.Ruler, error) {
+	address := f.Cfg.Ingester.MetricsExporter.RulesSource.ClientAddress
+	if address == "" {
+		return metrics.NewStaticRulerFromOverrides(f.Overrides), nil
+	}
+	// The client does not need to be started.
+	c, err := recordingrulesclient.NewClient(address, f.logger, f.auth)
+	if err != nil {
+		return nil, err
+	}
+	return metrics.NewCachedRemoteRuler(c, f.logger)
+}
+
 func (f *Phlare) initStoreGateway() (serv services.Service, err error) {
 	f.Cfg.StoreGateway.ShardingRing.Ring.ListenPort = f.Cfg.Server.HTTPListenPort
 	if f.storageBucket == nil {
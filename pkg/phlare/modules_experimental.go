@@ -278,6 +278,7 @@ func (f *Phlare) initMetastore() (services.Service, error) {
 		f.metastoreClient,
 		f.storageBucket,
 		f.placementManager,
+		f.Overrides,
 	)
 	if err != nil {
 		return nil, err
@@ -328,12 +329,17 @@ func (f *Phlare) initQueryBackend() (services.Service, error) {
 		return nil, err
 	}
 	logger := log.With(f.logger, "component", "query-backend")
+	bucket, err := phlareobj.NewCachingBucket(f.storageBucket, f.Cfg.QueryBackend.BucketCache,
+		prometheus.WrapRegistererWith(prometheus.Labels{"component": "query-backend"}, f.reg))
+	if err != nil {
+		return nil, err
+	}
 	b, err := querybackend.New(
 		f.Cfg.QueryBackend,
 		logger,
 		f.reg,
 		f.queryBackendClient,
-		querybackend.NewBlockReader(f.logger, f.storageBucket, f.reg),
+		querybackend.NewBlockReader(f.logger, bucket, f.reg),
 	)
 	if err != nil {
 		return nil, err
@@ -388,6 +394,7 @@ func (f *Phlare) initSymbolizer() (services.Service, error) {
 	}
 
 	f.symbolizer = sym
+	f.API.RegisterSymbolizer(sym)
 
 	return nil, nil
 }
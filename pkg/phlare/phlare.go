@@ -477,7 +477,7 @@ func (f *Phlare) setupModuleManager() error {
 		Querier:           {Overrides, API, MemberlistKV, IngesterRing, UsageReport, Version},
 		QueryFrontend:     {OverridesExporter, API, MemberlistKV, UsageReport, Version},
 		QueryScheduler:    {Overrides, API, MemberlistKV, UsageReport},
-		Ingester:          {Overrides, API, MemberlistKV, Storage, UsageReport, Version},
+		Ingester:          {Overrides, API, MemberlistKV, Storage, UsageReport, Version, IngesterRing},
 		StoreGateway:      {API, Storage, Overrides, MemberlistKV, UsageReport, Admin, Version},
 		Compactor:         {API, Storage, Overrides, MemberlistKV, UsageReport},
 		UsageReport:       {Storage, MemberlistKV},
@@ -505,7 +505,7 @@ func (f *Phlare) setupModuleManager() error {
 			SegmentWriterClient: {Overrides, API, SegmentWriterRing, PlacementAgent},
 			PlacementAgent:      {Overrides, API, Storage},
 			PlacementManager:    {Overrides, API, Storage},
-			Symbolizer:          {Overrides, Storage},
+			Symbolizer:          {Overrides, API, Storage},
 		}
 		for k, v := range experimentalModules {
 			deps[k] = v
@@ -17,6 +17,7 @@ import (
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/multierror"
 	"github.com/grafana/dskit/runutil"
 	"github.com/oklog/ulid"
@@ -585,13 +586,14 @@ type Querier interface {
 
 	MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64) (*phlaremodel.Tree, error)
 	MergeBySpans(ctx context.Context, rows iter.Iterator[Profile], spans phlaremodel.SpanSelector) (*phlaremodel.Tree, error)
-	MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], s *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error)
+	MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], s *typesv1.StackTraceSelector, exemplars bool, by ...string) ([]*typesv1.Series, error)
 	MergePprof(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, s *typesv1.StackTraceSelector) (*profilev1.Profile, error)
+	MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], granularity typesv1.FunctionGranularity) ([]*typesv1.TopFunction, error)
 	Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error)
 
 	SelectMatchingProfiles(ctx context.Context, params *ingestv1.SelectProfilesRequest) (iter.Iterator[Profile], error)
 	SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (*phlaremodel.Tree, error)
-	SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, s *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error)
+	SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, s *typesv1.StackTraceSelector, exemplars bool, by ...string) ([]*typesv1.Series, error)
 	SelectMergeBySpans(ctx context.Context, params *ingestv1.SelectSpanProfileRequest) (*phlaremodel.Tree, error)
 	SelectMergePprof(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, s *typesv1.StackTraceSelector) (*profilev1.Profile, error)
 
@@ -624,6 +626,8 @@ type ReadAPI interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[ingestv1.MergeProfilesLabelsRequest, ingestv1.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[ingestv1.MergeProfilesPprofRequest, ingestv1.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[ingestv1.MergeSpanProfileRequest, ingestv1.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error
+	SelectProfileTrees(context.Context, *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error
 }
 
 var _ ReadAPI = make(Queriers, 0)
@@ -730,6 +734,14 @@ func (queriers Queriers) MergeSpanProfile(ctx context.Context, stream *connect.B
 	return MergeSpanProfile(ctx, stream, queriers.ForTimeRange)
 }
 
+func (queriers Queriers) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return MergeProfilesTopFunctions(ctx, stream, queriers.ForTimeRange)
+}
+
+func (queriers Queriers) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	return SelectProfileTrees(ctx, stream, queriers.ForTimeRange)
+}
+
 type BlockGetter func(ctx context.Context, start, end model.Time, hints *ingestv1.Hints) (Queriers, error)
 
 func (queriers Queriers) ForTimeRange(_ context.Context, start, end model.Time, hints *ingestv1.Hints) (Queriers, error) {
@@ -1124,7 +1136,7 @@ func MergeProfilesLabels(ctx context.Context, stream *connect.BidiStream[ingestv
 		for _, querier := range queriers {
 			querier := querier
 			g.Go(util.RecoverPanic(func() error {
-				merge, err := querier.SelectMergeByLabels(ctx, request, r.StackTraceSelector, by...)
+				merge, err := querier.SelectMergeByLabels(ctx, request, r.StackTraceSelector, r.IncludeExemplars, by...)
 				if err != nil {
 					return err
 				}
@@ -1166,6 +1178,7 @@ func MergeProfilesLabels(ctx context.Context, stream *connect.BidiStream[ingestv
 				merge, err := querier.MergeByLabels(ctx,
 					iter.NewSliceIterator(querier.Sort(selectedProfiles[i])),
 					r.StackTraceSelector,
+					r.IncludeExemplars,
 					by...)
 				if err != nil {
 					return err
@@ -1231,6 +1244,12 @@ func MergeProfilesPprof(ctx context.Context, stream *connect.BidiStream[ingestv1
 		deduplicationNeeded = request.Hints.Block.Deduplication
 	}
 
+	// Profiles can only be selected by ID in the raw profile
+	// table, which is only read if the profiles are deduplicated.
+	if request.ProfileId != "" {
+		deduplicationNeeded = true
+	}
+
 	var result pprof.ProfileMerge
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -1325,6 +1344,245 @@ func MergeProfilesPprof(ctx context.Context, stream *connect.BidiStream[ingestv1
 	return nil
 }
 
+// MergeProfilesTopFunctions computes self and total values of the functions
+// of the selected profiles. The values are accumulated per block while stack
+// traces are resolved, and the call tree is never built.
+func MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse], blockGetter BlockGetter) error {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeProfilesTopFunctions")
+	defer sp.Finish()
+
+	r, err := stream.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	if r.Request == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("missing initial select request"))
+	}
+
+	request := r.Request
+	sp.SetTag("start", model.Time(request.Start).Time().String()).
+		SetTag("end", model.Time(request.End).Time().String()).
+		SetTag("selector", request.LabelSelector).
+		SetTag("profile_type", request.Type.ID).
+		SetTag("granularity", r.Granularity.String())
+	sp.LogFields(otlog.Object("hints", request.Hints))
+
+	queriers, err := blockGetter(ctx, model.Time(request.Start), model.Time(request.End), request.Hints)
+	if err != nil {
+		return err
+	}
+
+	deduplicationNeeded := true
+	if request.Hints != nil && request.Hints.Block != nil {
+		deduplicationNeeded = request.Hints.Block.Deduplication
+	}
+
+	result := phlaremodel.NewTopFunctionsMerger()
+	g, ctx := errgroup.WithContext(ctx)
+
+	// depending on if new need deduplication or not there are two different code paths.
+	if !deduplicationNeeded {
+		// signal the end of the profile streaming by sending an empty response.
+		sp.LogFields(otlog.String("msg", "no profile streaming as no deduplication needed"))
+		if err = stream.Send(&ingestv1.MergeProfilesTopFunctionsResponse{}); err != nil {
+			return err
+		}
+
+		// in this path we can just merge the profiles from each block and send the result to the client.
+		for _, querier := range queriers {
+			querier := querier
+			g.Go(util.RecoverPanic(func() error {
+				profiles, err := querier.SelectMatchingProfiles(ctx, request)
+				if err != nil {
+					return err
+				}
+				functions, err := querier.MergeTopFunctions(ctx, profiles, r.Granularity)
+				if err != nil {
+					return err
+				}
+				result.MergeTopFunctions(functions)
+				return nil
+			}))
+		}
+	} else {
+		// in this path we have to go thorugh every profile and deduplicate them.
+		iters, err := SelectMatchingProfiles(ctx, request, queriers)
+		if err != nil {
+			return err
+		}
+
+		// send batches of profiles to client and filter via bidi stream.
+		selectedProfiles, err := filterProfiles[
+			BidiServerMerge[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest],
+			*ingestv1.MergeProfilesTopFunctionsResponse,
+			*ingestv1.MergeProfilesTopFunctionsRequest](ctx, iters, defaultBatchSize, stream)
+		if err != nil {
+			return err
+		}
+
+		for i, querier := range queriers {
+			querier := querier
+			i := i
+			if len(selectedProfiles[i]) == 0 {
+				continue
+			}
+			// Sort profiles for better read locality.
+			// Merge async the result so we can continue streaming profiles.
+			g.Go(util.RecoverPanic(func() error {
+				functions, err := querier.MergeTopFunctions(ctx,
+					iter.NewSliceIterator(querier.Sort(selectedProfiles[i])),
+					r.Granularity)
+				if err != nil {
+					return err
+				}
+				result.MergeTopFunctions(functions)
+				return nil
+			}))
+		}
+
+		// Signals the end of the profile streaming by sending an empty response.
+		// This allows the client to not block other streaming ingesters.
+		sp.LogFields(otlog.String("msg", "signaling the end of the profile streaming"))
+		if err = stream.Send(&ingestv1.MergeProfilesTopFunctionsResponse{}); err != nil {
+			return err
+		}
+	}
+
+	if err = g.Wait(); err != nil {
+		return err
+	}
+
+	// sends the final result to the client.
+	functions := result.TopFunctions()
+	sp.LogFields(
+		otlog.String("msg", "sending the final result to the client"),
+		otlog.Int("functions", len(functions)),
+	)
+	err = stream.Send(&ingestv1.MergeProfilesTopFunctionsResponse{
+		Result: &ingestv1.MergeProfilesTopFunctionsResult{
+			Functions: functions,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SelectProfileTrees builds the call tree of every selected profile. Unlike
+// the merge methods, the profiles are always streamed to the client, which
+// checks the series they belong to, and the trees are not truncated.
+func SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse], blockGetter BlockGetter) error {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfileTrees")
+	defer sp.Finish()
+
+	r, err := stream.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	if r.Request == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("missing initial select request"))
+	}
+
+	request := r.Request
+	sp.SetTag("start", model.Time(request.Start).Time().String()).
+		SetTag("end", model.Time(request.End).Time().String()).
+		SetTag("selector", request.LabelSelector).
+		SetTag("profile_type", request.Type.ID)
+	sp.LogFields(otlog.Object("hints", request.Hints))
+
+	queriers, err := blockGetter(ctx, model.Time(request.Start), model.Time(request.End), request.Hints)
+	if err != nil {
+		return err
+	}
+
+	iters, err := SelectMatchingProfiles(ctx, request, queriers)
+	if err != nil {
+		return err
+	}
+
+	// send batches of profiles to client and filter via bidi stream.
+	selectedProfiles, err := filterProfiles[
+		BidiServerMerge[*ingestv1.SelectProfileTreesResponse, *ingestv1.SelectProfileTreesRequest],
+		*ingestv1.SelectProfileTreesResponse,
+		*ingestv1.SelectProfileTreesRequest](ctx, iters, defaultBatchSize, stream)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lock   sync.Mutex
+		result []*ingestv1.ProfileTree
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	for i, querier := range queriers {
+		querier := querier
+		i := i
+		if len(selectedProfiles[i]) == 0 {
+			continue
+		}
+		// Build the trees async so we can continue streaming profiles.
+		g.Go(util.RecoverPanic(func() error {
+			for _, p := range querier.Sort(selectedProfiles[i]) {
+				tree, err := querier.MergeByStacktraces(ctx, iter.NewSliceIterator([]Profile{p}), 0)
+				if err != nil {
+					return err
+				}
+				profileTree := &ingestv1.ProfileTree{
+					Timestamp: int64(p.Timestamp()),
+					Tree:      tree.Bytes(0),
+				}
+				lock.Lock()
+				result = append(result, profileTree)
+				lock.Unlock()
+			}
+			return nil
+		}))
+	}
+
+	// Signals the end of the profile streaming by sending an empty response.
+	// This allows the client to not block other streaming ingesters.
+	sp.LogFields(otlog.String("msg", "signaling the end of the profile streaming"))
+	if err = stream.Send(&ingestv1.SelectProfileTreesResponse{}); err != nil {
+		return err
+	}
+
+	if err = g.Wait(); err != nil {
+		return err
+	}
+
+	// sends the final result to the client.
+	sp.LogFields(
+		otlog.String("msg", "sending the final result to the client"),
+		otlog.Int("profiles", len(result)),
+	)
+	err = stream.Send(&ingestv1.SelectProfileTreesResponse{
+		Result: &ingestv1.SelectProfileTreesResult{
+			Profiles: result,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	return nil
+}
+
 func ProfileTypes(ctx context.Context, req *connect.Request[ingestv1.ProfileTypesRequest], blockGetter BlockGetter) (*connect.Response[ingestv1.ProfileTypesResponse], error) {
 	queriers, err := blockGetter(ctx, model.Time(req.Msg.Start), model.Time(req.Msg.End), nil)
 	if err != nil {
@@ -1524,6 +1782,24 @@ type labelsInfo struct {
 	lbs phlaremodel.Labels
 }
 
+func parseProfileID(profileID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(profileID)
+	if err != nil {
+		return id, status.Error(codes.InvalidArgument, "failed to parse profile ID: "+err.Error())
+	}
+	return id, nil
+}
+
+// profileIDPredicate returns a predicate that selects
+// the profile with the given ID in the ID column.
+func profileIDPredicate(profileID string) (query.Predicate, error) {
+	id, err := parseProfileID(profileID)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewStringInPredicate([]string{string(id[:])}), nil
+}
+
 func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params *ingestv1.SelectProfilesRequest) (iter.Iterator[Profile], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMatchingProfiles - Block")
 	defer sp.Finish()
@@ -1581,6 +1857,13 @@ func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params
 		profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), "SeriesIndex"),
 		profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(model.Time(params.Start).UnixNano(), model.Time(params.End).UnixNano()), "TimeNanos"),
 	)
+	if params.ProfileId != "" {
+		predicate, err := profileIDPredicate(params.ProfileId)
+		if err != nil {
+			return nil, err
+		}
+		pIt = query.NewBinaryJoinIterator(0, pIt, profiles.columnIter(ctx, schemav1.IDColumnName, predicate, ""))
+	}
 
 	if b.meta.Version >= 2 {
 		pIt = query.NewBinaryJoinIterator(
@@ -1629,6 +1912,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	ctx context.Context,
 	params *ingestv1.SelectProfilesRequest,
 	sts *typesv1.StackTraceSelector,
+	exemplars bool,
 	by ...string,
 ) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByLabels - Block")
@@ -1662,7 +1946,14 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	)
 	// get all relevant labels/fingerprints
 	for postings.Next() {
-		fp, err := b.index.SeriesBy(postings.At(), &lbls, &chks, by...)
+		var fp uint64
+		if exemplars {
+			// Exemplars refer to the profile series, therefore
+			// the series fingerprint and all labels are needed.
+			fp, err = b.index.Series(postings.At(), &lbls, &chks)
+		} else {
+			fp, err = b.index.SeriesBy(postings.At(), &lbls, &chks, by...)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1692,7 +1983,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 		}
 		rows := profileBatchIteratorBySeriesIndex(it, lblsPerRef)
 		defer rows.Close()
-		return mergeByLabels[Profile](ctx, profiles.file, columnName, rows, by...)
+		return mergeByLabels[Profile](ctx, profiles.file, columnName, rows, exemplars, by...)
 	}
 
 	if b.meta.Version < 2 {
@@ -1707,7 +1998,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	rows := profileBatchIteratorBySeriesIndex(it, lblsPerRef)
 	defer rows.Close()
 
-	return mergeByLabelsWithStackTraceSelector[Profile](ctx, profiles.file, rows, r, by...)
+	return mergeByLabelsWithStackTraceSelector[Profile](ctx, profiles.file, rows, r, exemplars, by...)
 }
 
 func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (tree *phlaremodel.Tree, err error) {
@@ -2173,7 +2464,8 @@ func (b *singleBlockQuerier) downsampleResolutions() []time.Duration {
 
 func downsampleAggregation(v typesv1.TimeSeriesAggregationType) string {
 	switch v {
-	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM:
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+		typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE:
 		return "sum"
 	}
 	return ""
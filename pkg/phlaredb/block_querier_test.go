@@ -1214,7 +1214,7 @@ func TestSelectMergeLabels(t *testing.T) {
 		},
 		Start: 0,
 		End:   int64(model.TimeFromUnixNano(math.MaxInt64)),
-	}, nil, "job")
+	}, nil, false, "job")
 	require.NoError(t, err)
 	expected := []*typesv1.Series{
 		{
@@ -1271,7 +1271,7 @@ func TestSelectMergeLabels_StackTraceSelector(t *testing.T) {
 			{Name: "foo"},
 			{Name: "bar"},
 		},
-	}, "job")
+	}, false, "job")
 	require.NoError(t, err)
 	expected := []*typesv1.Series{
 		{
@@ -73,7 +73,7 @@ func TestCompact(t *testing.T) {
 	}
 	it, err := querier.SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	series, err := querier.MergeByLabels(ctx, it, nil, "job")
+	series, err := querier.MergeByLabels(ctx, it, nil, false, "job")
 	require.NoError(t, err)
 	require.Equal(t, []*typesv1.Series{
 		{
@@ -154,7 +154,7 @@ func TestCompactWithDownsampling(t *testing.T) {
 	}
 	it, err := querier.SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	series, err := querier.MergeByLabels(ctx, it, nil, "job")
+	series, err := querier.MergeByLabels(ctx, it, nil, false, "job")
 	require.NoError(t, err)
 	require.Equal(t, []*typesv1.Series{
 		{
@@ -281,7 +281,7 @@ func TestCompactWithSplitting(t *testing.T) {
 	// Then we query 2 different shards and verify we have a subset of series.
 	it, err = queriers[0].SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	seriesResult, err := queriers[0].MergeByLabels(context.Background(), it, nil, "job")
+	seriesResult, err := queriers[0].MergeByLabels(context.Background(), it, nil, false, "job")
 	require.NoError(t, err)
 	require.Equal(t,
 		[]*typesv1.Series{
@@ -293,7 +293,7 @@ func TestCompactWithSplitting(t *testing.T) {
 
 	it, err = queriers[1].SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	seriesResult, err = queriers[1].MergeByLabels(context.Background(), it, nil, "job")
+	seriesResult, err = queriers[1].MergeByLabels(context.Background(), it, nil, false, "job")
 	require.NoError(t, err)
 	require.Equal(t,
 		[]*typesv1.Series{
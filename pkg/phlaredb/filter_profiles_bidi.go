@@ -41,14 +41,18 @@ type filterRequest interface {
 	*ingestv1.MergeProfilesStacktracesRequest |
 		*ingestv1.MergeProfilesLabelsRequest |
 		*ingestv1.MergeProfilesPprofRequest |
-		*ingestv1.MergeSpanProfileRequest
+		*ingestv1.MergeSpanProfileRequest |
+		*ingestv1.MergeProfilesTopFunctionsRequest |
+		*ingestv1.SelectProfileTreesRequest
 }
 
 type filterResponse interface {
 	*ingestv1.MergeProfilesStacktracesResponse |
 		*ingestv1.MergeProfilesLabelsResponse |
 		*ingestv1.MergeProfilesPprofResponse |
-		*ingestv1.MergeSpanProfileResponse
+		*ingestv1.MergeSpanProfileResponse |
+		*ingestv1.MergeProfilesTopFunctionsResponse |
+		*ingestv1.SelectProfileTreesResponse
 }
 
 func rewriteEOFError(err error) error {
@@ -125,6 +129,14 @@ func filterProfiles[B BidiServerMerge[Res, Req], Res filterResponse, Req filterR
 			err = s.Send(&ingestv1.MergeSpanProfileResponse{
 				SelectedProfiles: selectProfileResult,
 			})
+		case BidiServerMerge[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest]:
+			err = s.Send(&ingestv1.MergeProfilesTopFunctionsResponse{
+				SelectedProfiles: selectProfileResult,
+			})
+		case BidiServerMerge[*ingestv1.SelectProfileTreesResponse, *ingestv1.SelectProfileTreesRequest]:
+			err = s.Send(&ingestv1.SelectProfileTreesResponse{
+				SelectedProfiles: selectProfileResult,
+			})
 		}
 		// read a batch of profiles and sends it.
 
@@ -162,6 +174,18 @@ func filterProfiles[B BidiServerMerge[Res, Req], Res filterResponse, Req filterR
 				return rewriteEOFError(err)
 			}
 			selected = selectionResponse.Profiles
+		case BidiServerMerge[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest]:
+			selectionResponse, err := s.Receive()
+			if err != nil {
+				return rewriteEOFError(err)
+			}
+			selected = selectionResponse.Profiles
+		case BidiServerMerge[*ingestv1.SelectProfileTreesResponse, *ingestv1.SelectProfileTreesRequest]:
+			selectionResponse, err := s.Receive()
+			if err != nil {
+				return rewriteEOFError(err)
+			}
+			selected = selectionResponse.Profiles
 		}
 		sp.LogFields(otlog.String("msg", "selection received"))
 		for i, k := range selected {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -71,9 +72,14 @@ type Head struct {
 	totalSamples  *atomic.Uint64
 	tables        []Table
 	delta         *deltaProfiles
+	wal           *wal
 
 	limiter   TenantLimiter
 	updatedAt *atomic.Time
+
+	// Series that must not be observed when the block is flushed.
+	// Protected by metaLock.
+	unobserved map[model.Fingerprint]struct{}
 }
 
 const (
@@ -113,6 +119,12 @@ func NewHead(phlarectx context.Context, cfg Config, limiter TenantLimiter) (*Hea
 	if err != nil {
 		return nil, err
 	}
+	if cfg.WALEnabled {
+		h.wal, err = openWAL(filepath.Join(h.headPath, pathWAL), int64(cfg.WALSegmentSize), h.metrics)
+		if err != nil {
+			return nil, errors.Wrap(err, "opening wal")
+		}
+	}
 
 	// create profile store
 	h.profiles = newProfileStore(phlarectx)
@@ -194,12 +206,19 @@ func (h *Head) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, a
 		return nil
 	}
 
+	record := walRecord{ID: id, Profile: p, Annotations: annotations, Labels: externalLabels}
+	// Internal labels are removed in place: the record
+	// and the caller must keep the original labels.
+	externalLabels = slices.Clone(externalLabels)
 	delta := phlaremodel.Labels(externalLabels).Get(phlaremodel.LabelNameDelta) != "false"
 	externalLabels = phlaremodel.Labels(externalLabels).Delete(phlaremodel.LabelNameDelta)
 
 	otel := phlaremodel.Labels(externalLabels).Get(phlaremodel.LabelNameOTEL) == "true"
 	externalLabels = phlaremodel.Labels(externalLabels).Delete(phlaremodel.LabelNameOTEL)
 
+	observe := phlaremodel.Labels(externalLabels).Get(phlaremodel.LabelNameObserve) != "false"
+	externalLabels = phlaremodel.Labels(externalLabels).Delete(phlaremodel.LabelNameObserve)
+
 	enforceLabelOrder := phlaremodel.Labels(externalLabels).Get(phlaremodel.LabelNameOrder) == phlaremodel.LabelOrderEnforced
 	externalLabels = phlaremodel.Labels(externalLabels).Delete(phlaremodel.LabelNameOrder)
 
@@ -217,6 +236,15 @@ func (h *Head) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, a
 		}
 	}
 
+	// The profile is recorded before it's written to the head: the
+	// symbols are rewritten in place, and the delta profiles state
+	// must be restored on replay, even if nothing is ingested.
+	if h.wal != nil {
+		if err := h.wal.append(&record); err != nil {
+			return errors.Wrap(err, "appending to wal")
+		}
+	}
+
 	var profileIngested bool
 	for idxType, profile := range h.symdb.WriteProfileSymbols(partition, p) {
 		profile.ID = id
@@ -263,6 +291,16 @@ func (h *Head) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, a
 	if v > h.meta.MaxTime {
 		h.meta.MaxTime = v
 	}
+	for _, fp := range seriesFingerprints {
+		if !observe {
+			if h.unobserved == nil {
+				h.unobserved = make(map[model.Fingerprint]struct{})
+			}
+			h.unobserved[fp] = struct{}{}
+		} else if h.unobserved != nil {
+			delete(h.unobserved, fp)
+		}
+	}
 	h.metaLock.Unlock()
 
 	h.updatedAt.Store(time.Now())
@@ -557,6 +595,13 @@ func (h *Head) flush(ctx context.Context) error {
 	// It must be guaranteed that no new inserts will happen
 	// after the call start.
 	h.inFlightProfiles.Wait()
+	if h.wal != nil {
+		// The log is only removed once the head is moved
+		// to local blocks, and is replayed otherwise.
+		if err := h.wal.close(); err != nil {
+			return errors.Wrap(err, "closing wal")
+		}
+	}
 	if h.profiles.index.totalProfiles.Load() == 0 {
 		level.Info(h.logger).Log("msg", "head empty - no block written")
 		return os.RemoveAll(h.headPath)
@@ -652,6 +697,12 @@ func (h *Head) Move() error {
 		return err
 	}
 
+	if h.wal != nil {
+		if err := h.wal.remove(); err != nil {
+			return err
+		}
+	}
+
 	// move block to the local directory
 	if err := os.MkdirAll(filepath.Dir(h.localPath), defaultFolderMode); err != nil {
 		return err
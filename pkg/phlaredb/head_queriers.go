@@ -6,6 +6,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/parquet-go/parquet-go"
 	"github.com/pkg/errors"
@@ -63,6 +64,13 @@ func (q *headOnDiskQuerier) SelectMatchingProfiles(ctx context.Context, params *
 		),
 		q.rowGroup().columnIter(ctx, "StacktracePartition", nil, "StacktracePartition"),
 	)
+	if params.ProfileId != "" {
+		predicate, err := profileIDPredicate(params.ProfileId)
+		if err != nil {
+			return nil, err
+		}
+		pIt = query.NewBinaryJoinIterator(0, pIt, q.rowGroup().columnIter(ctx, schemav1.IDColumnName, predicate, ""))
+	}
 	defer pIt.Close()
 
 	var (
@@ -269,19 +277,19 @@ func (q *headOnDiskQuerier) MergePprof(ctx context.Context, rows iter.Iterator[P
 	return r.Pprof()
 }
 
-func (q *headOnDiskQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
+func (q *headOnDiskQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, exemplars bool, by ...string) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByLabels - HeadOnDisk")
 	defer sp.Finish()
 	if len(sts.GetCallSite()) == 0 {
-		return mergeByLabels(ctx, q.rowGroup(), "TotalValue", rows, by...)
+		return mergeByLabels(ctx, q.rowGroup(), "TotalValue", rows, exemplars, by...)
 	}
 	r := symdb.NewResolver(ctx, q.head.symdb,
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
-	return mergeByLabelsWithStackTraceSelector(ctx, q.rowGroup(), rows, r, by...)
+	return mergeByLabelsWithStackTraceSelector(ctx, q.rowGroup(), rows, r, exemplars, by...)
 }
 
-func (q *headOnDiskQuerier) SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
+func (q *headOnDiskQuerier) SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, sts *typesv1.StackTraceSelector, exemplars bool, by ...string) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByLabels - HeadOnDisk")
 	defer sp.Finish()
 
@@ -305,7 +313,7 @@ func (q *headOnDiskQuerier) SelectMergeByLabels(ctx context.Context, params *ing
 	if len(sts.GetCallSite()) == 0 {
 		rows := profileBatchIteratorByFingerprints(it, labelsPerFP)
 		defer rows.Close()
-		return mergeByLabels[Profile](ctx, q.rowGroup(), "TotalValue", rows, by...)
+		return mergeByLabels[Profile](ctx, q.rowGroup(), "TotalValue", rows, exemplars, by...)
 	}
 
 	r := symdb.NewResolver(ctx, q.head.symdb,
@@ -316,7 +324,7 @@ func (q *headOnDiskQuerier) SelectMergeByLabels(ctx context.Context, params *ing
 	rows := profileBatchIteratorByFingerprints(it, labelsPerFP)
 	defer rows.Close()
 
-	return mergeByLabelsWithStackTraceSelector[Profile](ctx, q.rowGroup(), rows, r, by...)
+	return mergeByLabelsWithStackTraceSelector[Profile](ctx, q.rowGroup(), rows, r, exemplars, by...)
 }
 
 func (q *headOnDiskQuerier) Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
@@ -335,6 +343,17 @@ func (q *headOnDiskQuerier) MergeBySpans(ctx context.Context, rows iter.Iterator
 	return r.Tree()
 }
 
+func (q *headOnDiskQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], granularity typesv1.FunctionGranularity) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - HeadOnDisk")
+	defer sp.Finish()
+	r := symdb.NewResolver(ctx, q.head.symdb)
+	defer r.Release()
+	if err := mergeByStacktraces(ctx, q.rowGroup(), rows, r); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(granularity)
+}
+
 func (q *headOnDiskQuerier) Sort(in []Profile) []Profile {
 	var rowI, rowJ int64
 	sort.Slice(in, func(i, j int) bool {
@@ -372,6 +391,13 @@ func (q *headInMemoryQuerier) SelectMatchingProfiles(ctx context.Context, params
 		return nil, err
 	}
 
+	var id uuid.UUID
+	if params.ProfileId != "" {
+		if id, err = parseProfileID(params.ProfileId); err != nil {
+			return nil, err
+		}
+	}
+
 	// get time nano information for profiles
 	var (
 		start = model.Time(params.Start)
@@ -388,8 +414,17 @@ func (q *headInMemoryQuerier) SelectMatchingProfiles(ctx context.Context, params
 			continue
 		}
 
-		profiles := make([]*schemav1.InMemoryProfile, len(profileSeries.profiles))
-		copy(profiles, profileSeries.profiles)
+		var profiles []*schemav1.InMemoryProfile
+		if params.ProfileId != "" {
+			for _, p := range profileSeries.profiles {
+				if p.ID == id {
+					profiles = append(profiles, p)
+				}
+			}
+		} else {
+			profiles = make([]*schemav1.InMemoryProfile, len(profileSeries.profiles))
+			copy(profiles, profileSeries.profiles)
+		}
 
 		iters = append(iters,
 			NewSeriesIterator(
@@ -593,6 +628,7 @@ func (q *headInMemoryQuerier) MergeByLabels(
 	ctx context.Context,
 	rows iter.Iterator[Profile],
 	sts *typesv1.StackTraceSelector,
+	exemplars bool,
 	by ...string,
 ) ([]*typesv1.Series, error) {
 	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeByLabels - HeadInMemory")
@@ -605,7 +641,7 @@ func (q *headInMemoryQuerier) MergeByLabels(
 			if !ok {
 				return nil, errors.New("expected ProfileWithLabels")
 			}
-			seriesBuilder.Add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), float64(p.Total()), p.Annotations())
+			seriesBuilder.Add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), float64(p.Total()), p.Annotations(), exemplarProfileID(exemplars, p.profile))
 		}
 	} else {
 		r := symdb.NewResolver(ctx, q.head.symdb,
@@ -620,7 +656,7 @@ func (q *headInMemoryQuerier) MergeByLabels(
 			if err := r.CallSiteValues(&v, p.StacktracePartition(), p.Samples()); err != nil {
 				return nil, err
 			}
-			seriesBuilder.Add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), float64(v.Total), p.Annotations())
+			seriesBuilder.Add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), float64(v.Total), p.Annotations(), exemplarProfileID(exemplars, p.profile))
 		}
 	}
 
@@ -635,6 +671,7 @@ func (q *headInMemoryQuerier) SelectMergeByLabels(
 	ctx context.Context,
 	params *ingestv1.SelectProfilesRequest,
 	sts *typesv1.StackTraceSelector,
+	exemplars bool,
 	by ...string,
 ) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByLabels - HeadInMemory")
@@ -670,7 +707,7 @@ func (q *headInMemoryQuerier) SelectMergeByLabels(
 				if p.Timestamp() > end {
 					break
 				}
-				seriesBuilder.Add(fp, profileSeries.lbs, int64(p.Timestamp()), float64(p.Total()), p.Annotations)
+				seriesBuilder.Add(fp, profileSeries.lbs, int64(p.Timestamp()), float64(p.Total()), p.Annotations, exemplarProfileID(exemplars, p))
 			}
 		}
 	} else {
@@ -693,7 +730,7 @@ func (q *headInMemoryQuerier) SelectMergeByLabels(
 				if err = r.CallSiteValues(&v, p.StacktracePartition, p.Samples); err != nil {
 					return nil, err
 				}
-				seriesBuilder.Add(fp, profileSeries.lbs, int64(p.Timestamp()), float64(v.Total), p.Annotations)
+				seriesBuilder.Add(fp, profileSeries.lbs, int64(p.Timestamp()), float64(v.Total), p.Annotations, exemplarProfileID(exemplars, p))
 			}
 		}
 	}
@@ -729,6 +766,32 @@ func (q *headInMemoryQuerier) MergeBySpans(ctx context.Context, rows iter.Iterat
 	return r.Tree()
 }
 
+func (q *headInMemoryQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], granularity typesv1.FunctionGranularity) ([]*typesv1.TopFunction, error) {
+	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - HeadInMemory")
+	defer sp.Finish()
+	r := symdb.NewResolver(ctx, q.head.symdb)
+	defer r.Release()
+	for rows.Next() {
+		p, ok := rows.At().(ProfileWithLabels)
+		if !ok {
+			return nil, errors.New("expected ProfileWithLabels")
+		}
+		r.AddSamples(p.StacktracePartition(), p.Samples())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(granularity)
+}
+
 func (q *headInMemoryQuerier) Sort(in []Profile) []Profile {
 	return in
 }
+
+// exemplarProfileID returns the profile ID, if exemplars are requested.
+func exemplarProfileID(exemplars bool, p *schemav1.InMemoryProfile) string {
+	if !exemplars {
+		return ""
+	}
+	return p.ID.String()
+}
@@ -318,6 +318,96 @@ func TestHead_SelectMatchingProfiles_Order(t *testing.T) {
 	}
 }
 
+func TestHead_SelectMatchingProfiles_ProfileID(t *testing.T) {
+	ctx := testContext(t)
+	const n = 15
+	head, err := NewHead(ctx, Config{
+		DataPath: t.TempDir(),
+		Parquet: &ParquetConfig{
+			MaxBufferRowCount: n - 1,
+		},
+	}, NoLimit)
+	require.NoError(t, err)
+
+	c := make(chan struct{})
+	var closeOnce sync.Once
+	head.profiles.onFlush = func() {
+		closeOnce.Do(func() {
+			close(c)
+		})
+	}
+
+	now := time.Now()
+	ids := make([]uuid.UUID, n)
+	for i := 0; i < n; i++ {
+		x := newProfileFoo()
+		x.TimeNanos = now.Add(time.Second * time.Duration(i)).UnixNano()
+		ids[i] = uuid.New()
+		require.NoError(t, head.Ingest(ctx, x, ids[i], nil, []*typesv1.LabelPair{
+			{Name: "job", Value: "foo"},
+			{Name: "x", Value: strconv.Itoa(i)},
+		}...))
+	}
+
+	<-c
+	q := head.Queriers()
+	assert.Equal(t, 2, len(q)) // on-disk and in-memory parts.
+
+	typ, err := phlaremodel.ParseProfileTypeSelector(":type:unit:type:unit")
+	require.NoError(t, err)
+	// The first profile is flushed to disk, the last one is in memory.
+	for _, i := range []int{0, n - 1} {
+		req := &ingestv1.SelectProfilesRequest{
+			LabelSelector: "{}",
+			Type:          typ,
+			End:           now.Add(time.Hour).UnixMilli(),
+			ProfileId:     ids[i].String(),
+		}
+		profiles := make([]Profile, 0, 1)
+		for _, b := range q {
+			it, err := b.SelectMatchingProfiles(ctx, req)
+			require.NoError(t, err)
+			s, err := iter.Slice(it)
+			require.NoError(t, err)
+			profiles = append(profiles, s...)
+		}
+		require.Len(t, profiles, 1)
+		assert.Equal(t, strconv.Itoa(i), profiles[0].Labels().Get("x"))
+	}
+
+	for _, b := range q {
+		_, err = b.SelectMatchingProfiles(ctx, &ingestv1.SelectProfilesRequest{
+			LabelSelector: "{}",
+			Type:          typ,
+			End:           now.Add(time.Hour).UnixMilli(),
+			ProfileId:     "invalid",
+		})
+		require.Error(t, err)
+	}
+
+	// Every point references the profile it was built from.
+	expected := make([]string, n)
+	for i := range ids {
+		expected[i] = ids[i].String()
+	}
+	exemplars := make([]string, 0, n)
+	for _, b := range q {
+		series, err := b.SelectMergeByLabels(ctx, &ingestv1.SelectProfilesRequest{
+			LabelSelector: "{}",
+			Type:          typ,
+			End:           now.Add(time.Hour).UnixMilli(),
+		}, nil, true, "job")
+		require.NoError(t, err)
+		for _, s := range series {
+			for _, p := range s.Points {
+				require.Len(t, p.Exemplars, 1)
+				exemplars = append(exemplars, p.Exemplars[0].ProfileId)
+			}
+		}
+	}
+	assert.ElementsMatch(t, expected, exemplars)
+}
+
 func TestHeadFlush(t *testing.T) {
 	profilePaths := []string{
 		"testdata/heap",
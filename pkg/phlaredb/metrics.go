@@ -42,6 +42,12 @@ type headMetrics struct {
 	flushedBlocksReasons        *prometheus.CounterVec
 	writtenProfileSegments      *prometheus.CounterVec
 	writtenProfileSegmentsBytes prometheus.Histogram
+
+	walWrittenRecords        prometheus.Counter
+	walWrittenBytes          prometheus.Counter
+	walReplayedRecords       prometheus.Counter
+	walCorruptedRecords      prometheus.Counter
+	walReplayDurationSeconds prometheus.Histogram
 }
 
 func newHeadMetrics(reg prometheus.Registerer) *headMetrics {
@@ -154,6 +160,28 @@ func newHeadMetricsWithPrefix(reg prometheus.Registerer, prefix string) *headMet
 			Name: prefix + "_head_samples",
 			Help: "Number of samples in the head.",
 		}),
+		walWrittenRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: prefix + "_head_wal_records_written_total",
+			Help: "Total number of records written to the head write-ahead log.",
+		}),
+		walWrittenBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: prefix + "_head_wal_written_bytes_total",
+			Help: "Total number of bytes written to the head write-ahead log.",
+		}),
+		walReplayedRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: prefix + "_head_wal_records_replayed_total",
+			Help: "Total number of records replayed from the head write-ahead log.",
+		}),
+		walCorruptedRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: prefix + "_head_wal_corrupted_records_total",
+			Help: "Total number of corrupted or torn records found in the head write-ahead log on replay.",
+		}),
+		walReplayDurationSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: prefix + "_head_wal_replay_duration_seconds",
+			Help: "Time to replay the head write-ahead log in seconds.",
+			// [1s, 2s, 4s, 8s, 16s, 32s, 64s, 128s, 256s, 512s]
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}),
 	}
 
 	m.register(reg)
@@ -183,6 +211,11 @@ func (m *headMetrics) register(reg prometheus.Registerer) {
 	m.flushedBlocksReasons = util.RegisterOrGet(reg, m.flushedBlocksReasons)
 	m.writtenProfileSegments = util.RegisterOrGet(reg, m.writtenProfileSegments)
 	m.writtenProfileSegmentsBytes = util.RegisterOrGet(reg, m.writtenProfileSegmentsBytes)
+	m.walWrittenRecords = util.RegisterOrGet(reg, m.walWrittenRecords)
+	m.walWrittenBytes = util.RegisterOrGet(reg, m.walWrittenBytes)
+	m.walReplayedRecords = util.RegisterOrGet(reg, m.walReplayedRecords)
+	m.walCorruptedRecords = util.RegisterOrGet(reg, m.walCorruptedRecords)
+	m.walReplayDurationSeconds = util.RegisterOrGet(reg, m.walReplayDurationSeconds)
 }
 
 func ContextWithHeadMetrics(ctx context.Context, reg prometheus.Registerer, prefix string) context.Context {
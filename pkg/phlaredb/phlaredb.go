@@ -60,6 +60,11 @@ type Config struct {
 	MinDiskAvailablePercentage float64       `yaml:"min_disk_available_percentage"`
 	EnforcementInterval        time.Duration `yaml:"enforcement_interval"`
 	DisableEnforcement         bool          `yaml:"disable_enforcement"`
+
+	// WAL records the profiles appended to the heads, so that they can be
+	// recovered, if the process terminates before the heads are flushed.
+	WALEnabled     bool   `yaml:"wal_enabled"`
+	WALSegmentSize uint64 `yaml:"wal_segment_size_bytes"`
 }
 
 type ParquetConfig struct {
@@ -77,6 +82,8 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	f.Float64Var(&cfg.MinDiskAvailablePercentage, "pyroscopedb.retention-policy-min-disk-available-percentage", DefaultMinDiskAvailablePercentage, "Which percentage of free disk space to keep")
 	f.DurationVar(&cfg.EnforcementInterval, "pyroscopedb.retention-policy-enforcement-interval", DefaultRetentionPolicyEnforcementInterval, "How often to enforce disk retention")
 	f.BoolVar(&cfg.DisableEnforcement, "pyroscopedb.retention-policy-disable", false, "Disable retention policy enforcement")
+	f.BoolVar(&cfg.WALEnabled, "pyroscopedb.wal-enabled", false, "Record ingested profiles in a write-ahead log, and replay it on startup to recover the heads that were not flushed.")
+	f.Uint64Var(&cfg.WALSegmentSize, "pyroscopedb.wal-segment-size-bytes", DefaultWALSegmentSize, "Size of a write-ahead log segment in bytes, after which a new segment is created.")
 }
 
 type TenantLimiter interface {
@@ -112,9 +119,13 @@ type PhlareDB struct {
 	blockQuerier *BlockQuerier
 	limiter      TenantLimiter
 	evictCh      chan *blockEviction
+
+	sampleObserver SampleObserverFactory
+	observeLock    sync.Mutex
+	observeWg      sync.WaitGroup
 }
 
-func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlareobj.Bucket) (*PhlareDB, error) {
+func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlareobj.Bucket, options ...Option) (*PhlareDB, error) {
 	reg := phlarecontext.Registry(phlarectx)
 	f := &PhlareDB{
 		cfg:     cfg,
@@ -125,6 +136,9 @@ func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlare
 		limiter: limiter,
 		heads:   make(map[int64]*Head),
 	}
+	for _, option := range options {
+		option(f)
+	}
 
 	if err := os.MkdirAll(f.LocalDataPath(), 0o777); err != nil {
 		return nil, fmt.Errorf("mkdir %s: %w", f.LocalDataPath(), err)
@@ -143,6 +157,7 @@ func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlare
 	if err := f.blockQuerier.Sync(ctx); err != nil {
 		return nil, err
 	}
+	f.replayWAL(ctx)
 	return f, nil
 }
 
@@ -265,6 +280,8 @@ func (f *PhlareDB) Flush(ctx context.Context, force bool, reason string) (err er
 	}
 	f.flushing = nil
 	f.headLock.Unlock()
+
+	f.observeHeads(successful)
 	return err
 }
 
@@ -295,6 +312,7 @@ func (f *PhlareDB) evictBlock(e *blockEviction) {
 func (f *PhlareDB) Close() error {
 	close(f.stopCh)
 	f.wg.Wait()
+	f.observeWg.Wait()
 	errs := multierror.New()
 	for _, h := range f.heads {
 		errs.Add(h.Flush(f.phlarectx))
@@ -473,6 +491,20 @@ func (f *PhlareDB) MergeSpanProfile(ctx context.Context, stream *connect.BidiStr
 	return f.queriers().MergeSpanProfile(ctx, stream)
 }
 
+func (f *PhlareDB) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+
+	return f.queriers().MergeProfilesTopFunctions(ctx, stream)
+}
+
+func (f *PhlareDB) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+
+	return f.queriers().SelectProfileTrees(ctx, stream)
+}
+
 type blockEviction struct {
 	blockID ulid.ULID
 	err     error
@@ -1,10 +1,12 @@
 package phlaredb
 
 import (
+	"cmp"
 	"context"
 	"math"
 	"net/http"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -24,6 +26,9 @@ import (
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	connectapi "github.com/grafana/pyroscope/pkg/api/connect"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 	"github.com/grafana/pyroscope/pkg/testhelper"
 )
 
@@ -458,6 +463,183 @@ func TestMergeProfilesPprof(t *testing.T) {
 	})
 }
 
+func TestSelectProfileTrees(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	var (
+		ctx     = testContext(t)
+		testDir = contextDataDir(ctx)
+		end     = time.Unix(0, int64(time.Hour))
+		start   = end.Add(-time.Minute)
+		step    = 15 * time.Second
+	)
+
+	db, err := New(ctx, Config{
+		DataPath:         testDir,
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "namespace", Value: "my-namespace"},
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+
+	requireProfileTrees := func(client ingesterv1connect.IngesterServiceClient) {
+		bidi := client.SelectProfileTrees(ctx)
+		require.NoError(t, bidi.Send(&ingestv1.SelectProfileTreesRequest{
+			Request: &ingestv1.SelectProfilesRequest{
+				LabelSelector: `{pod="my-pod"}`,
+				Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+				Start:         start.UnixMilli(),
+				End:           end.UnixMilli(),
+			},
+		}))
+
+		resp, err := bidi.Receive()
+		require.NoError(t, err)
+		require.Nil(t, resp.Result)
+		require.Len(t, resp.SelectedProfiles.Fingerprints, 1)
+		require.Len(t, resp.SelectedProfiles.Profiles, 5)
+		selected := resp.SelectedProfiles.Profiles
+
+		require.NoError(t, bidi.Send(&ingestv1.SelectProfileTreesRequest{
+			Profiles: []bool{true, false, true, false, false},
+		}))
+
+		// expect empty resp to signal it is finished
+		resp, err = bidi.Receive()
+		require.NoError(t, err)
+		require.Nil(t, resp.Result)
+
+		// received result: a tree per kept profile.
+		resp, err = bidi.Receive()
+		require.NoError(t, err)
+		require.NotNil(t, resp.Result)
+		require.Len(t, resp.Result.Profiles, 2)
+		slices.SortFunc(resp.Result.Profiles, func(a, b *ingestv1.ProfileTree) int {
+			return cmp.Compare(a.Timestamp, b.Timestamp)
+		})
+		for i, p := range resp.Result.Profiles {
+			require.Equal(t, selected[2*i].Timestamp, p.Timestamp)
+			tree, err := phlaremodel.UnmarshalTree(p.Tree)
+			require.NoError(t, err)
+			require.Equal(t, int64(500000000), tree.Total())
+		}
+	}
+
+	client, cleanup := db.queriers().ingesterClient()
+	defer cleanup()
+	requireProfileTrees(client)
+
+	// The trees are the same once the head is flushed to a block.
+	require.NoError(t, db.Flush(ctx, true, ""))
+	blockClient, blockCleanup := db.queriers().ingesterClient()
+	defer blockCleanup()
+	requireProfileTrees(blockClient)
+}
+
+func TestMergeProfilesTopFunctions(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	var (
+		ctx     = testContext(t)
+		testDir = contextDataDir(ctx)
+		end     = time.Unix(0, int64(time.Hour))
+		start   = end.Add(-time.Minute)
+		step    = 15 * time.Second
+	)
+
+	db, err := New(ctx, Config{
+		DataPath:         testDir,
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "namespace", Value: "my-namespace"},
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+
+	client, cleanup := db.queriers().ingesterClient()
+	defer cleanup()
+
+	request := &ingestv1.SelectProfilesRequest{
+		LabelSelector: `{pod="my-pod"}`,
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         start.UnixMilli(),
+		End:           end.UnixMilli(),
+	}
+
+	// The function values must match the ones of the merged profile.
+	pprofBidi := client.MergeProfilesPprof(ctx)
+	require.NoError(t, pprofBidi.Send(&ingestv1.MergeProfilesPprofRequest{Request: request}))
+	resp, err := pprofBidi.Receive()
+	require.NoError(t, err)
+	require.Len(t, resp.SelectedProfiles.Profiles, 5)
+	require.NoError(t, pprofBidi.Send(&ingestv1.MergeProfilesPprofRequest{
+		Profiles: []bool{true, true, true, true, true},
+	}))
+	_, err = pprofBidi.Receive()
+	require.NoError(t, err)
+	resp, err = pprofBidi.Receive()
+	require.NoError(t, err)
+	var merged googlev1.Profile
+	require.NoError(t, merged.UnmarshalVT(resp.Result))
+
+	requireTopFunctions := func(client ingesterv1connect.IngesterServiceClient) {
+		for _, granularity := range []typesv1.FunctionGranularity{
+			typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FUNCTION,
+			typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE,
+		} {
+			bidi := client.MergeProfilesTopFunctions(ctx)
+			require.NoError(t, bidi.Send(&ingestv1.MergeProfilesTopFunctionsRequest{
+				Request:     request,
+				Granularity: granularity,
+			}))
+
+			resp, err := bidi.Receive()
+			require.NoError(t, err)
+			require.Nil(t, resp.Result)
+			require.Len(t, resp.SelectedProfiles.Profiles, 5)
+
+			require.NoError(t, bidi.Send(&ingestv1.MergeProfilesTopFunctionsRequest{
+				Profiles: []bool{true, true, true, true, true},
+			}))
+
+			// expect empty resp to signal it is finished
+			resp, err = bidi.Receive()
+			require.NoError(t, err)
+			require.Nil(t, resp.Result)
+
+			// received result
+			resp, err = bidi.Receive()
+			require.NoError(t, err)
+			require.NotNil(t, resp.Result)
+			require.NotEmpty(t, resp.Result.Functions)
+			m := phlaremodel.NewTopFunctionsMerger()
+			m.MergeTopFunctions(phlaremodel.NewTopFunctionsFromProfile(&merged, granularity))
+			expected := m.Top(0, false, 0)
+			m = phlaremodel.NewTopFunctionsMerger()
+			m.MergeTopFunctions(resp.Result.Functions)
+			testhelper.EqualProto(t, expected, m.Top(0, false, 0))
+		}
+	}
+	requireTopFunctions(client)
+
+	// The values are the same once the head is flushed to a block.
+	require.NoError(t, db.Flush(ctx, true, ""))
+	blockClient, blockCleanup := db.queriers().ingesterClient()
+	defer blockCleanup()
+	requireTopFunctions(blockClient)
+}
+
 func Test_QueryNotInitializedHead(t *testing.T) {
 	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
 
@@ -647,3 +829,65 @@ func Test_getProfileStatsFromMetas(t *testing.T) {
 		})
 	}
 }
+
+type testSampleObserver struct {
+	t        *testing.T
+	profiles map[string]int
+	total    map[string]int64
+	closed   bool
+}
+
+func (o *testSampleObserver) ObserveProfile(symbols symdb.SymbolsReader, _ model.Fingerprint, lbls phlaremodel.Labels, row schemav1.ProfileRow) {
+	p, err := symbols.Partition(context.Background(), row.StacktracePartitionID())
+	require.NoError(o.t, err)
+	p.Release()
+	o.profiles[lbls.Get("pod")]++
+	o.total[lbls.Get(phlaremodel.LabelNameProfileType)] += row.TotalValue()
+}
+
+func (o *testSampleObserver) Close() { o.closed = true }
+
+func Test_SampleObserver(t *testing.T) {
+	var (
+		ctx     = testContext(t)
+		testDir = contextDataDir(ctx)
+		end     = time.Unix(0, int64(time.Hour))
+		start   = end.Add(-time.Minute)
+		step    = 15 * time.Second
+	)
+
+	var observers []*testSampleObserver
+	db, err := New(ctx, Config{
+		DataPath:         testDir,
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient, WithSampleObserver(func(*block.Meta) SampleObserver {
+		o := &testSampleObserver{
+			t:        t,
+			profiles: make(map[string]int),
+			total:    make(map[string]int64),
+		}
+		observers = append(observers, o)
+		return o
+	}))
+	require.NoError(t, err)
+
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "pod", Value: "another-pod"},
+	)
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "pod", Value: "unobserved-pod"},
+		&typesv1.LabelPair{Name: phlaremodel.LabelNameObserve, Value: "false"},
+	)
+	require.NoError(t, db.Flush(context.Background(), true, ""))
+	// Blocks are observed in the background.
+	require.NoError(t, db.Close())
+
+	require.Len(t, observers, 1)
+	o := observers[0]
+	assert.True(t, o.closed)
+	assert.Equal(t, map[string]int{"my-pod": 10, "another-pod": 10}, o.profiles)
+	assert.Equal(t, int64(5000000000), o.total["process_cpu:cpu:nanoseconds:cpu:nanoseconds"])
+}
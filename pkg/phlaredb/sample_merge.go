@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/runutil"
 	"github.com/opentracing/opentracing-go"
 	"github.com/parquet-go/parquet-go"
@@ -59,7 +60,7 @@ func (b *singleBlockQuerier) MergePprof(ctx context.Context, rows iter.Iterator[
 	return r.Pprof()
 }
 
-func (b *singleBlockQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
+func (b *singleBlockQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, exemplars bool, by ...string) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByLabels - Block")
 	defer sp.Finish()
 	sp.SetTag("block ULID", b.meta.ULID.String())
@@ -76,12 +77,12 @@ func (b *singleBlockQuerier) MergeByLabels(ctx context.Context, rows iter.Iterat
 		if b.meta.Version == 1 {
 			columnName = "Samples.list.element.Value"
 		}
-		return mergeByLabels(ctx, b.profileSourceTable().file, columnName, rows, by...)
+		return mergeByLabels(ctx, b.profileSourceTable().file, columnName, rows, exemplars, by...)
 	}
 	r := symdb.NewResolver(ctx, b.symbols,
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
-	return mergeByLabelsWithStackTraceSelector(ctx, b.profileSourceTable().file, rows, r, by...)
+	return mergeByLabelsWithStackTraceSelector(ctx, b.profileSourceTable().file, rows, r, exemplars, by...)
 }
 
 func (b *singleBlockQuerier) MergeBySpans(ctx context.Context, rows iter.Iterator[Profile], spanSelector phlaremodel.SpanSelector) (*phlaremodel.Tree, error) {
@@ -104,6 +105,28 @@ func (b *singleBlockQuerier) MergeBySpans(ctx context.Context, rows iter.Iterato
 	return r.Tree()
 }
 
+// MergeTopFunctions computes self and total values of the functions of the
+// profiles. Unlike MergeByStacktraces, it does not build the call tree.
+func (b *singleBlockQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], granularity typesv1.FunctionGranularity) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - Block")
+	defer sp.Finish()
+	sp.SetTag("block ULID", b.meta.ULID.String())
+
+	if err := b.Open(ctx); err != nil {
+		return nil, err
+	}
+	b.queries.Add(1)
+	defer b.queries.Done()
+
+	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
+	r := symdb.NewResolver(ctx, b.symbols)
+	defer r.Release()
+	if err := mergeByStacktraces(ctx, b.profileSourceTable().file, rows, r); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(granularity)
+}
+
 type Source interface {
 	Schema() *parquet.Schema
 	RowGroups() []parquet.RowGroup
@@ -163,6 +186,7 @@ func mergeByLabels[T Profile](
 	profileSource Source,
 	columnName string,
 	rows iter.Iterator[T],
+	exemplars bool,
 	by ...string,
 ) ([]*typesv1.Series, error) {
 	column, err := v1.ResolveColumnByPath(profileSource.Schema(), strings.Split(columnName, "."))
@@ -174,13 +198,26 @@ func mergeByLabels[T Profile](
 	annotationKeysColumn, _ := v1.ResolveColumnByPath(profileSource.Schema(), v1.AnnotationKeyColumnPath)
 	annotationValuesColumn, _ := v1.ResolveColumnByPath(profileSource.Schema(), v1.AnnotationValueColumnPath)
 
+	columns := []int{
+		column.ColumnIndex,
+		annotationKeysColumn.ColumnIndex,
+		annotationValuesColumn.ColumnIndex,
+	}
+	idColumn := -1
+	if exemplars {
+		c, err := v1.ResolveColumnByPath(profileSource.Schema(), []string{v1.IDColumnName})
+		if err != nil {
+			return nil, err
+		}
+		idColumn = c.ColumnIndex
+		columns = append(columns, idColumn)
+	}
+
 	profiles := query.NewRepeatedRowIterator(
 		ctx,
 		rows,
 		profileSource.RowGroups(),
-		column.ColumnIndex,
-		annotationKeysColumn.ColumnIndex,
-		annotationValuesColumn.ColumnIndex,
+		columns...,
 	)
 	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
 
@@ -190,6 +227,7 @@ func mergeByLabels[T Profile](
 		values := profiles.At()
 		p := values.Row
 		var total int64
+		var id string
 		annotations := v1.Annotations{
 			Keys:   make([]string, 0),
 			Values: make([]string, 0),
@@ -201,6 +239,8 @@ func mergeByLabels[T Profile](
 				annotations.Keys = append(annotations.Keys, e[0].String())
 			} else if e[0].Column() == annotationValuesColumn.ColumnIndex && e[0].Kind() == parquet.ByteArray {
 				annotations.Values = append(annotations.Values, e[0].String())
+			} else if e[0].Column() == idColumn {
+				id = profileID(e[0])
 			}
 		}
 		seriesBuilder.Add(
@@ -209,6 +249,7 @@ func mergeByLabels[T Profile](
 			int64(p.Timestamp()),
 			float64(total),
 			annotations,
+			id,
 		)
 	}
 	return seriesBuilder.Build(), profiles.Err()
@@ -219,16 +260,25 @@ func mergeByLabelsWithStackTraceSelector[T Profile](
 	profileSource Source,
 	rows iter.Iterator[T],
 	r *symdb.Resolver,
+	exemplars bool,
 	by ...string,
 ) (s []*typesv1.Series, err error) {
 	var columns v1.SampleColumns
 	if err = columns.Resolve(profileSource.Schema()); err != nil {
 		return nil, err
 	}
-	profiles := query.NewRepeatedRowIterator(ctx, rows, profileSource.RowGroups(),
+	columnIndices := []int{
 		columns.StacktraceID.ColumnIndex,
 		columns.Value.ColumnIndex,
-	)
+	}
+	if exemplars {
+		c, err := v1.ResolveColumnByPath(profileSource.Schema(), []string{v1.IDColumnName})
+		if err != nil {
+			return nil, err
+		}
+		columnIndices = append(columnIndices, c.ColumnIndex)
+	}
+	profiles := query.NewRepeatedRowIterator(ctx, rows, profileSource.RowGroups(), columnIndices...)
 
 	seriesBuilder := phlaremodel.TimeSeriesBuilder{}
 	seriesBuilder.Init(by...)
@@ -241,9 +291,22 @@ func mergeByLabelsWithStackTraceSelector[T Profile](
 		if err = r.CallSiteValuesParquet(&v, h.StacktracePartition(), row.Values[0], row.Values[1]); err != nil {
 			return nil, err
 		}
+		var id string
+		if exemplars {
+			id = profileID(row.Values[2][0])
+		}
 		// TODO aleks-p: add annotation support?
-		seriesBuilder.Add(h.Fingerprint(), h.Labels(), int64(h.Timestamp()), float64(v.Total), v1.Annotations{})
+		seriesBuilder.Add(h.Fingerprint(), h.Labels(), int64(h.Timestamp()), float64(v.Total), v1.Annotations{}, id)
 	}
 
 	return seriesBuilder.Build(), profiles.Err()
 }
+
+// profileID returns the string representation of the profile ID column value.
+func profileID(v parquet.Value) string {
+	id, err := uuid.FromBytes(v.ByteArray())
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
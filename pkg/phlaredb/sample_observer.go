@@ -0,0 +1,109 @@
+package phlaredb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/common/model"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+// SampleObserver observes profiles of a block flushed from the head,
+// e.g., to evaluate recording rules.
+type SampleObserver interface {
+	// ObserveProfile is called for every profile of the block, ordered
+	// by series. Stack traces of the profile refer to the block symbols.
+	// This method must not modify the row.
+	ObserveProfile(symbols symdb.SymbolsReader, fp model.Fingerprint, lbls phlaremodel.Labels, row schemav1.ProfileRow)
+	// Close is called once all the profiles of the block are observed.
+	Close()
+}
+
+// SampleObserverFactory creates a SampleObserver for a flushed block.
+// If nil is returned, the block is not observed.
+type SampleObserverFactory func(meta *block.Meta) SampleObserver
+
+type Option func(*PhlareDB)
+
+// WithSampleObserver registers a sample observer factory: every
+// block flushed from the head is read and observed in the background,
+// once it is available for querying. Series ingested with the
+// __observe__="false" label are not observed.
+func WithSampleObserver(factory SampleObserverFactory) Option {
+	return func(f *PhlareDB) {
+		f.sampleObserver = factory
+	}
+}
+
+// observeHeads observes the blocks of the flushed heads asynchronously,
+// so that the flush is not delayed. Blocks are observed one at a time.
+func (f *PhlareDB) observeHeads(heads []*Head) {
+	if f.sampleObserver == nil || len(heads) == 0 {
+		return
+	}
+	f.observeWg.Add(1)
+	go func() {
+		defer f.observeWg.Done()
+		f.observeLock.Lock()
+		defer f.observeLock.Unlock()
+		for _, h := range heads {
+			f.observeHead(context.Background(), h)
+		}
+	}()
+}
+
+func (f *PhlareDB) observeHead(ctx context.Context, h *Head) {
+	observer := f.sampleObserver(h.meta)
+	if observer == nil {
+		return
+	}
+	defer observer.Close()
+	h.metaLock.RLock()
+	unobserved := h.unobserved
+	h.metaLock.RUnlock()
+	if err := f.observeBlock(ctx, h.meta, observer, unobserved); err != nil {
+		level.Error(f.logger).Log("msg", "failed to observe block samples", "block", h.meta.ULID, "err", err)
+	}
+}
+
+func (f *PhlareDB) observeBlock(
+	ctx context.Context,
+	meta *block.Meta,
+	observer SampleObserver,
+	unobserved map[model.Fingerprint]struct{},
+) (err error) {
+	// The block is opened separately from the block querier,
+	// as the latter may close the block, e.g., on eviction.
+	q := NewSingleBlockQuerierFromMeta(f.phlarectx, f.blockQuerier.bkt, meta)
+	if err = q.Open(ctx); err != nil {
+		return fmt.Errorf("opening block: %w", err)
+	}
+	defer func() {
+		if closeErr := q.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	rows, err := newProfileRowIterator(q)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	symbols := q.Symbols()
+	for rows.Next() {
+		r := rows.At()
+		if _, skip := unobserved[r.fp]; skip {
+			continue
+		}
+		observer.ObserveProfile(symbols, r.fp, r.labels, r.row)
+	}
+	return rows.Err()
+}
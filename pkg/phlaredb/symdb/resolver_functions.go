@@ -0,0 +1,124 @@
+package symdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/model"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+// TopFunctions returns self and total values of the functions of the
+// stack traces added to the resolver. Unlike Tree, the method does not
+// build the call tree: values are accumulated for each function while
+// stack traces are resolved. The functions are not ordered.
+func (r *Resolver) TopFunctions(granularity typesv1.FunctionGranularity) ([]*typesv1.TopFunction, error) {
+	span, ctx := opentracing.StartSpanFromContext(r.ctx, "Resolver.TopFunctions")
+	defer span.Finish()
+	m := model.NewTopFunctionsMerger()
+	err := r.withSymbols(ctx, func(symbols *Symbols, appender *SampleAppender) error {
+		resolved, err := symbols.TopFunctions(ctx, appender, granularity)
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(resolved)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.TopFunctions(), nil
+}
+
+func (r *Symbols) TopFunctions(
+	ctx context.Context,
+	appender *SampleAppender,
+	granularity typesv1.FunctionGranularity,
+) ([]*typesv1.TopFunction, error) {
+	samples := appender.Samples()
+	f := functionsSymbolsFromPool()
+	defer f.reset()
+	f.init(r, samples, granularity)
+	if err := r.Stacktraces.ResolveStacktraceLocations(ctx, f, samples.StacktraceIDs); err != nil {
+		return nil, err
+	}
+	return f.topFunctions(), nil
+}
+
+// functionKey identifies a function in the partition string table.
+type functionKey struct {
+	name     uint32
+	filename uint32
+	line     int32
+}
+
+type functionsSymbols struct {
+	symbols     *Symbols
+	samples     *schemav1.Samples
+	granularity typesv1.FunctionGranularity
+	values      *model.FunctionValues[functionKey]
+	stack       []functionKey
+	cur         int
+}
+
+var functionsSymbolsPool = sync.Pool{
+	New: func() any { return new(functionsSymbols) },
+}
+
+func functionsSymbolsFromPool() *functionsSymbols {
+	return functionsSymbolsPool.Get().(*functionsSymbols)
+}
+
+func (r *functionsSymbols) reset() {
+	r.symbols = nil
+	r.samples = nil
+	r.values = nil
+	r.stack = r.stack[:0]
+	r.cur = 0
+	functionsSymbolsPool.Put(r)
+}
+
+func (r *functionsSymbols) init(symbols *Symbols, samples schemav1.Samples, granularity typesv1.FunctionGranularity) {
+	r.symbols = symbols
+	r.samples = &samples
+	r.granularity = granularity
+	r.values = model.NewFunctionValues[functionKey]()
+}
+
+func (r *functionsSymbols) InsertStacktrace(_ uint32, locations []int32) {
+	r.stack = r.stack[:0]
+	for i := 0; i < len(locations); i++ {
+		lines := r.symbols.Locations[locations[i]].Line
+		for j := 0; j < len(lines); j++ {
+			f := r.symbols.Functions[lines[j].FunctionId]
+			k := functionKey{name: f.Name}
+			switch r.granularity {
+			case typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE:
+				k.line = lines[j].Line
+				fallthrough
+			case typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FILE:
+				k.filename = f.Filename
+			}
+			r.stack = append(r.stack, k)
+		}
+	}
+	r.values.Add(r.stack, int64(r.samples.Values[r.cur]))
+	r.cur++
+}
+
+func (r *functionsSymbols) topFunctions() []*typesv1.TopFunction {
+	top := make([]*typesv1.TopFunction, 0, r.values.Len())
+	r.values.Range(func(k functionKey, self, total int64) {
+		top = append(top, &typesv1.TopFunction{
+			Name:     r.symbols.Strings[k.name],
+			Filename: r.symbols.Strings[k.filename],
+			Line:     int64(k.line),
+			Self:     self,
+			Total:    total,
+		})
+	})
+	return top
+}
@@ -0,0 +1,38 @@
+package symdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/testhelper"
+)
+
+func Test_memory_Resolver_TopFunctions(t *testing.T) {
+	s := newMemSuite(t, [][]string{{"testdata/profile.pb.gz"}})
+	sorted := func(functions []*typesv1.TopFunction) []*typesv1.TopFunction {
+		m := model.NewTopFunctionsMerger()
+		m.MergeTopFunctions(functions)
+		return m.Top(querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL, false, 0)
+	}
+	for _, granularity := range []typesv1.FunctionGranularity{
+		typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FUNCTION,
+		typesv1.FunctionGranularity_FUNCTION_GRANULARITY_FILE,
+		typesv1.FunctionGranularity_FUNCTION_GRANULARITY_LINE,
+	} {
+		t.Run(granularity.String(), func(t *testing.T) {
+			expected := model.NewTopFunctionsFromProfile(s.profiles[0], granularity)
+			r := NewResolver(context.Background(), s.db)
+			defer r.Release()
+			r.AddSamples(0, s.indexed[0][0].Samples)
+			resolved, err := r.TopFunctions(granularity)
+			require.NoError(t, err)
+			require.NotEmpty(t, resolved)
+			testhelper.EqualProto(t, sorted(expected), sorted(resolved))
+		})
+	}
+}
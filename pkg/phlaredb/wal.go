@@ -0,0 +1,439 @@
+package phlaredb
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+// The write-ahead log records the profiles appended to the head, so that
+// they can be replayed into a new head, if the process terminates before
+// the head is flushed. The log resides in the head directory and is
+// removed once the head is written to a block.
+//
+// The log consists of segments: files named after their sequence number.
+// A segment is a sequence of records, each of them is prefixed with the
+// header that holds the payload size and its CRC32 (Castagnoli) checksum:
+//
+//	| size (4 bytes) | checksum (4 bytes) | payload (size bytes) |
+//
+// Once the segment size exceeds the limit, a new segment is created.
+
+const (
+	pathWAL = "wal"
+
+	DefaultWALSegmentSize = 128 << 20
+
+	walRecordHeaderSize = 8
+	walMaxRecordSize    = 1 << 30
+	walSegmentNameLen   = 8
+)
+
+var (
+	errWALClosed        = errors.New("wal is closed")
+	errWALCorrupted     = errors.New("wal record is corrupted")
+	errWALRecordTooLong = errors.New("wal record is too long")
+)
+
+// walRecord holds the arguments of an ingestion request.
+type walRecord struct {
+	ID          uuid.UUID
+	Profile     *profilev1.Profile
+	Annotations []*typesv1.ProfileAnnotation
+	Labels      []*typesv1.LabelPair
+}
+
+// The record payload layout:
+//
+//	| id (16 bytes) | labels size (uvarint) | labels |
+//	| annotations (uvarint) | [ annotation size (uvarint) | annotation ] ... |
+//	| profile |
+func (r *walRecord) marshal(buf []byte) ([]byte, error) {
+	buf = append(buf, r.ID[:]...)
+	labels, err := (&typesv1.Labels{Labels: r.Labels}).MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(labels)))
+	buf = append(buf, labels...)
+	buf = binary.AppendUvarint(buf, uint64(len(r.Annotations)))
+	for _, a := range r.Annotations {
+		b, err := a.MarshalVT()
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	p, err := r.Profile.MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, p...), nil
+}
+
+func (r *walRecord) unmarshal(b []byte) error {
+	if len(b) < len(r.ID) {
+		return io.ErrUnexpectedEOF
+	}
+	copy(r.ID[:], b)
+	b = b[len(r.ID):]
+	next := func() ([]byte, error) {
+		n, s := binary.Uvarint(b)
+		if s <= 0 || n > uint64(len(b)-s) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		v := b[s : s+int(n)]
+		b = b[s+int(n):]
+		return v, nil
+	}
+	v, err := next()
+	if err != nil {
+		return err
+	}
+	var labels typesv1.Labels
+	if err = labels.UnmarshalVT(v); err != nil {
+		return err
+	}
+	r.Labels = labels.Labels
+	n, s := binary.Uvarint(b)
+	if s <= 0 || n > uint64(len(b)) {
+		return io.ErrUnexpectedEOF
+	}
+	b = b[s:]
+	r.Annotations = make([]*typesv1.ProfileAnnotation, 0, n)
+	for i := uint64(0); i < n; i++ {
+		if v, err = next(); err != nil {
+			return err
+		}
+		a := new(typesv1.ProfileAnnotation)
+		if err = a.UnmarshalVT(v); err != nil {
+			return err
+		}
+		r.Annotations = append(r.Annotations, a)
+	}
+	r.Profile = new(profilev1.Profile)
+	return r.Profile.UnmarshalVT(b)
+}
+
+var walCastagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+type wal struct {
+	dir         string
+	segmentSize int64
+	metrics     *headMetrics
+
+	mu      sync.Mutex
+	f       *os.File
+	segment int
+	size    int64
+	buf     []byte
+}
+
+func openWAL(dir string, segmentSize int64, metrics *headMetrics) (*wal, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultWALSegmentSize
+	}
+	if err := os.MkdirAll(dir, defaultFolderMode); err != nil {
+		return nil, err
+	}
+	segments, err := walSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	w := &wal{
+		dir:         dir,
+		segmentSize: segmentSize,
+		metrics:     metrics,
+	}
+	// Never append to an existing segment:
+	// its tail might have been torn.
+	if len(segments) > 0 {
+		w.segment = segments[len(segments)-1] + 1
+	}
+	if err = w.openSegment(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *wal) openSegment() (err error) {
+	name := filepath.Join(w.dir, walSegmentName(w.segment))
+	w.f, err = os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	w.size = 0
+	return err
+}
+
+// append writes the record to the log. The record is written to the
+// file with a single call, therefore it survives the process termination
+// once the call returns. However, the segment is only synced to the disk
+// on rotation and on close.
+func (w *wal) append(r *walRecord) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return errWALClosed
+	}
+	w.buf = slices.Grow(w.buf[:0], walRecordHeaderSize)[:walRecordHeaderSize]
+	if w.buf, err = r.marshal(w.buf); err != nil {
+		return err
+	}
+	payload := w.buf[walRecordHeaderSize:]
+	if len(payload) > walMaxRecordSize {
+		return errWALRecordTooLong
+	}
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(w.buf[4:8], crc32.Checksum(payload, walCastagnoli))
+	if w.size > 0 && w.size+int64(len(w.buf)) > w.segmentSize {
+		if err = w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.f.Write(w.buf)
+	w.size += int64(n)
+	w.metrics.walWrittenBytes.Add(float64(n))
+	if err != nil {
+		// The record might have been written partially: start
+		// a new segment, so that the next records are readable.
+		_ = w.rotate()
+		return fmt.Errorf("writing wal segment: %w", err)
+	}
+	w.metrics.walWrittenRecords.Inc()
+	return nil
+}
+
+func (w *wal) rotate() error {
+	if err := w.closeSegment(); err != nil {
+		return err
+	}
+	w.segment++
+	return w.openSegment()
+}
+
+func (w *wal) closeSegment() error {
+	err := w.f.Sync()
+	if closeErr := w.f.Close(); err == nil {
+		err = closeErr
+	}
+	w.f = nil
+	return err
+}
+
+// close syncs and closes the current segment.
+// The log can't be appended after the call.
+func (w *wal) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	return w.closeSegment()
+}
+
+// remove closes the log and deletes its directory.
+func (w *wal) remove() error {
+	if err := w.close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(w.dir)
+}
+
+func walSegmentName(segment int) string {
+	return fmt.Sprintf("%0*d", walSegmentNameLen, segment)
+}
+
+// walSegments returns the sequence numbers of the
+// log segments found in the directory, in order.
+func walSegments(dir string) ([]int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]int, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || len(e.Name()) != walSegmentNameLen {
+			continue
+		}
+		if n, err := strconv.Atoi(e.Name()); err == nil {
+			segments = append(segments, n)
+		}
+	}
+	slices.Sort(segments)
+	return segments, nil
+}
+
+type walReplayStats struct {
+	records   int
+	corrupted int
+}
+
+// readWAL reads the log in the directory and calls fn for every record.
+// A corrupted record makes the rest of its segment unreadable: the reader
+// skips it and proceeds with the next segment. A record truncated at the
+// end of a segment (torn write) is considered corrupted as well.
+func readWAL(dir string, fn func(*walRecord) error) (stats walReplayStats, err error) {
+	segments, err := walSegments(dir)
+	if err != nil {
+		return stats, err
+	}
+	for _, s := range segments {
+		if err = readWALSegment(filepath.Join(dir, walSegmentName(s)), &stats, fn); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+func readWALSegment(path string, stats *walReplayStats, fn func(*walRecord) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r := bufio.NewReader(f)
+	var (
+		header [walRecordHeaderSize]byte
+		buf    []byte
+	)
+	for {
+		if _, err = io.ReadFull(r, header[:]); err != nil {
+			break
+		}
+		size := binary.LittleEndian.Uint32(header[0:4])
+		if size > walMaxRecordSize {
+			err = errWALRecordTooLong
+			break
+		}
+		buf = slices.Grow(buf[:0], int(size))[:size]
+		if _, err = io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			break
+		}
+		if crc32.Checksum(buf, walCastagnoli) != binary.LittleEndian.Uint32(header[4:8]) {
+			err = errWALCorrupted
+			break
+		}
+		var record walRecord
+		if err = record.unmarshal(buf); err != nil {
+			err = fmt.Errorf("%w: %w", errWALCorrupted, err)
+			break
+		}
+		stats.records++
+		if err = fn(&record); err != nil {
+			return err
+		}
+	}
+	switch {
+	case errors.Is(err, io.EOF):
+		return nil
+	case errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, errWALCorrupted),
+		errors.Is(err, errWALRecordTooLong):
+		stats.corrupted++
+		return nil
+	default:
+		return err
+	}
+}
+
+// HasWAL reports whether there are heads with write-ahead
+// logs to be replayed in the data directory.
+func HasWAL(dataPath string) (bool, error) {
+	dirs, err := headsWithWAL(dataPath)
+	return len(dirs) > 0, err
+}
+
+func headsWithWAL(dataPath string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(dataPath, pathHead))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var dirs []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir := filepath.Join(dataPath, pathHead, e.Name())
+		if _, err = os.Stat(filepath.Join(dir, pathWAL)); err == nil {
+			dirs = append(dirs, dir)
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// replayWAL ingests the profiles recorded in the write-ahead logs of the
+// heads left from the previous run, and removes the heads directories.
+// The directory of a head is kept, if its log can't be read.
+//
+// The replayed profiles are recorded in the logs of the new heads. If the
+// process terminates before the replayed heads are removed, the next replay
+// encounters the same profiles in both the old and the new logs: a profile
+// is only ingested once, the duplicates are identified by the profile ID.
+func (f *PhlareDB) replayWAL(ctx context.Context) {
+	dirs, err := headsWithWAL(f.cfg.DataPath)
+	if err != nil {
+		level.Error(f.logger).Log("msg", "failed to list heads with wal", "err", err)
+		return
+	}
+	replayed := make(map[uuid.UUID]struct{})
+	for _, dir := range dirs {
+		start := time.Now()
+		var failed, duplicates int
+		stats, err := readWAL(filepath.Join(dir, pathWAL), func(r *walRecord) error {
+			if _, ok := replayed[r.ID]; ok {
+				duplicates++
+				return nil
+			}
+			replayed[r.ID] = struct{}{}
+			if err := f.Ingest(ctx, r.Profile, r.ID, r.Annotations, r.Labels...); err != nil {
+				level.Debug(f.logger).Log("msg", "failed to replay wal record", "head", dir, "err", err)
+				failed++
+			}
+			return nil
+		})
+		f.metrics.walReplayDurationSeconds.Observe(time.Since(start).Seconds())
+		f.metrics.walReplayedRecords.Add(float64(stats.records))
+		f.metrics.walCorruptedRecords.Add(float64(stats.corrupted))
+		if err != nil {
+			level.Error(f.logger).Log("msg", "failed to replay wal", "head", dir, "err", err)
+			continue
+		}
+		level.Info(f.logger).Log(
+			"msg", "wal replayed",
+			"head", dir,
+			"records", stats.records,
+			"corrupted", stats.corrupted,
+			"failed", failed,
+			"duplicates", duplicates,
+			"duration", time.Since(start),
+		)
+		if err = os.RemoveAll(dir); err != nil {
+			level.Error(f.logger).Log("msg", "failed to remove replayed head", "head", dir, "err", err)
+		}
+	}
+}
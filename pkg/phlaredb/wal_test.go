@@ -0,0 +1,173 @@
+package phlaredb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+func newTestWALRecords(t *testing.T, n int) []*walRecord {
+	records := make([]*walRecord, n)
+	for i := range records {
+		p, name := cpuProfileGenerator(int64(i)*int64(time.Second), t)
+		records[i] = &walRecord{
+			ID:      uuid.New(),
+			Profile: p,
+			Annotations: []*typesv1.ProfileAnnotation{
+				{Key: "throttled", Value: "true"},
+			},
+			Labels: []*typesv1.LabelPair{
+				{Name: "__name__", Value: name},
+				{Name: "pod", Value: "my-pod"},
+			},
+		}
+	}
+	return records
+}
+
+func writeTestWAL(t *testing.T, dir string, segmentSize int64, records []*walRecord) {
+	w, err := openWAL(dir, segmentSize, newHeadMetrics(prometheus.NewRegistry()))
+	require.NoError(t, err)
+	for _, r := range records {
+		require.NoError(t, w.append(r))
+	}
+	require.NoError(t, w.close())
+}
+
+func readTestWAL(t *testing.T, dir string) ([]*walRecord, walReplayStats) {
+	var records []*walRecord
+	stats, err := readWAL(dir, func(r *walRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	require.NoError(t, err)
+	return records, stats
+}
+
+func requireWALRecordsEqual(t *testing.T, expected, actual []*walRecord) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].ID, actual[i].ID)
+		assert.Equal(t, expected[i].Labels, actual[i].Labels)
+		assert.Equal(t, expected[i].Annotations, actual[i].Annotations)
+		assert.True(t, expected[i].Profile.EqualVT(actual[i].Profile))
+	}
+}
+
+func Test_WAL_ReadWrite(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), pathWAL)
+	records := newTestWALRecords(t, 10)
+	// Every record exceeds the segment size.
+	writeTestWAL(t, dir, 1<<10, records[:5])
+	segments, err := walSegments(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, segments)
+
+	// A reopened log starts a new segment.
+	writeTestWAL(t, dir, DefaultWALSegmentSize, records[5:])
+	segments, err = walSegments(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, segments)
+
+	actual, stats := readTestWAL(t, dir)
+	assert.Equal(t, walReplayStats{records: 10}, stats)
+	requireWALRecordsEqual(t, records, actual)
+}
+
+func Test_WAL_Corrupted(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), pathWAL)
+	records := newTestWALRecords(t, 9)
+	writeTestWAL(t, dir, DefaultWALSegmentSize, records[:3])
+	writeTestWAL(t, dir, DefaultWALSegmentSize, records[3:6])
+	writeTestWAL(t, dir, DefaultWALSegmentSize, records[6:])
+
+	// Corrupt the second record of the first segment.
+	name := filepath.Join(dir, walSegmentName(0))
+	b, err := os.ReadFile(name)
+	require.NoError(t, err)
+	b[len(b)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(name, b, 0o644))
+
+	// Tear the last record of the last segment.
+	name = filepath.Join(dir, walSegmentName(2))
+	fi, err := os.Stat(name)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(name, fi.Size()-10))
+
+	actual, stats := readTestWAL(t, dir)
+	assert.Equal(t, walReplayStats{records: 6, corrupted: 2}, stats)
+	requireWALRecordsEqual(t, append(append(records[:1:1], records[3:6]...), records[6:8]...), actual)
+}
+
+func Test_ReplayWAL(t *testing.T) {
+	ctx := testContext(t)
+	cfg := Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Hour,
+		WALEnabled:       true,
+	}
+	totalProfiles := func(db *PhlareDB) (n uint64) {
+		for _, h := range db.heads {
+			n += uint64(h.profiles.index.totalProfiles.Load())
+		}
+		return n
+	}
+	headDirs := func() []string {
+		dirs, err := headsWithWAL(cfg.DataPath)
+		require.NoError(t, err)
+		return dirs
+	}
+
+	db, err := New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	// The profiles are spread over two heads.
+	start := time.Unix(0, 0).Add(30 * time.Minute)
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), start.Add(time.Hour).UnixNano(), time.Minute,
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+	expected := totalProfiles(db)
+	require.NotZero(t, expected)
+	// The heads are not moved to local blocks on close.
+	require.NoError(t, db.Close())
+	replayed := headDirs()
+	require.Len(t, replayed, 2)
+	backup := t.TempDir()
+	for _, dir := range replayed {
+		require.NoError(t, os.CopyFS(filepath.Join(backup, filepath.Base(dir)), os.DirFS(dir)))
+	}
+
+	db, err = New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, expected, totalProfiles(db))
+	dirs := headDirs()
+	require.Len(t, dirs, 2)
+	for _, dir := range replayed {
+		assert.NotContains(t, dirs, dir)
+	}
+
+	// The process terminates before the replayed heads are removed:
+	// the profiles are recorded in both the old and the new logs.
+	require.NoError(t, db.Close())
+	for _, dir := range replayed {
+		require.NoError(t, os.CopyFS(dir, os.DirFS(filepath.Join(backup, filepath.Base(dir)))))
+	}
+	require.Len(t, headDirs(), 4)
+
+	db, err = New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, expected, totalProfiles(db))
+	require.Len(t, headDirs(), 2)
+
+	require.NoError(t, db.Flush(context.Background(), true, ""))
+	assert.Empty(t, headDirs())
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,256 @@
+package pprof
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/relabel"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+)
+
+type StackFrameRuleAction string
+
+const (
+	// StackFrameReplace replaces the function name with the
+	// replacement, if the name matches the regular expression.
+	StackFrameReplace StackFrameRuleAction = "replace"
+	// StackFrameDrop removes frames of functions with matching
+	// names from stack traces.
+	StackFrameDrop StackFrameRuleAction = "drop"
+	// StackFrameTruncate removes all the frames below the first
+	// (counting from the root) frame of a function with a matching
+	// name, which becomes the leaf of the stack trace.
+	StackFrameTruncate StackFrameRuleAction = "truncate"
+)
+
+// StackFrameRule describes a stack frame rewriting rule. The regular
+// expression is anchored and matched against the function name.
+type StackFrameRule struct {
+	Action      StackFrameRuleAction `yaml:"action" json:"action"`
+	Regex       relabel.Regexp       `yaml:"regex" json:"regex"`
+	Replacement string               `yaml:"replacement,omitempty" json:"replacement,omitempty"`
+}
+
+var defaultStackFrameRule = StackFrameRule{
+	Action:      StackFrameReplace,
+	Replacement: "$1",
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (r *StackFrameRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*r = defaultStackFrameRule
+	type plain StackFrameRule
+	if err := unmarshal((*plain)(r)); err != nil {
+		return err
+	}
+	return r.Validate()
+}
+
+func (r *StackFrameRule) Validate() error {
+	switch r.Action {
+	case StackFrameReplace, StackFrameDrop, StackFrameTruncate:
+	default:
+		return fmt.Errorf("unknown stack frame rule action %q", r.Action)
+	}
+	if r.Regex.Regexp == nil {
+		return fmt.Errorf("regex is required for %s action", r.Action)
+	}
+	if r.Action == StackFrameReplace {
+		// A reference to a missing capture group expands to an empty
+		// string: this would make the function name empty.
+		for _, name := range replacementGroups(r.Replacement) {
+			if !hasCaptureGroup(r.Regex, name) {
+				return fmt.Errorf("replacement %q refers to capture group %q that is not defined in the regex", r.Replacement, name)
+			}
+		}
+	}
+	return nil
+}
+
+// replacementGroups returns the capture group names and numbers
+// referenced in the replacement template, as regexp.Expand does.
+func replacementGroups(template string) []string {
+	var groups []string
+	for i := 0; i < len(template); i++ {
+		if template[i] != '$' || i+1 == len(template) {
+			continue
+		}
+		i++
+		if template[i] == '$' {
+			continue
+		}
+		var name string
+		if template[i] == '{' {
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				continue
+			}
+			name = template[i+1 : i+end]
+			i += end
+		} else {
+			j := i
+			for j < len(template) && isGroupNameByte(template[j]) {
+				j++
+			}
+			name = template[i:j]
+			i = j - 1
+		}
+		if name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups
+}
+
+func isGroupNameByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func hasCaptureGroup(re relabel.Regexp, name string) bool {
+	if n, err := strconv.Atoi(name); err == nil {
+		return n <= re.NumSubexp()
+	}
+	return re.SubexpIndex(name) >= 0
+}
+
+// RewriteStackFrames applies the rules to the functions of the profile.
+// The rules are applied in the order they are specified: a function name
+// is replaced by each matching replace rule, and the first matching drop
+// or truncate rule stops the evaluation.
+//
+// If the profile is modified, it is normalized in the same way as in
+// DropGoTypeParameters. Otherwise, the profile returns unchanged.
+func RewriteStackFrames(p *profilev1.Profile, rules []*StackFrameRule) *profilev1.Profile {
+	if len(rules) == 0 || len(p.Function) == 0 {
+		return p
+	}
+
+	var stringsIndex map[string]int64
+	stringIndex := func(s string) int64 {
+		if stringsIndex == nil {
+			stringsIndex = make(map[string]int64, len(p.StringTable))
+			for i, x := range p.StringTable {
+				if _, ok := stringsIndex[x]; !ok {
+					stringsIndex[x] = int64(i)
+				}
+			}
+		}
+		if i, ok := stringsIndex[s]; ok {
+			return i
+		}
+		i := int64(len(p.StringTable))
+		p.StringTable = append(p.StringTable, s)
+		stringsIndex[s] = i
+		return i
+	}
+
+	var renamed bool
+	actions := make(map[uint64]StackFrameRuleAction)
+	for _, fn := range p.Function {
+		if fn.Name < 0 || fn.Name >= int64(len(p.StringTable)) {
+			continue
+		}
+		original := p.StringTable[fn.Name]
+		name := original
+	rules:
+		for _, r := range rules {
+			switch r.Action {
+			case StackFrameReplace:
+				if m := r.Regex.FindStringSubmatchIndex(name); m != nil {
+					name = string(r.Regex.ExpandString(nil, r.Replacement, name, m))
+				}
+			case StackFrameDrop, StackFrameTruncate:
+				if r.Regex.MatchString(name) {
+					actions[fn.Id] = r.Action
+					break rules
+				}
+			}
+		}
+		if name == original {
+			continue
+		}
+		renamed = true
+		// The system name is often the same as the name:
+		// keep them in sync to not retain the original one.
+		if fn.SystemName == fn.Name ||
+			(fn.SystemName >= 0 && fn.SystemName < int64(len(p.StringTable)) && p.StringTable[fn.SystemName] == original) {
+			fn.SystemName = stringIndex(name)
+		}
+		fn.Name = stringIndex(name)
+	}
+
+	if len(actions) > 0 {
+		rewriteStackFrames(p, actions)
+	}
+	if !renamed && len(actions) == 0 {
+		return p
+	}
+
+	// Merging with self deduplicates functions, locations and
+	// samples that became identical after the rewrite.
+	var m ProfileMerge
+	if err := m.Merge(p); err != nil {
+		// The profile has a malformed header (e.g., no period
+		// type): leave it as is, it will be validated later.
+		return p
+	}
+	return m.Profile()
+}
+
+// rewriteStackFrames removes lines of dropped functions from locations,
+// and drops and truncates frames in the stack traces accordingly.
+func rewriteStackFrames(p *profilev1.Profile, actions map[uint64]StackFrameRuleAction) {
+	locations := make(map[uint64]StackFrameRuleAction)
+	for _, loc := range p.Location {
+		if len(loc.Line) == 0 {
+			continue
+		}
+		// Lines are ordered from the innermost inlined
+		// function to the outermost caller.
+		truncate := -1
+		for i := len(loc.Line) - 1; i >= 0; i-- {
+			if actions[loc.Line[i].FunctionId] == StackFrameTruncate {
+				truncate = i
+				break
+			}
+		}
+		if truncate >= 0 {
+			loc.Line = loc.Line[truncate:]
+			locations[loc.Id] = StackFrameTruncate
+		}
+		j := 0
+		for _, line := range loc.Line {
+			if actions[line.FunctionId] != StackFrameDrop {
+				loc.Line[j] = line
+				j++
+			}
+		}
+		loc.Line = loc.Line[:j]
+		if j == 0 {
+			locations[loc.Id] = StackFrameDrop
+		}
+	}
+	if len(locations) == 0 {
+		return
+	}
+
+	for _, s := range p.Sample {
+		// Locations are ordered from the leaf to the root.
+		for i := len(s.LocationId) - 1; i >= 0; i-- {
+			if locations[s.LocationId[i]] == StackFrameTruncate {
+				s.LocationId = s.LocationId[i:]
+				break
+			}
+		}
+		j := 0
+		for _, id := range s.LocationId {
+			if locations[id] != StackFrameDrop {
+				s.LocationId[j] = id
+				j++
+			}
+		}
+		s.LocationId = s.LocationId[:j]
+	}
+}
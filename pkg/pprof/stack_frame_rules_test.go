@@ -0,0 +1,147 @@
+package pprof
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/relabel"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+)
+
+func Test_RewriteStackFrames(t *testing.T) {
+	const rulesYAML = `
+- regex: (.*)\$\$Lambda\$.*\.(.*)
+  replacement: $1.$2
+- action: drop
+  regex: jdk\.internal\.reflect\..*
+- action: truncate
+  regex: io\.netty\..*\.run
+`
+	var rules []*StackFrameRule
+	require.NoError(t, yaml.Unmarshal([]byte(rulesYAML), &rules))
+
+	names := []string{
+		"main",
+		"com.Foo$$Lambda$12/0x0000000800c01234.apply",
+		"com.Foo$$Lambda$34/0x0000000800c05678.apply",
+		"jdk.internal.reflect.Method.invoke",
+		"io.netty.channel.nio.NioEventLoop.run",
+		"io.netty.channel.nio.NioEventLoop.processSelectedKeys",
+	}
+	p := &profilev1.Profile{
+		SampleType:  []*profilev1.ValueType{{Type: 1, Unit: 2}},
+		PeriodType:  &profilev1.ValueType{Type: 1, Unit: 2},
+		StringTable: append([]string{"", "samples", "count"}, names...),
+		Mapping:     []*profilev1.Mapping{{Id: 1, HasFunctions: true}},
+	}
+	for i := range names {
+		id := uint64(i + 1)
+		p.Function = append(p.Function, &profilev1.Function{Id: id, Name: int64(i + 3), SystemName: int64(i + 3)})
+		p.Location = append(p.Location, &profilev1.Location{Id: id, MappingId: 1, Line: []*profilev1.Line{{FunctionId: id}}})
+	}
+	p.Sample = []*profilev1.Sample{
+		{LocationId: []uint64{2, 4, 1}, Value: []int64{1}},
+		{LocationId: []uint64{3, 4, 1}, Value: []int64{2}},
+		{LocationId: []uint64{6, 5, 1}, Value: []int64{5}},
+		{LocationId: []uint64{6, 1}, Value: []int64{7}},
+	}
+
+	p = RewriteStackFrames(p, rules)
+	require.Equal(t, map[string]int64{
+		"main;com.Foo.apply":                                         3,
+		"main;io.netty.channel.nio.NioEventLoop.run":                 5,
+		"main;io.netty.channel.nio.NioEventLoop.processSelectedKeys": 7,
+	}, stackFrameRulesTestStacks(p))
+
+	for _, fn := range p.Function {
+		require.Equal(t, fn.Name, fn.SystemName)
+		require.NotContains(t, p.StringTable[fn.Name], "$$Lambda$")
+	}
+}
+
+func Test_RewriteStackFrames_Inlined(t *testing.T) {
+	rules := []*StackFrameRule{
+		{Action: StackFrameDrop, Regex: relabel.MustNewRegexp("inlined_drop")},
+		{Action: StackFrameTruncate, Regex: relabel.MustNewRegexp("inlined_truncate")},
+	}
+	p := &profilev1.Profile{
+		SampleType:  []*profilev1.ValueType{{Type: 1, Unit: 2}},
+		PeriodType:  &profilev1.ValueType{Type: 1, Unit: 2},
+		StringTable: []string{"", "samples", "count", "main", "inlined_drop", "inlined_truncate", "leaf"},
+		Mapping:     []*profilev1.Mapping{{Id: 1, HasFunctions: true}},
+		Function: []*profilev1.Function{
+			{Id: 1, Name: 3},
+			{Id: 2, Name: 4},
+			{Id: 3, Name: 5},
+			{Id: 4, Name: 6},
+		},
+		Location: []*profilev1.Location{
+			{Id: 1, MappingId: 1, Line: []*profilev1.Line{{FunctionId: 2}, {FunctionId: 1}}},
+			{Id: 2, MappingId: 1, Line: []*profilev1.Line{{FunctionId: 4}, {FunctionId: 3}, {FunctionId: 1}}},
+		},
+		Sample: []*profilev1.Sample{
+			{LocationId: []uint64{1}, Value: []int64{1}},
+			{LocationId: []uint64{2}, Value: []int64{2}},
+		},
+	}
+
+	p = RewriteStackFrames(p, rules)
+	require.Equal(t, map[string]int64{
+		"main":                  1,
+		"main;inlined_truncate": 2,
+	}, stackFrameRulesTestStacks(p))
+}
+
+func Test_RewriteStackFrames_NoMatch(t *testing.T) {
+	rules := []*StackFrameRule{{Action: StackFrameDrop, Regex: relabel.MustNewRegexp("foo")}}
+	p := &profilev1.Profile{
+		StringTable: []string{"", "main"},
+		Function:    []*profilev1.Function{{Id: 1, Name: 1}},
+	}
+	require.Same(t, p, RewriteStackFrames(p, rules))
+}
+
+func Test_StackFrameRule_Validate(t *testing.T) {
+	var rules []*StackFrameRule
+	require.Error(t, yaml.Unmarshal([]byte(`[{action: keep, regex: foo}]`), &rules))
+	require.Error(t, yaml.Unmarshal([]byte(`[{action: drop}]`), &rules))
+	require.Error(t, yaml.Unmarshal([]byte(`[{action: drop, regex: "("}]`), &rules))
+	require.NoError(t, yaml.Unmarshal([]byte(`[{regex: "(.*)_[0-9]+"}]`), &rules))
+	require.Equal(t, StackFrameReplace, rules[0].Action)
+	require.Equal(t, "$1", rules[0].Replacement)
+
+	// References to missing capture groups.
+	require.Error(t, yaml.Unmarshal([]byte(`[{regex: "foo.*"}]`), &rules))
+	require.Error(t, yaml.Unmarshal([]byte(`[{regex: "(foo).*", replacement: "$1_bar"}]`), &rules))
+	require.Error(t, yaml.Unmarshal([]byte(`[{regex: "(?P<name>foo).*", replacement: "${other}"}]`), &rules))
+	require.NoError(t, yaml.Unmarshal([]byte(`[{regex: "foo.*", replacement: "$0 $$1"}]`), &rules))
+	require.NoError(t, yaml.Unmarshal([]byte(`[{regex: "(?P<name>foo)(.*)", replacement: "${1}_${name}$2"}]`), &rules))
+	require.NoError(t, yaml.Unmarshal([]byte(`[{action: drop, regex: "foo.*"}]`), &rules))
+}
+
+// stackFrameRulesTestStacks returns sample values by
+// stack traces, rendered from the root to the leaf.
+func stackFrameRulesTestStacks(p *profilev1.Profile) map[string]int64 {
+	functions := make(map[uint64]string)
+	for _, fn := range p.Function {
+		functions[fn.Id] = p.StringTable[fn.Name]
+	}
+	locations := make(map[uint64][]string)
+	for _, loc := range p.Location {
+		for i := len(loc.Line) - 1; i >= 0; i-- {
+			locations[loc.Id] = append(locations[loc.Id], functions[loc.Line[i].FunctionId])
+		}
+	}
+	stacks := make(map[string]int64)
+	for _, s := range p.Sample {
+		var frames []string
+		for i := len(s.LocationId) - 1; i >= 0; i-- {
+			frames = append(frames, locations[s.LocationId[i]]...)
+		}
+		stacks[strings.Join(frames, ";")] += s.Value[0]
+	}
+	return stacks
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/grafana/pyroscope/pkg/frontend/dot/graph"
 	"github.com/grafana/pyroscope/pkg/frontend/dot/report"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/convert/chrome"
+	"github.com/grafana/pyroscope/pkg/og/convert/speedscope"
 	"github.com/grafana/pyroscope/pkg/og/structs/flamebearer"
 	"github.com/grafana/pyroscope/pkg/og/util/attime"
 	"github.com/grafana/pyroscope/pkg/querier/timeline"
@@ -143,10 +145,33 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM
 		case "avg":
 			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE
+		case "min":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN
+		case "max":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX
+		case "count":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT
+		case "rate":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_RATE
+		case "p50":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50
+		case "p90":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90
+		case "p99":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99
 		}
 	}
 
 	format := req.URL.Query().Get("format")
+	if function := req.URL.Query().Get("function"); function != "" {
+		if format != "" && format != "json" {
+			httputil.Error(w, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("format %q is not supported for the callers and callees of a function", format)))
+			return
+		}
+		q.renderCallersCallees(w, req, selectParams, profileType, function)
+		return
+	}
 	if format == "dot" {
 		// We probably should distinguish max nodes of the source pprof
 		// profile and max nodes value for the output profile in dot format.
@@ -177,6 +202,23 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	switch format {
+	case "speedscope-evented":
+		q.renderTimeline(w, req, selectParams, profileType)
+		return
+	case "collapsed", "speedscope", "chrome":
+		resp, err := q.client.SelectMergeStacktraces(req.Context(), connect.NewRequest(selectParams))
+		if err != nil {
+			httputil.Error(w, err)
+			return
+		}
+		fb := phlaremodel.ExportToFlamebearer(resp.Msg.Flamegraph, profileType)
+		if err = exportFlamebearer(w, fb, profileType, format); err != nil {
+			httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+		}
+		return
+	}
+
 	var resFlame *connect.Response[querierv1.SelectMergeStacktracesResponse]
 	g, gCtx := errgroup.WithContext(req.Context())
 	selectParamsClone := selectParams.CloneVT()
@@ -255,6 +297,110 @@ func pprofToDotProfile(w io.Writer, p *profilev1.Profile, maxNodes int) error {
 	return nil
 }
 
+// exportFlamebearer writes the profile in the given format: collapsed
+// stacks, speedscope JSON, or the Chrome trace event format.
+func exportFlamebearer(w http.ResponseWriter, fb *flamebearer.FlamebearerProfile, profileType *typesv1.ProfileType, format string) error {
+	t, err := flamebearer.ProfileToTree(*fb)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	switch format {
+	case "collapsed":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		data = []byte(t.Collapsed())
+	case "speedscope":
+		w.Header().Set("Content-Type", "application/json")
+		data, err = speedscope.ExportTree(t, profileType.ID, profileType.SampleUnit)
+	case "chrome":
+		w.Header().Set("Content-Type", "application/json")
+		data, err = chrome.ExportTrace(t, profileType.SampleUnit)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// callersCallees is the response of the render endpoint, if the function
+// is specified: both flame graphs are rooted at the function.
+type callersCallees struct {
+	Callers *flamebearer.FlamebearerProfile `json:"callers"`
+	Callees *flamebearer.FlamebearerProfile `json:"callees"`
+}
+
+// renderCallersCallees writes the merged callers and callees
+// of the function (butterfly view) as flame graphs.
+func (q *QueryHandlers) renderCallersCallees(w http.ResponseWriter, req *http.Request, selectParams *querierv1.SelectMergeStacktracesRequest, profileType *typesv1.ProfileType, function string) {
+	resp, err := q.client.SelectCallersCallees(req.Context(), connect.NewRequest(&querierv1.SelectCallersCalleesRequest{
+		ProfileTypeID: selectParams.ProfileTypeID,
+		LabelSelector: selectParams.LabelSelector,
+		Start:         selectParams.Start,
+		End:           selectParams.End,
+		Function:      function,
+		MaxNodes:      selectParams.MaxNodes,
+	}))
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(callersCallees{
+		Callers: phlaremodel.ExportToFlamebearer(resp.Msg.Callers, profileType),
+		Callees: phlaremodel.ExportToFlamebearer(resp.Msg.Callees, profileType),
+	}); err != nil {
+		httputil.Error(w, err)
+		return
+	}
+}
+
+// maxTimelineProfiles limits the number of
+// profiles a timeline export may include.
+const maxTimelineProfiles = 100
+
+// renderTimeline exports the individual profiles of the series selected by
+// the query as a speedscope evented profile, ordered by time. The selector
+// must match a single series.
+func (q *QueryHandlers) renderTimeline(w http.ResponseWriter, req *http.Request, selectParams *querierv1.SelectMergeStacktracesRequest, profileType *typesv1.ProfileType) {
+	resp, err := q.client.SelectProfileTrees(req.Context(), connect.NewRequest(&querierv1.SelectProfileTreesRequest{
+		ProfileTypeID: selectParams.ProfileTypeID,
+		LabelSelector: selectParams.LabelSelector,
+		Start:         selectParams.Start,
+		End:           selectParams.End,
+		Limit:         maxTimelineProfiles,
+	}))
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+
+	trees := make([]speedscope.TimedTree, len(resp.Msg.Profiles))
+	for i, p := range resp.Msg.Profiles {
+		t, err := phlaremodel.UnmarshalTree(p.Tree)
+		if err != nil {
+			httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+			return
+		}
+		tree, err := flamebearer.ProfileToTree(*phlaremodel.ExportToFlamebearer(phlaremodel.NewFlameGraph(t, -1), profileType))
+		if err != nil {
+			httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+			return
+		}
+		trees[i] = speedscope.TimedTree{Time: model.Time(p.Timestamp).Time(), Tree: tree}
+	}
+
+	data, err := speedscope.ExportTimeline(trees, profileType.ID, profileType.SampleUnit)
+	if err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
 type renderRequestFieldNames struct {
 	query string
 	from  string
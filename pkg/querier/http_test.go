@@ -1,16 +1,23 @@
 package querier
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/test/mocks/mockquerierv1connect"
 )
 
 func Test_ParseQuery(t *testing.T) {
@@ -40,3 +47,149 @@ func Test_ParseQuery(t *testing.T) {
 
 	require.Equal(t, `{foo="bar",bar=~"buzz"}`, queryRequest.LabelSelector)
 }
+
+func Test_RenderExport(t *testing.T) {
+	tree := new(phlaremodel.Tree)
+	tree.InsertStack(100, "main", "foo")
+	tree.InsertStack(200, "main", "bar")
+	flamegraph := phlaremodel.NewFlameGraph(tree, -1)
+
+	for _, tc := range []struct {
+		format      string
+		contentType string
+		expected    string
+	}{
+		{
+			format:      "collapsed",
+			contentType: "text/plain; charset=utf-8",
+			expected:    "main;bar 200\nmain;foo 100\n",
+		},
+		{
+			format:      "speedscope",
+			contentType: "application/json",
+			expected: `{"$schema":"https://www.speedscope.app/file-format-schema.json","shared":{"frames":[{"name":"main"},{"name":"bar"},{"name":"foo"}]},` +
+				`"profiles":[{"type":"sampled","name":"process_cpu:cpu:nanoseconds:cpu:nanoseconds","unit":"nanoseconds","startValue":0,"endValue":300,"samples":[[0,1],[0,2]],"weights":[200,100]}],` +
+				`"name":"process_cpu:cpu:nanoseconds:cpu:nanoseconds","activeProfileIndex":0,"exporter":"pyroscope"}`,
+		},
+		{
+			format:      "chrome",
+			contentType: "application/json",
+			expected: `{"traceEvents":[{"name":"bar","ph":"X","ts":0,"dur":0.2,"pid":1,"tid":1},{"name":"foo","ph":"X","ts":0.2,"dur":0.1,"pid":1,"tid":1},` +
+				`{"name":"main","ph":"X","ts":0,"dur":0.3,"pid":1,"tid":1}],"otherData":{"unit":"nanoseconds"}}`,
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			client := mockquerierv1connect.NewMockQuerierServiceClient(t)
+			client.On("SelectMergeStacktraces", mock.Anything, mock.Anything).
+				Return(connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Flamegraph: flamegraph.CloneVT()}), nil).
+				Once()
+
+			q := url.Values{
+				"query":  []string{`process_cpu:cpu:nanoseconds:cpu:nanoseconds{service_name="app"}`},
+				"from":   []string{"now-1h"},
+				"until":  []string{"now"},
+				"format": []string{tc.format},
+			}
+			req := httptest.NewRequest("GET", "/pyroscope/render?"+q.Encode(), nil)
+			rec := httptest.NewRecorder()
+			NewHTTPHandlers(client).Render(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			require.Equal(t, tc.contentType, rec.Header().Get("Content-Type"))
+			require.Equal(t, tc.expected, rec.Body.String())
+		})
+	}
+}
+
+func Test_RenderCallersCallees(t *testing.T) {
+	tree := new(phlaremodel.Tree)
+	tree.InsertStack(100, "main", "foo", "json.Marshal")
+	tree.InsertStack(200, "main", "bar", "json.Marshal", "reflect.Value")
+	callers, callees := tree.CallersCallees("json.Marshal")
+
+	client := mockquerierv1connect.NewMockQuerierServiceClient(t)
+	client.On("SelectCallersCallees", mock.Anything, mock.MatchedBy(func(req *connect.Request[querierv1.SelectCallersCalleesRequest]) bool {
+		return req.Msg.Function == "json.Marshal" && req.Msg.LabelSelector == `{service_name="app"}`
+	})).
+		Return(connect.NewResponse(&querierv1.SelectCallersCalleesResponse{
+			Callers: phlaremodel.NewFlameGraph(callers, -1),
+			Callees: phlaremodel.NewFlameGraph(callees, -1),
+		}), nil).
+		Once()
+
+	q := url.Values{
+		"query":    []string{`process_cpu:cpu:nanoseconds:cpu:nanoseconds{service_name="app"}`},
+		"from":     []string{"now-1h"},
+		"until":    []string{"now"},
+		"function": []string{"json.Marshal"},
+	}
+	req := httptest.NewRequest("GET", "/pyroscope/render?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	NewHTTPHandlers(client).Render(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp callersCallees
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.ElementsMatch(t, []string{"total", "json.Marshal", "bar", "foo", "main"}, resp.Callers.Flamebearer.Names)
+	require.Equal(t, []string{"total", "json.Marshal", "reflect.Value"}, resp.Callees.Flamebearer.Names)
+	require.Equal(t, 300, resp.Callers.Flamebearer.NumTicks)
+	require.Equal(t, 300, resp.Callees.Flamebearer.NumTicks)
+
+	q.Set("format", "collapsed")
+	req = httptest.NewRequest("GET", "/pyroscope/render?"+q.Encode(), nil)
+	rec = httptest.NewRecorder()
+	NewHTTPHandlers(client).Render(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_RenderTimeline(t *testing.T) {
+	tree := new(phlaremodel.Tree)
+	tree.InsertStack(100, "main", "foo")
+
+	client := mockquerierv1connect.NewMockQuerierServiceClient(t)
+	client.On("SelectProfileTrees", mock.Anything, mock.MatchedBy(func(req *connect.Request[querierv1.SelectProfileTreesRequest]) bool {
+		return req.Msg.Start == 0 && req.Msg.End == 60000 && req.Msg.Limit == maxTimelineProfiles &&
+			req.Msg.LabelSelector == `{service_name="app"}`
+	})).Return(connect.NewResponse(&querierv1.SelectProfileTreesResponse{
+		Profiles: []*querierv1.ProfileTree{
+			{Timestamp: 10000, Tree: tree.Bytes(-1)},
+			{Timestamp: 30000, Tree: tree.Bytes(-1)},
+		},
+	}), nil).Once()
+
+	q := url.Values{
+		"query":  []string{`process_cpu:cpu:nanoseconds:cpu:nanoseconds{service_name="app"}`},
+		"from":   []string{"0"},
+		"until":  []string{"60"},
+		"format": []string{"speedscope-evented"},
+	}
+	req := httptest.NewRequest("GET", "/pyroscope/render?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	NewHTTPHandlers(client).Render(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var actual struct {
+		Shared struct {
+			Frames []struct{ Name string }
+		}
+		Profiles []struct {
+			Type     string
+			EndValue float64
+			Events   []struct {
+				Type  string
+				At    float64
+				Frame int
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	frames := make([]string, 0, len(actual.Shared.Frames))
+	for _, f := range actual.Shared.Frames {
+		frames = append(frames, f.Name)
+	}
+	require.Equal(t, []string{"1970-01-01T00:00:10Z", "main", "foo", "1970-01-01T00:00:30Z"}, frames)
+	require.Len(t, actual.Profiles, 1)
+	require.Equal(t, "evented", actual.Profiles[0].Type)
+	require.Equal(t, float64(200), actual.Profiles[0].EndValue)
+	require.Len(t, actual.Profiles[0].Events, 12)
+}
@@ -30,6 +30,8 @@ type IngesterQueryClient interface {
 	MergeProfilesLabels(ctx context.Context) clientpool.BidiClientMergeProfilesLabels
 	MergeProfilesPprof(ctx context.Context) clientpool.BidiClientMergeProfilesPprof
 	MergeSpanProfile(ctx context.Context) clientpool.BidiClientMergeSpanProfile
+	MergeProfilesTopFunctions(ctx context.Context) clientpool.BidiClientMergeProfilesTopFunctions
+	SelectProfileTrees(ctx context.Context) clientpool.BidiClientSelectProfileTrees
 	BlockMetadata(ctx context.Context, req *connect.Request[ingestv1.BlockMetadataRequest]) (*connect.Response[ingestv1.BlockMetadataResponse], error)
 	GetProfileStats(ctx context.Context, req *connect.Request[typesv1.GetProfileStatsRequest]) (*connect.Response[typesv1.GetProfileStatsResponse], error)
 	GetBlockStats(ctx context.Context, req *connect.Request[ingestv1.GetBlockStatsRequest]) (*connect.Response[ingestv1.GetBlockStatsResponse], error)
@@ -142,7 +144,7 @@ func (q *Querier) selectTreeFromIngesters(ctx context.Context, req *querierv1.Se
 	return selectMergeTree(ctx, responses)
 }
 
-func (q *Querier) selectProfileFromIngesters(ctx context.Context, req *querierv1.SelectMergeProfileRequest, plan blockPlan) (*googlev1.Profile, error) {
+func (q *Querier) selectProfileFromIngesters(ctx context.Context, req *querierv1.SelectMergeProfileRequest, plan blockPlan, profileID string) (*googlev1.Profile, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfile Ingesters")
 	defer span.Finish()
 	profileType, err := phlaremodel.ParseProfileTypeSelector(req.ProfileTypeID)
@@ -186,6 +188,7 @@ func (q *Querier) selectProfileFromIngesters(ctx context.Context, req *querierv1
 					End:           req.End,
 					Type:          profileType,
 					Hints:         &ingestv1.Hints{Block: blockHints},
+					ProfileId:     profileID,
 				},
 				MaxNodes:           req.MaxNodes,
 				StackTraceSelector: req.StackTraceSelector,
@@ -239,6 +242,44 @@ func (q *Querier) selectSeriesFromIngesters(ctx context.Context, req *ingesterv1
 	return responses, nil
 }
 
+func (q *Querier) selectProfileTreesFromIngesters(ctx context.Context, req *ingestv1.SelectProfileTreesRequest, plan map[string]*blockPlanEntry) ([]ResponseFromReplica[clientpool.BidiClientSelectProfileTrees], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfileTrees Ingesters")
+	defer sp.Finish()
+	var responses []ResponseFromReplica[clientpool.BidiClientSelectProfileTrees]
+	var err error
+
+	if plan != nil {
+		responses, err = forAllPlannedIngesters(ctx, q.ingesterQuerier, plan, func(ctx context.Context, ic IngesterQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientSelectProfileTrees, error) {
+			return ic.SelectProfileTrees(ctx), nil
+		})
+	} else {
+		responses, err = forAllIngesters(ctx, q.ingesterQuerier, func(ctx context.Context, ic IngesterQueryClient) (clientpool.BidiClientSelectProfileTrees, error) {
+			return ic.SelectProfileTrees(ctx), nil
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all ingesters.
+	g, _ := errgroup.WithContext(ctx)
+	for _, r := range responses {
+		r := r
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		g.Go(util.RecoverPanic(func() error {
+			req := req.CloneVT()
+			req.Request.Hints = &ingestv1.Hints{Block: blockHints}
+			return r.response.Send(req)
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return responses, nil
+}
+
 func (q *Querier) labelValuesFromIngesters(ctx context.Context, req *typesv1.LabelValuesRequest) ([]ResponseFromReplica[[]string], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "LabelValues Ingesters")
 	defer sp.Finish()
@@ -353,6 +394,48 @@ func (q *Querier) selectSpanProfileFromIngesters(ctx context.Context, req *queri
 	return selectMergeSpanProfile(ctx, responses)
 }
 
+func (q *Querier) selectTopFunctionsFromIngesters(ctx context.Context, req *ingestv1.MergeProfilesTopFunctionsRequest, plan map[string]*blockPlanEntry) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectTopFunctions Ingesters")
+	defer sp.Finish()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]
+	var err error
+	if plan != nil {
+		responses, err = forAllPlannedIngesters(ctx, q.ingesterQuerier, plan, func(ctx context.Context, ic IngesterQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	} else {
+		responses, err = forAllIngesters(ctx, q.ingesterQuerier, func(ctx context.Context, ic IngesterQueryClient) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all ingesters.
+	g, _ := errgroup.WithContext(ctx)
+	for _, r := range responses {
+		r := r
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		g.Go(util.RecoverPanic(func() error {
+			req := req.CloneVT()
+			req.Request.Hints = &ingestv1.Hints{Block: blockHints}
+			return r.response.Send(req)
+		}))
+	}
+	if err = g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// merge all profiles
+	return selectMergeTopFunctions(ctx, responses)
+}
+
 func (q *Querier) blockSelectFromIngesters(ctx context.Context, req *ingestv1.BlockMetadataRequest) ([]ResponseFromReplica[[]*typesv1.BlockInfo], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "blockSelectFromIngesters")
 	defer sp.Finish()
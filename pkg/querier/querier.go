@@ -13,6 +13,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/ring"
 	ring_client "github.com/grafana/dskit/ring/client"
 	"github.com/grafana/dskit/services"
@@ -482,18 +483,28 @@ func (q *Querier) Diff(ctx context.Context, req *connect.Request[querierv1.DiffR
 		sp.Finish()
 	}()
 
-	var leftTree, rightTree *phlaremodel.Tree
-	g, gCtx := errgroup.WithContext(ctx)
-
-	g.Go(func() error {
-		res, err := q.selectTree(gCtx, req.Msg.Left)
-		if err != nil {
-			return err
+	lefts := []*querierv1.SelectMergeStacktracesRequest{req.Msg.Left}
+	if req.Msg.Baseline != nil {
+		var err error
+		if lefts, err = phlaremodel.DiffBaselineWindows(req.Msg.Left, req.Msg.Baseline); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+	}
 
-		leftTree = res
-		return nil
-	})
+	leftTrees := make([]*phlaremodel.Tree, len(lefts))
+	var rightTree *phlaremodel.Tree
+	g, gCtx := errgroup.WithContext(ctx)
+
+	for i, left := range lefts {
+		g.Go(func() error {
+			res, err := q.selectTree(gCtx, left)
+			if err != nil {
+				return err
+			}
+			leftTrees[i] = res
+			return nil
+		})
+	}
 
 	g.Go(func() error {
 		res, err := q.selectTree(gCtx, req.Msg.Right)
@@ -508,6 +519,13 @@ func (q *Querier) Diff(ctx context.Context, req *connect.Request[querierv1.DiffR
 		return nil, err
 	}
 
+	leftTree := leftTrees[0]
+	var changes []*querierv1.DiffNodeChange
+	if req.Msg.Baseline != nil {
+		leftTree = phlaremodel.NewBaselineTree(leftTrees...)
+		changes = phlaremodel.NewDiffChanges(leftTree, rightTree, req.Msg.Baseline.Threshold, int(maxNodesDefault))
+	}
+
 	fd, err := phlaremodel.NewFlamegraphDiff(leftTree, rightTree, maxNodesDefault)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -515,6 +533,7 @@ func (q *Querier) Diff(ctx context.Context, req *connect.Request[querierv1.DiffR
 
 	return connect.NewResponse(&querierv1.DiffResponse{
 		Flamegraph: fd,
+		Changes:    changes,
 	}), nil
 }
 
@@ -736,6 +755,7 @@ func (sq storeQuery) MergeSeriesRequest(req *querierv1.SelectSeriesRequest, prof
 		},
 		By:                 req.GroupBy,
 		StackTraceSelector: req.StackTraceSelector,
+		IncludeExemplars:   req.IncludeExemplars,
 	}
 }
 
@@ -751,6 +771,20 @@ func (sq storeQuery) MergeSpanProfileRequest(req *querierv1.SelectMergeSpanProfi
 	}
 }
 
+func (sq storeQuery) SelectProfileTreesRequest(req *ingestv1.SelectProfileTreesRequest) *ingestv1.SelectProfileTreesRequest {
+	r := req.CloneVT()
+	r.Request.Start = int64(sq.start)
+	r.Request.End = int64(sq.end)
+	return r
+}
+
+func (sq storeQuery) MergeTopFunctionsRequest(req *ingestv1.MergeProfilesTopFunctionsRequest) *ingestv1.MergeProfilesTopFunctionsRequest {
+	r := req.CloneVT()
+	r.Request.Start = int64(sq.start)
+	r.Request.End = int64(sq.end)
+	return r
+}
+
 func (sq storeQuery) MergeProfileRequest(req *querierv1.SelectMergeProfileRequest) *querierv1.SelectMergeProfileRequest {
 	return &querierv1.SelectMergeProfileRequest{
 		ProfileTypeID:      req.ProfileTypeID,
@@ -846,7 +880,7 @@ func (q *Querier) SelectMergeProfile(ctx context.Context, req *connect.Request[q
 		SetTag("profile_type", req.Msg.ProfileTypeID)
 	defer sp.Finish()
 
-	profile, err := q.selectProfile(ctx, req.Msg)
+	profile, err := q.selectProfile(ctx, req.Msg, "")
 	if err != nil {
 		return nil, err
 	}
@@ -855,7 +889,39 @@ func (q *Querier) SelectMergeProfile(ctx context.Context, req *connect.Request[q
 	return connect.NewResponse(profile), nil
 }
 
-func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeProfileRequest) (*googlev1.Profile, error) {
+func (q *Querier) GetProfile(ctx context.Context, req *connect.Request[querierv1.GetProfileRequest]) (*connect.Response[googlev1.Profile], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "GetProfile")
+	sp.SetTag("timestamp", model.Time(req.Msg.Timestamp).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("profile_type", req.Msg.ProfileTypeID).
+		SetTag("profile_id", req.Msg.ProfileId)
+	defer sp.Finish()
+
+	if _, err := uuid.Parse(req.Msg.ProfileId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid profile ID: %w", err))
+	}
+
+	// The timestamp is truncated to milliseconds, and the
+	// profile time is within [timestamp, timestamp+1).
+	profile, err := q.selectProfile(ctx, &querierv1.SelectMergeProfileRequest{
+		ProfileTypeID: req.Msg.ProfileTypeID,
+		LabelSelector: req.Msg.LabelSelector,
+		Start:         req.Msg.Timestamp,
+		End:           req.Msg.Timestamp + 1,
+	}, req.Msg.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if len(profile.GetSample()) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+	profile.TimeNanos = model.Time(req.Msg.Timestamp).UnixNano()
+	return connect.NewResponse(profile), nil
+}
+
+// selectProfile merges the profiles matching the request. If the
+// profile ID is not empty, only the profile with the ID is selected.
+func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeProfileRequest, profileID string) (*googlev1.Profile, error) {
 	// determine the block hints
 	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
 	if isEndpointNotExistingErr(err) {
@@ -870,7 +936,7 @@ func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeP
 
 	// no store gateways configured so just query the ingesters
 	if q.storeGatewayQuerier == nil {
-		return q.selectProfileFromIngesters(ctx, req, plan)
+		return q.selectProfileFromIngesters(ctx, req, plan, profileID)
 	}
 
 	storeQueries := splitQueryToStores(model.Time(req.Start), model.Time(req.End), model.Now(), q.cfg.QueryStoreAfter, plan)
@@ -881,23 +947,23 @@ func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeP
 	storeQueries.Log(level.Debug(spanlogger.FromContext(ctx, q.logger)))
 
 	if plan == nil && !storeQueries.ingester.shouldQuery {
-		return q.selectProfileFromStoreGateway(ctx, storeQueries.storeGateway.MergeProfileRequest(req), plan)
+		return q.selectProfileFromStoreGateway(ctx, storeQueries.storeGateway.MergeProfileRequest(req), plan, profileID)
 	}
 	if plan == nil && !storeQueries.storeGateway.shouldQuery {
-		return q.selectProfileFromIngesters(ctx, storeQueries.ingester.MergeProfileRequest(req), plan)
+		return q.selectProfileFromIngesters(ctx, storeQueries.ingester.MergeProfileRequest(req), plan, profileID)
 	}
 
 	g, gCtx := errgroup.WithContext(ctx)
 	var merge pprof.ProfileMerge
 	g.Go(func() error {
-		ingesterProfile, err := q.selectProfileFromIngesters(gCtx, storeQueries.ingester.MergeProfileRequest(req), plan)
+		ingesterProfile, err := q.selectProfileFromIngesters(gCtx, storeQueries.ingester.MergeProfileRequest(req), plan, profileID)
 		if err != nil {
 			return err
 		}
 		return merge.Merge(ingesterProfile)
 	})
 	g.Go(func() error {
-		storegatewayProfile, err := q.selectProfileFromStoreGateway(gCtx, storeQueries.storeGateway.MergeProfileRequest(req), plan)
+		storegatewayProfile, err := q.selectProfileFromStoreGateway(gCtx, storeQueries.storeGateway.MergeProfileRequest(req), plan, profileID)
 		if err != nil {
 			return err
 		}
@@ -973,6 +1039,321 @@ func (q *Querier) SelectSeries(ctx context.Context, req *connect.Request[querier
 	}), nil
 }
 
+func (q *Querier) SelectHeatmap(ctx context.Context, req *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectHeatmap")
+	defer func() {
+		sp.LogFields(
+			otlog.String("start", model.Time(req.Msg.Start).Time().String()),
+			otlog.String("end", model.Time(req.Msg.End).Time().String()),
+			otlog.String("selector", req.Msg.LabelSelector),
+			otlog.String("profile_id", req.Msg.ProfileTypeID),
+			otlog.Float64("step", req.Msg.Step),
+			otlog.Int64("profiles_per_cell", req.Msg.ProfilesPerCell),
+		)
+		sp.Finish()
+	}()
+
+	if _, err := parser.ParseMetricSelector(req.Msg.LabelSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Start > req.Msg.End {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start must be before end"))
+	}
+	if req.Msg.Step <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("step must be positive"))
+	}
+	if req.Msg.ProfilesPerCell < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("profiles_per_cell must not be negative"))
+	}
+
+	// Profiles are only distinguishable by their series labels and
+	// timestamps, therefore the series are grouped by all the labels.
+	names, err := q.LabelNames(ctx, connect.NewRequest(&typesv1.LabelNamesRequest{
+		Matchers: []string{req.Msg.LabelSelector},
+		Start:    req.Msg.Start,
+		End:      req.Msg.End,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if len(names.Msg.Names) == 0 {
+		return connect.NewResponse(&querierv1.SelectHeatmapResponse{}), nil
+	}
+
+	plan, err := q.blockSelect(ctx, model.Time(req.Msg.Start), model.Time(req.Msg.End))
+	if isEndpointNotExistingErr(err) {
+		level.Warn(spanlogger.FromContext(ctx, q.logger)).Log(
+			"msg", "block select not supported on at least one component, fallback to use full dataset",
+			"err", err,
+		)
+		plan = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error during block select: %w", err)
+	}
+
+	responses, err := q.selectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		ProfileTypeID: req.Msg.ProfileTypeID,
+		LabelSelector: req.Msg.LabelSelector,
+		Start:         req.Msg.Start,
+		End:           req.Msg.End,
+		GroupBy:       names.Msg.Names,
+		Step:          req.Msg.Step,
+	}), plan)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := selectMergeSeries(ctx, nil, responses)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer it.Close()
+
+	stepMs := time.Duration(req.Msg.Step * float64(time.Second)).Milliseconds()
+	builder := phlaremodel.NewHeatmapBuilder(req.Msg.Start, req.Msg.End, stepMs, int(req.Msg.ProfilesPerCell))
+	for it.Next() {
+		p := it.At()
+		builder.Add(p.Lbs, p.Ts, p.Value)
+	}
+	if it.Err() != nil {
+		return nil, connect.NewError(connect.CodeInternal, it.Err())
+	}
+
+	return connect.NewResponse(&querierv1.SelectHeatmapResponse{
+		Cells: builder.Build(),
+	}), nil
+}
+
+func (q *Querier) SelectTopFunctions(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectTopFunctions")
+	defer func() {
+		sp.LogFields(
+			otlog.String("start", model.Time(req.Msg.Start).Time().String()),
+			otlog.String("end", model.Time(req.Msg.End).Time().String()),
+			otlog.String("selector", req.Msg.LabelSelector),
+			otlog.String("profile_id", req.Msg.ProfileTypeID),
+			otlog.String("granularity", req.Msg.Granularity.String()),
+			otlog.String("order_by", req.Msg.OrderBy.String()),
+			otlog.Int64("limit", req.Msg.Limit),
+			otlog.String("baseline_start", model.Time(req.Msg.BaselineStart).Time().String()),
+			otlog.String("baseline_end", model.Time(req.Msg.BaselineEnd).Time().String()),
+		)
+		sp.Finish()
+	}()
+
+	if err := validateTopFunctionsRequest(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	profileType, err := phlaremodel.ParseProfileTypeSelector(req.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	m := phlaremodel.NewTopFunctionsMerger()
+	selectTopFunctions := func(start, end int64, merge func([]*typesv1.TopFunction)) func() error {
+		return func() error {
+			// Function values are computed by the ingesters and
+			// store gateways while stack traces are resolved.
+			functions, err := q.selectTopFunctions(ctx, &ingestv1.MergeProfilesTopFunctionsRequest{
+				Request: &ingestv1.SelectProfilesRequest{
+					LabelSelector: req.Msg.LabelSelector,
+					Type:          profileType,
+					Start:         start,
+					End:           end,
+				},
+				Granularity: req.Msg.Granularity,
+			})
+			if err != nil {
+				return err
+			}
+			merge(functions)
+			return nil
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(selectTopFunctions(req.Msg.Start, req.Msg.End, m.MergeTopFunctions))
+	diff := req.Msg.BaselineEnd > 0
+	if diff {
+		g.Go(selectTopFunctions(req.Msg.BaselineStart, req.Msg.BaselineEnd, m.MergeBaseline))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+		Functions: m.Top(req.Msg.OrderBy, diff, int(req.Msg.Limit)),
+	}), nil
+}
+
+func validateTopFunctionsRequest(req *querierv1.SelectTopFunctionsRequest) error {
+	if _, err := parser.ParseMetricSelector(req.LabelSelector); err != nil {
+		return err
+	}
+	if req.Start > req.End {
+		return errors.New("start must be before end")
+	}
+	if req.BaselineStart > req.BaselineEnd {
+		return errors.New("baseline start must be before baseline end")
+	}
+	if req.Limit < 0 {
+		return errors.New("limit must not be negative")
+	}
+	return nil
+}
+
+func (q *Querier) SelectCallersCallees(ctx context.Context, req *connect.Request[querierv1.SelectCallersCalleesRequest]) (*connect.Response[querierv1.SelectCallersCalleesResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectCallersCallees")
+	defer func() {
+		sp.LogFields(
+			otlog.String("start", model.Time(req.Msg.Start).Time().String()),
+			otlog.String("end", model.Time(req.Msg.End).Time().String()),
+			otlog.String("selector", req.Msg.LabelSelector),
+			otlog.String("profile_id", req.Msg.ProfileTypeID),
+			otlog.String("function", req.Msg.Function),
+		)
+		sp.Finish()
+	}()
+
+	if err := validateCallersCalleesRequest(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.MaxNodes == nil || *req.Msg.MaxNodes == 0 {
+		mn := maxNodesDefault
+		req.Msg.MaxNodes = &mn
+	}
+
+	// The function may be called anywhere in the stacks,
+	// therefore the number of nodes is not limited.
+	t, err := q.selectTree(ctx, &querierv1.SelectMergeStacktracesRequest{
+		ProfileTypeID: req.Msg.ProfileTypeID,
+		LabelSelector: req.Msg.LabelSelector,
+		Start:         req.Msg.Start,
+		End:           req.Msg.End,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callers, callees := t.CallersCallees(req.Msg.Function)
+	var resp querierv1.SelectCallersCalleesResponse
+	switch req.Msg.Format {
+	default:
+		resp.Callers = phlaremodel.NewFlameGraph(callers, req.Msg.GetMaxNodes())
+		resp.Callees = phlaremodel.NewFlameGraph(callees, req.Msg.GetMaxNodes())
+	case querierv1.ProfileFormat_PROFILE_FORMAT_TREE:
+		resp.CallersTree = callers.Bytes(req.Msg.GetMaxNodes())
+		resp.CalleesTree = callees.Bytes(req.Msg.GetMaxNodes())
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func validateCallersCalleesRequest(req *querierv1.SelectCallersCalleesRequest) error {
+	if _, err := parser.ParseMetricSelector(req.LabelSelector); err != nil {
+		return err
+	}
+	if req.Start > req.End {
+		return errors.New("start must be before end")
+	}
+	if req.Function == "" {
+		return errors.New("function must be specified")
+	}
+	return nil
+}
+
+func (q *Querier) SelectProfileTrees(ctx context.Context, req *connect.Request[querierv1.SelectProfileTreesRequest]) (*connect.Response[querierv1.SelectProfileTreesResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfileTrees")
+	defer func() {
+		sp.LogFields(
+			otlog.String("start", model.Time(req.Msg.Start).Time().String()),
+			otlog.String("end", model.Time(req.Msg.End).Time().String()),
+			otlog.String("selector", req.Msg.LabelSelector),
+			otlog.String("profile_id", req.Msg.ProfileTypeID),
+			otlog.Int64("limit", req.Msg.Limit),
+		)
+		sp.Finish()
+	}()
+
+	if _, err := parser.ParseMetricSelector(req.Msg.LabelSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Start > req.Msg.End {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start must be before end"))
+	}
+	profileType, err := phlaremodel.ParseProfileTypeSelector(req.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// determine the block hints
+	plan, err := q.blockSelect(ctx, model.Time(req.Msg.Start), model.Time(req.Msg.End))
+	if isEndpointNotExistingErr(err) {
+		level.Warn(spanlogger.FromContext(ctx, q.logger)).Log(
+			"msg", "block select not supported on at least one component, fallback to use full dataset",
+			"err", err,
+		)
+		plan = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error during block select: %w", err)
+	}
+
+	// The profiles of the ingesters and store gateways are deduplicated
+	// together: the selected series is checked across all of them.
+	selectReq := &ingestv1.SelectProfileTreesRequest{
+		Request: &ingestv1.SelectProfilesRequest{
+			LabelSelector: req.Msg.LabelSelector,
+			Type:          profileType,
+			Start:         req.Msg.Start,
+			End:           req.Msg.End,
+		},
+	}
+	var responses []ResponseFromReplica[clientpool.BidiClientSelectProfileTrees]
+	if q.storeGatewayQuerier == nil {
+		if responses, err = q.selectProfileTreesFromIngesters(ctx, selectReq, plan); err != nil {
+			return nil, err
+		}
+	} else {
+		storeQueries := splitQueryToStores(model.Time(req.Msg.Start), model.Time(req.Msg.End), model.Now(), q.cfg.QueryStoreAfter, plan)
+		if !storeQueries.ingester.shouldQuery && !storeQueries.storeGateway.shouldQuery {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end time are outside of the ingester and store gateway retention"))
+		}
+		storeQueries.Log(level.Debug(spanlogger.FromContext(ctx, q.logger)))
+		if storeQueries.ingester.shouldQuery {
+			ir, err := q.selectProfileTreesFromIngesters(ctx, storeQueries.ingester.SelectProfileTreesRequest(selectReq), plan)
+			if err != nil {
+				return nil, err
+			}
+			responses = append(responses, ir...)
+		}
+		if storeQueries.storeGateway.shouldQuery {
+			ir, err := q.selectProfileTreesFromStoreGateway(ctx, storeQueries.storeGateway.SelectProfileTreesRequest(selectReq), plan)
+			if err != nil {
+				return nil, err
+			}
+			responses = append(responses, ir...)
+		}
+	}
+
+	trees, err := selectProfileTrees(ctx, responses, req.Msg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*querierv1.ProfileTree, len(trees))
+	for i, t := range trees {
+		profiles[i] = &querierv1.ProfileTree{
+			Timestamp: t.Timestamp,
+			Tree:      t.Tree,
+		}
+	}
+	return connect.NewResponse(&querierv1.SelectProfileTreesResponse{
+		Profiles: profiles,
+	}), nil
+}
+
 func (q *Querier) selectSeries(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest], plan map[string]*blockPlanEntry) ([]ResponseFromReplica[clientpool.BidiClientMergeProfilesLabels], error) {
 	stepMs := time.Duration(req.Msg.Step * float64(time.Second)).Milliseconds()
 	sort.Strings(req.Msg.GroupBy)
@@ -997,6 +1378,7 @@ func (q *Querier) selectSeries(ctx context.Context, req *connect.Request[querier
 			},
 			By:                 req.Msg.GroupBy,
 			StackTraceSelector: req.Msg.StackTraceSelector,
+			IncludeExemplars:   req.Msg.IncludeExemplars,
 		}, plan)
 	}
 
@@ -1047,6 +1429,62 @@ func uniqueSortedStrings(responses []ResponseFromReplica[[]string]) []string {
 	return result
 }
 
+func (q *Querier) selectTopFunctions(ctx context.Context, req *ingestv1.MergeProfilesTopFunctionsRequest) ([]*typesv1.TopFunction, error) {
+	// determine the block hints
+	plan, err := q.blockSelect(ctx, model.Time(req.Request.Start), model.Time(req.Request.End))
+	if isEndpointNotExistingErr(err) {
+		level.Warn(spanlogger.FromContext(ctx, q.logger)).Log(
+			"msg", "block select not supported on at least one component, fallback to use full dataset",
+			"err", err,
+		)
+		plan = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error during block select: %w", err)
+	}
+
+	// no store gateways configured so just query the ingesters
+	if q.storeGatewayQuerier == nil {
+		return q.selectTopFunctionsFromIngesters(ctx, req, plan)
+	}
+
+	storeQueries := splitQueryToStores(model.Time(req.Request.Start), model.Time(req.Request.End), model.Now(), q.cfg.QueryStoreAfter, plan)
+	if !storeQueries.ingester.shouldQuery && !storeQueries.storeGateway.shouldQuery {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end time are outside of the ingester and store gateway retention"))
+	}
+
+	storeQueries.Log(level.Debug(spanlogger.FromContext(ctx, q.logger)))
+
+	if plan == nil && !storeQueries.ingester.shouldQuery {
+		return q.selectTopFunctionsFromStoreGateway(ctx, storeQueries.storeGateway.MergeTopFunctionsRequest(req), plan)
+	}
+	if plan == nil && !storeQueries.storeGateway.shouldQuery {
+		return q.selectTopFunctionsFromIngesters(ctx, storeQueries.ingester.MergeTopFunctionsRequest(req), plan)
+	}
+
+	m := phlaremodel.NewTopFunctionsMerger()
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		functions, err := q.selectTopFunctionsFromIngesters(gCtx, storeQueries.ingester.MergeTopFunctionsRequest(req), plan)
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(functions)
+		return nil
+	})
+	g.Go(func() error {
+		functions, err := q.selectTopFunctionsFromStoreGateway(gCtx, storeQueries.storeGateway.MergeTopFunctionsRequest(req), plan)
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(functions)
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return m.TopFunctions(), nil
+}
+
 func (q *Querier) selectSpanProfile(ctx context.Context, req *querierv1.SelectMergeSpanProfileRequest) (*phlaremodel.Tree, error) {
 	// determine the block hints
 	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
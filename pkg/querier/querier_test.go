@@ -619,6 +619,234 @@ func TestSelectSeries(t *testing.T) {
 	}
 }
 
+func TestSelectHeatmap(t *testing.T) {
+	foo := []*typesv1.LabelPair{{Name: "app", Value: "foo"}}
+	bar := []*typesv1.LabelPair{{Name: "app", Value: "bar"}}
+	newBidi := func() *fakeBidiClientSeries {
+		return newFakeBidiClientSeries([]*ingestv1.ProfileSets{
+			{
+				LabelsSets: []*typesv1.Labels{{Labels: foo}, {Labels: bar}},
+				Profiles: []*ingestv1.SeriesProfile{
+					{Timestamp: 1000, LabelIndex: 0},
+					{Timestamp: 1000, LabelIndex: 1},
+					{Timestamp: 2000, LabelIndex: 0},
+				},
+			},
+		},
+			&typesv1.Series{Labels: bar, Points: []*typesv1.Point{{Value: 3, Timestamp: 1000}}},
+			&typesv1.Series{Labels: foo, Points: []*typesv1.Point{{Value: 3, Timestamp: 1000}, {Value: 10, Timestamp: 2000}}},
+		)
+	}
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+		}, 2),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("LabelNames", mock.Anything, mock.Anything).Return(connect.NewResponse(&typesv1.LabelNamesResponse{Names: []string{"app"}}), nil)
+			// The replicas have the same block:
+			// only one of them is queried.
+			q.mockMergeLabels(newBidi(), []string{"a"}, true)
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectHeatmap(context.Background(), connect.NewRequest(&querierv1.SelectHeatmapRequest{
+		LabelSelector:   `{app=~"foo|bar"}`,
+		ProfileTypeID:   "memory:inuse_space:bytes:space:byte",
+		Start:           1000,
+		End:             2000,
+		Step:            1,
+		ProfilesPerCell: 1,
+	}))
+	require.NoError(t, err)
+	testhelper.EqualProto(t, []*typesv1.HeatmapCell{
+		{
+			Timestamp:  1000,
+			Bucket:     phlaremodel.HeatmapValueBucket(3),
+			LowerBound: phlaremodel.HeatmapBucketBound(phlaremodel.HeatmapValueBucket(3) - 1),
+			UpperBound: phlaremodel.HeatmapBucketBound(phlaremodel.HeatmapValueBucket(3)),
+			Count:      2,
+			Profiles:   []*typesv1.ProfileReference{{Labels: bar, Timestamp: 1000, Value: 3}},
+		},
+		{
+			Timestamp:  2000,
+			Bucket:     phlaremodel.HeatmapValueBucket(10),
+			LowerBound: phlaremodel.HeatmapBucketBound(phlaremodel.HeatmapValueBucket(10) - 1),
+			UpperBound: phlaremodel.HeatmapBucketBound(phlaremodel.HeatmapValueBucket(10)),
+			Count:      1,
+			Profiles:   []*typesv1.ProfileReference{{Labels: foo, Timestamp: 2000, Value: 10}},
+		},
+	}, res.Msg.Cells)
+}
+
+func TestSelectTopFunctions(t *testing.T) {
+	newBidi := func() *fakeBidiClientTopFunctions {
+		return newFakeBidiClientTopFunctions([]*ingestv1.ProfileSets{
+			{
+				LabelsSets: []*typesv1.Labels{{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}}},
+				Profiles:   []*ingestv1.SeriesProfile{{Timestamp: 1, LabelIndex: 0}},
+			},
+		},
+			&typesv1.TopFunction{Name: "bar", Self: 3, Total: 4},
+			&typesv1.TopFunction{Name: "foo", Self: 3, Total: 3},
+		)
+	}
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+		}, 2),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			// The replicas have the same block: only one of them
+			// is queried, for the time range and the baseline.
+			q.mockMergeTopFunctions(newBidi(), []string{"a"}, true)
+			q.mockMergeTopFunctions(newBidi(), []string{"a"}, true)
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectTopFunctions(context.Background(), connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		LabelSelector: `{app="foo"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           2,
+		OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL,
+		BaselineStart: 0,
+		BaselineEnd:   2,
+	}))
+	require.NoError(t, err)
+	testhelper.EqualProto(t, []*typesv1.TopFunction{
+		{Name: "bar", Self: 3, Total: 4, BaselineSelf: 3, BaselineTotal: 4},
+		{Name: "foo", Self: 3, Total: 3, BaselineSelf: 3, BaselineTotal: 3},
+	}, res.Msg.Functions)
+}
+
+func TestSelectProfileTrees(t *testing.T) {
+	tree := new(phlaremodel.Tree)
+	tree.InsertStack(1, "foo", "bar")
+	newQuerier := func(t *testing.T, series ...string) *Querier {
+		batch := &ingestv1.ProfileSets{}
+		for i, s := range series {
+			batch.LabelsSets = append(batch.LabelsSets, &typesv1.Labels{Labels: []*typesv1.LabelPair{{Name: "app", Value: s}}})
+			batch.Profiles = append(batch.Profiles, &ingestv1.SeriesProfile{Timestamp: int64(i + 1), LabelIndex: int32(i)})
+		}
+		querier, err := New(&NewQuerierParams{
+			Cfg: Config{
+				PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+			},
+			IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+				{Addr: "1"},
+				{Addr: "2"},
+			}, 2),
+			PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+				// Both replicas are queried: the profiles are deduplicated.
+				q := newFakeQuerier()
+				q.mockSelectProfileTrees(newFakeBidiClientProfileTrees(batch.CloneVT(), tree.Bytes(-1)))
+				return q, nil
+			}},
+			Logger: log.NewLogfmtLogger(os.Stdout),
+		})
+		require.NoError(t, err)
+		return querier
+	}
+
+	t.Run("single series", func(t *testing.T) {
+		res, err := newQuerier(t, "foo", "foo").SelectProfileTrees(context.Background(), connect.NewRequest(&querierv1.SelectProfileTreesRequest{
+			LabelSelector: `{app="foo"}`,
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			Start:         0,
+			End:           2,
+			Limit:         2,
+		}))
+		require.NoError(t, err)
+		testhelper.EqualProto(t, []*querierv1.ProfileTree{
+			{Timestamp: 1, Tree: tree.Bytes(-1)},
+			{Timestamp: 2, Tree: tree.Bytes(-1)},
+		}, res.Msg.Profiles)
+	})
+
+	t.Run("multiple series", func(t *testing.T) {
+		_, err := newQuerier(t, "foo", "bar").SelectProfileTrees(context.Background(), connect.NewRequest(&querierv1.SelectProfileTreesRequest{
+			LabelSelector: `{}`,
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			Start:         0,
+			End:           2,
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		require.ErrorContains(t, err, "matches 2 series")
+	})
+
+	t.Run("limit exceeded", func(t *testing.T) {
+		_, err := newQuerier(t, "foo", "foo").SelectProfileTrees(context.Background(), connect.NewRequest(&querierv1.SelectProfileTreesRequest{
+			LabelSelector: `{app="foo"}`,
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			Start:         0,
+			End:           2,
+			Limit:         1,
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		require.ErrorContains(t, err, "selects 2 profiles")
+	})
+}
+
+func TestSelectCallersCallees(t *testing.T) {
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+		}, 2),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.mockMergeStacktraces(newFakeBidiClientStacktraces([]*ingestv1.ProfileSets{
+				{
+					LabelsSets: []*typesv1.Labels{{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}}},
+					Profiles:   []*ingestv1.SeriesProfile{{Timestamp: 1, LabelIndex: 0}},
+				},
+			}), []string{"a"}, true)
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectCallersCallees(context.Background(), connect.NewRequest(&querierv1.SelectCallersCalleesRequest{
+		LabelSelector: `{app="foo"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           2,
+		Function:      "bar",
+		Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+	}))
+	require.NoError(t, err)
+	// The stack is buzz;bar;foo.
+	callers, err := phlaremodel.UnmarshalTree(res.Msg.CallersTree)
+	require.NoError(t, err)
+	callees, err := phlaremodel.UnmarshalTree(res.Msg.CalleesTree)
+	require.NoError(t, err)
+	expected := new(phlaremodel.Tree)
+	expected.InsertStack(1, "bar", "buzz")
+	require.Equal(t, expected.String(), callers.String())
+	expected = new(phlaremodel.Tree)
+	expected.InsertStack(1, "bar", "foo")
+	require.Equal(t, expected.String(), callees.String())
+}
+
 type fakeQuerierIngester struct {
 	mock.Mock
 	testhelper.FakePoolClient
@@ -659,6 +887,20 @@ func (f *fakeQuerierIngester) mockMergeProfile(bidi *fakeBidiClientProfiles, blo
 	f.On("MergeProfilesPprof", mock.Anything).Once().Return(bidi)
 }
 
+func (f *fakeQuerierIngester) mockMergeTopFunctions(bidi *fakeBidiClientTopFunctions, blocks []string, blockSelect bool) {
+	if blockSelect {
+		f.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(newBlockMeta(blocks...), nil)
+	} else {
+		f.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+	}
+	f.On("MergeProfilesTopFunctions", mock.Anything).Once().Return(bidi)
+}
+
+func (f *fakeQuerierIngester) mockSelectProfileTrees(bidi *fakeBidiClientProfileTrees) {
+	f.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+	f.On("SelectProfileTrees", mock.Anything).Once().Return(bidi)
+}
+
 func (f *fakeQuerierIngester) LabelValues(ctx context.Context, req *connect.Request[typesv1.LabelValuesRequest]) (*connect.Response[typesv1.LabelValuesResponse], error) {
 	var (
 		args = f.Called(ctx, req)
@@ -962,6 +1204,139 @@ func (f *fakeBidiClientSeries) Receive() (*ingestv1.MergeProfilesLabelsResponse,
 func (f *fakeBidiClientSeries) CloseRequest() error  { return nil }
 func (f *fakeBidiClientSeries) CloseResponse() error { return nil }
 
+type fakeBidiClientTopFunctions struct {
+	profiles chan *ingestv1.ProfileSets
+	batches  []*ingestv1.ProfileSets
+	kept     []testProfile
+	cur      *ingestv1.ProfileSets
+
+	result []*typesv1.TopFunction
+}
+
+func newFakeBidiClientTopFunctions(batches []*ingestv1.ProfileSets, result ...*typesv1.TopFunction) *fakeBidiClientTopFunctions {
+	res := &fakeBidiClientTopFunctions{
+		profiles: make(chan *ingestv1.ProfileSets, 1),
+	}
+	res.profiles <- batches[0]
+	batches = batches[1:]
+	res.batches = batches
+	res.result = result
+	return res
+}
+
+func (f *fakeBidiClientTopFunctions) Send(in *ingestv1.MergeProfilesTopFunctionsRequest) error {
+	if in.Request != nil {
+		return nil
+	}
+	for i, b := range in.Profiles {
+		if b {
+			f.kept = append(f.kept, testProfile{
+				Ts:     f.cur.Profiles[i].Timestamp,
+				Labels: f.cur.LabelsSets[f.cur.Profiles[i].LabelIndex],
+			})
+		}
+	}
+	if len(f.batches) == 0 {
+		close(f.profiles)
+		return nil
+	}
+	f.profiles <- f.batches[0]
+	f.batches = f.batches[1:]
+	return nil
+}
+
+func (f *fakeBidiClientTopFunctions) Receive() (*ingestv1.MergeProfilesTopFunctionsResponse, error) {
+	profiles := <-f.profiles
+	if profiles == nil {
+		return &ingestv1.MergeProfilesTopFunctionsResponse{
+			Result: &ingestv1.MergeProfilesTopFunctionsResult{
+				Functions: f.result,
+			},
+		}, nil
+	}
+	f.cur = profiles
+	return &ingestv1.MergeProfilesTopFunctionsResponse{
+		SelectedProfiles: profiles,
+	}, nil
+}
+func (f *fakeBidiClientTopFunctions) CloseRequest() error  { return nil }
+func (f *fakeBidiClientTopFunctions) CloseResponse() error { return nil }
+
+func (f *fakeQuerierIngester) MergeProfilesTopFunctions(ctx context.Context) clientpool.BidiClientMergeProfilesTopFunctions {
+	var (
+		args = f.Called(ctx)
+		res  clientpool.BidiClientMergeProfilesTopFunctions
+	)
+	if args[0] != nil {
+		res = args[0].(clientpool.BidiClientMergeProfilesTopFunctions)
+	}
+
+	return res
+}
+
+// fakeBidiClientProfileTrees sends a single batch of
+// profiles, and returns the same tree for every kept profile.
+type fakeBidiClientProfileTrees struct {
+	profiles chan *ingestv1.ProfileSets
+	cur      *ingestv1.ProfileSets
+	kept     []*ingestv1.ProfileTree
+	tree     []byte
+}
+
+func newFakeBidiClientProfileTrees(batch *ingestv1.ProfileSets, tree []byte) *fakeBidiClientProfileTrees {
+	res := &fakeBidiClientProfileTrees{
+		profiles: make(chan *ingestv1.ProfileSets, 1),
+		tree:     tree,
+	}
+	res.profiles <- batch
+	return res
+}
+
+func (f *fakeBidiClientProfileTrees) Send(in *ingestv1.SelectProfileTreesRequest) error {
+	if in.Request != nil {
+		return nil
+	}
+	for i, b := range in.Profiles {
+		if b {
+			f.kept = append(f.kept, &ingestv1.ProfileTree{
+				Timestamp: f.cur.Profiles[i].Timestamp,
+				Tree:      f.tree,
+			})
+		}
+	}
+	close(f.profiles)
+	return nil
+}
+
+func (f *fakeBidiClientProfileTrees) Receive() (*ingestv1.SelectProfileTreesResponse, error) {
+	profiles := <-f.profiles
+	if profiles == nil {
+		return &ingestv1.SelectProfileTreesResponse{
+			Result: &ingestv1.SelectProfileTreesResult{
+				Profiles: f.kept,
+			},
+		}, nil
+	}
+	f.cur = profiles
+	return &ingestv1.SelectProfileTreesResponse{
+		SelectedProfiles: profiles,
+	}, nil
+}
+func (f *fakeBidiClientProfileTrees) CloseRequest() error  { return nil }
+func (f *fakeBidiClientProfileTrees) CloseResponse() error { return nil }
+
+func (f *fakeQuerierIngester) SelectProfileTrees(ctx context.Context) clientpool.BidiClientSelectProfileTrees {
+	var (
+		args = f.Called(ctx)
+		res  clientpool.BidiClientSelectProfileTrees
+	)
+	if args[0] != nil {
+		res = args[0].(clientpool.BidiClientSelectProfileTrees)
+	}
+
+	return res
+}
+
 func (f *fakeQuerierIngester) MergeSpanProfile(ctx context.Context) clientpool.BidiClientMergeSpanProfile {
 	var (
 		args = f.Called(ctx)
@@ -1,12 +1,15 @@
 package querier
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/grafana/dskit/multierror"
 	"github.com/opentracing/opentracing-go"
 	otlog "github.com/opentracing/opentracing-go/log"
@@ -42,14 +45,18 @@ type Request interface {
 	*ingestv1.MergeProfilesStacktracesRequest |
 		*ingestv1.MergeProfilesLabelsRequest |
 		*ingestv1.MergeProfilesPprofRequest |
-		*ingestv1.MergeSpanProfileRequest
+		*ingestv1.MergeSpanProfileRequest |
+		*ingestv1.MergeProfilesTopFunctionsRequest |
+		*ingestv1.SelectProfileTreesRequest
 }
 
 type Response interface {
 	*ingestv1.MergeProfilesStacktracesResponse |
 		*ingestv1.MergeProfilesLabelsResponse |
 		*ingestv1.MergeProfilesPprofResponse |
-		*ingestv1.MergeSpanProfileResponse
+		*ingestv1.MergeSpanProfileResponse |
+		*ingestv1.MergeProfilesTopFunctionsResponse |
+		*ingestv1.SelectProfileTreesResponse
 }
 
 type MergeResult[R any] interface {
@@ -65,6 +72,8 @@ type keepResponse struct {
 	*ingestv1.MergeProfilesLabelsRequest
 	*ingestv1.MergeProfilesPprofRequest
 	*ingestv1.MergeSpanProfileRequest
+	*ingestv1.MergeProfilesTopFunctionsRequest
+	*ingestv1.SelectProfileTreesRequest
 }
 type mergeIterator[R any, Req Request, Res Response] struct {
 	ctx  context.Context
@@ -98,10 +107,12 @@ func NewMergeIterator[
 		currentProfile: &ProfileWithLabels{IngesterAddr: r.addr},
 		currIdx:        -1,
 		response: keepResponse{
-			MergeProfilesStacktracesRequest: &ingestv1.MergeProfilesStacktracesRequest{},
-			MergeProfilesLabelsRequest:      &ingestv1.MergeProfilesLabelsRequest{},
-			MergeProfilesPprofRequest:       &ingestv1.MergeProfilesPprofRequest{},
-			MergeSpanProfileRequest:         &ingestv1.MergeSpanProfileRequest{},
+			MergeProfilesStacktracesRequest:  &ingestv1.MergeProfilesStacktracesRequest{},
+			MergeProfilesLabelsRequest:       &ingestv1.MergeProfilesLabelsRequest{},
+			MergeProfilesPprofRequest:        &ingestv1.MergeProfilesPprofRequest{},
+			MergeSpanProfileRequest:          &ingestv1.MergeSpanProfileRequest{},
+			MergeProfilesTopFunctionsRequest: &ingestv1.MergeProfilesTopFunctionsRequest{},
+			SelectProfileTreesRequest:        &ingestv1.SelectProfileTreesRequest{},
 		},
 	}
 	it.fetchBatch()
@@ -128,6 +139,12 @@ func (s *mergeIterator[R, Req, Res]) Next() bool {
 			case BidiClientMerge[*ingestv1.MergeSpanProfileRequest, *ingestv1.MergeSpanProfileResponse]:
 				s.response.MergeSpanProfileRequest.Profiles = s.keep
 				err = bidi.Send(s.response.MergeSpanProfileRequest)
+			case BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]:
+				s.response.MergeProfilesTopFunctionsRequest.Profiles = s.keep
+				err = bidi.Send(s.response.MergeProfilesTopFunctionsRequest)
+			case BidiClientMerge[*ingestv1.SelectProfileTreesRequest, *ingestv1.SelectProfileTreesResponse]:
+				s.response.SelectProfileTreesRequest.Profiles = s.keep
+				err = bidi.Send(s.response.SelectProfileTreesRequest)
 			}
 			if err != nil {
 				s.err = err
@@ -189,6 +206,20 @@ func (s *mergeIterator[R, Req, Res]) fetchBatch() {
 			return
 		}
 		selectedProfiles = res.SelectedProfiles
+	case BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]:
+		res, err := bidi.Receive()
+		if err != nil {
+			s.err = err
+			return
+		}
+		selectedProfiles = res.SelectedProfiles
+	case BidiClientMerge[*ingestv1.SelectProfileTreesRequest, *ingestv1.SelectProfileTreesResponse]:
+		res, err := bidi.Receive()
+		if err != nil {
+			s.err = err
+			return
+		}
+		selectedProfiles = res.SelectedProfiles
 	}
 	s.curr = selectedProfiles
 	if s.curr == nil {
@@ -228,6 +259,10 @@ func (s *mergeIterator[R, Req, Res]) Result() (R, error) {
 		return any(result.Result).(R), nil
 	case *ingestv1.MergeSpanProfileResponse:
 		return any(result.Result).(R), nil
+	case *ingestv1.MergeProfilesTopFunctionsResponse:
+		return any(result.Result).(R), nil
+	case *ingestv1.SelectProfileTreesResponse:
+		return any(result.Result).(R), nil
 	default:
 		return *new(R), fmt.Errorf("unexpected response type %T", result)
 	}
@@ -561,3 +596,144 @@ func selectMergeSpanProfile(ctx context.Context, responses []ResponseFromReplica
 	span.LogFields(otlog.String("msg", "building tree"))
 	return m.Tree(), nil
 }
+
+// selectMergeTopFunctions selects the profile from each ingester by deduping
+// them and returns the merged self and total values of the functions.
+func selectMergeTopFunctions(ctx context.Context, responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]) ([]*typesv1.TopFunction, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "selectMergeTopFunctions")
+	defer span.Finish()
+
+	mergeResults := make([]MergeResult[*ingestv1.MergeProfilesTopFunctionsResult], len(responses))
+	iters := make([]MergeIterator, len(responses))
+	var wg sync.WaitGroup
+	for i, resp := range responses {
+		wg.Add(1)
+		go func(i int, resp ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]) {
+			defer wg.Done()
+			it := NewMergeIterator[*ingestv1.MergeProfilesTopFunctionsResult](
+				ctx, ResponseFromReplica[BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]]{
+					addr:     resp.addr,
+					response: resp.response,
+				})
+			iters[i] = it
+			mergeResults[i] = it
+		}(i, resp)
+	}
+	wg.Wait()
+
+	if err := skipDuplicates(ctx, iters); err != nil {
+		return nil, err
+	}
+
+	// Collects the results in parallel.
+	span.LogFields(otlog.String("msg", "collecting merge results"))
+	g, _ := errgroup.WithContext(ctx)
+	m := phlaremodel.NewTopFunctionsMerger()
+	for _, iter := range mergeResults {
+		iter := iter
+		g.Go(util.RecoverPanic(func() error {
+			result, err := iter.Result()
+			if err != nil || result == nil {
+				return err
+			}
+			m.MergeTopFunctions(result.Functions)
+			return nil
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return m.TopFunctions(), nil
+}
+
+// keptProfiles counts the profiles kept by
+// skipDuplicates and the series they belong to.
+type keptProfiles struct {
+	series   map[uint64]struct{}
+	profiles int64
+}
+
+type keptProfilesIterator struct {
+	MergeIterator
+	kept *keptProfiles
+}
+
+func (it *keptProfilesIterator) Keep() {
+	profile := it.At()
+	fingerprint := profile.Fingerprint
+	if fingerprint == 0 && len(profile.Labels) > 0 {
+		fingerprint = profile.Labels.Hash()
+	}
+	it.kept.series[fingerprint] = struct{}{}
+	it.kept.profiles++
+	it.MergeIterator.Keep()
+}
+
+// selectProfileTrees selects the profiles from each ingester by deduping
+// them and returns the tree of every profile, ordered by timestamp. The
+// profiles must belong to a single series, and their number must not
+// exceed the limit, if any.
+func selectProfileTrees(ctx context.Context, responses []ResponseFromReplica[clientpool.BidiClientSelectProfileTrees], limit int64) ([]*ingestv1.ProfileTree, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "selectProfileTrees")
+	defer span.Finish()
+
+	kept := &keptProfiles{series: make(map[uint64]struct{})}
+	mergeResults := make([]MergeResult[*ingestv1.SelectProfileTreesResult], len(responses))
+	iters := make([]MergeIterator, len(responses))
+	var wg sync.WaitGroup
+	for i, resp := range responses {
+		wg.Add(1)
+		go func(i int, resp ResponseFromReplica[clientpool.BidiClientSelectProfileTrees]) {
+			defer wg.Done()
+			it := NewMergeIterator[*ingestv1.SelectProfileTreesResult](
+				ctx, ResponseFromReplica[BidiClientMerge[*ingestv1.SelectProfileTreesRequest, *ingestv1.SelectProfileTreesResponse]]{
+					addr:     resp.addr,
+					response: resp.response,
+				})
+			iters[i] = &keptProfilesIterator{MergeIterator: it, kept: kept}
+			mergeResults[i] = it
+		}(i, resp)
+	}
+	wg.Wait()
+
+	if err := skipDuplicates(ctx, iters); err != nil {
+		return nil, err
+	}
+	span.LogFields(otlog.Int("series", len(kept.series)), otlog.Int64("profiles", kept.profiles))
+	if len(kept.series) > 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("the selector matches %d series, a single series is expected", len(kept.series)))
+	}
+	if limit > 0 && kept.profiles > limit {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("the query selects %d profiles, the maximum is %d: narrow the time range", kept.profiles, limit))
+	}
+
+	// Collects the results in parallel.
+	span.LogFields(otlog.String("msg", "collecting results"))
+	profiles := make([]*ingestv1.ProfileTree, 0, kept.profiles)
+	s := lo.Synchronize()
+	g, _ := errgroup.WithContext(ctx)
+	for _, iter := range mergeResults {
+		iter := iter
+		g.Go(util.RecoverPanic(func() error {
+			result, err := iter.Result()
+			if err != nil || result == nil {
+				return err
+			}
+			s.Do(func() {
+				profiles = append(profiles, result.Profiles...)
+			})
+			return nil
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(profiles, func(a, b *ingestv1.ProfileTree) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+	return profiles, nil
+}
@@ -33,6 +33,8 @@ type StoreGatewayQueryClient interface {
 	MergeProfilesLabels(ctx context.Context) clientpool.BidiClientMergeProfilesLabels
 	MergeProfilesPprof(ctx context.Context) clientpool.BidiClientMergeProfilesPprof
 	MergeSpanProfile(ctx context.Context) clientpool.BidiClientMergeSpanProfile
+	MergeProfilesTopFunctions(ctx context.Context) clientpool.BidiClientMergeProfilesTopFunctions
+	SelectProfileTrees(ctx context.Context) clientpool.BidiClientSelectProfileTrees
 	ProfileTypes(context.Context, *connect.Request[ingestv1.ProfileTypesRequest]) (*connect.Response[ingestv1.ProfileTypesResponse], error)
 	LabelValues(context.Context, *connect.Request[typesv1.LabelValuesRequest]) (*connect.Response[typesv1.LabelValuesResponse], error)
 	LabelNames(context.Context, *connect.Request[typesv1.LabelNamesRequest]) (*connect.Response[typesv1.LabelNamesResponse], error)
@@ -234,7 +236,7 @@ func (q *Querier) selectTreeFromStoreGateway(ctx context.Context, req *querierv1
 	return selectMergeTree(ctx, responses)
 }
 
-func (q *Querier) selectProfileFromStoreGateway(ctx context.Context, req *querierv1.SelectMergeProfileRequest, plan map[string]*blockPlanEntry) (*googlev1.Profile, error) {
+func (q *Querier) selectProfileFromStoreGateway(ctx context.Context, req *querierv1.SelectMergeProfileRequest, plan map[string]*blockPlanEntry, profileID string) (*googlev1.Profile, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfile StoreGateway")
 	defer sp.Finish()
 	profileType, err := phlaremodel.ParseProfileTypeSelector(req.ProfileTypeID)
@@ -281,6 +283,7 @@ func (q *Querier) selectProfileFromStoreGateway(ctx context.Context, req *querie
 					End:           req.End,
 					Type:          profileType,
 					Hints:         &ingestv1.Hints{Block: blockHints},
+					ProfileId:     profileID,
 				},
 				MaxNodes:           req.MaxNodes,
 				StackTraceSelector: req.StackTraceSelector,
@@ -336,6 +339,47 @@ func (q *Querier) selectSeriesFromStoreGateway(ctx context.Context, req *ingeste
 	return responses, nil
 }
 
+func (q *Querier) selectProfileTreesFromStoreGateway(ctx context.Context, req *ingestv1.SelectProfileTreesRequest, plan map[string]*blockPlanEntry) ([]ResponseFromReplica[clientpool.BidiClientSelectProfileTrees], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfileTrees StoreGateway")
+	defer sp.Finish()
+	tenantID, err := tenant.ExtractTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var responses []ResponseFromReplica[clientpool.BidiClientSelectProfileTrees]
+	if plan != nil {
+		responses, err = forAllPlannedStoreGateways(ctx, tenantID, q.storeGatewayQuerier, plan, func(ctx context.Context, ic StoreGatewayQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientSelectProfileTrees, error) {
+			return ic.SelectProfileTrees(ctx), nil
+		})
+	} else {
+		responses, err = forAllStoreGateways(ctx, tenantID, q.storeGatewayQuerier, func(ctx context.Context, ic StoreGatewayQueryClient) (clientpool.BidiClientSelectProfileTrees, error) {
+			return ic.SelectProfileTrees(ctx), nil
+		})
+	}
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all store gateways.
+	g, _ := errgroup.WithContext(ctx)
+	for _, r := range responses {
+		r := r
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		g.Go(util.RecoverPanic(func() error {
+			req := req.CloneVT()
+			req.Request.Hints = &ingestv1.Hints{Block: blockHints}
+			return r.response.Send(req)
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return responses, nil
+}
+
 func (q *Querier) labelValuesFromStoreGateway(ctx context.Context, req *typesv1.LabelValuesRequest) ([]ResponseFromReplica[[]string], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "LabelValues StoreGateway")
 	defer sp.Finish()
@@ -463,6 +507,51 @@ func (q *Querier) selectSpanProfileFromStoreGateway(ctx context.Context, req *qu
 	return selectMergeSpanProfile(ctx, responses)
 }
 
+func (q *Querier) selectTopFunctionsFromStoreGateway(ctx context.Context, req *ingestv1.MergeProfilesTopFunctionsRequest, plan map[string]*blockPlanEntry) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectTopFunctions StoreGateway")
+	defer sp.Finish()
+	tenantID, err := tenant.ExtractTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]
+	if plan != nil {
+		responses, err = forAllPlannedStoreGateways(ctx, tenantID, q.storeGatewayQuerier, plan, func(ctx context.Context, ic StoreGatewayQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	} else {
+		responses, err = forAllStoreGateways(ctx, tenantID, q.storeGatewayQuerier, func(ctx context.Context, ic StoreGatewayQueryClient) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all store gateways.
+	g, _ := errgroup.WithContext(ctx)
+	for _, r := range responses {
+		r := r
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		g.Go(util.RecoverPanic(func() error {
+			req := req.CloneVT()
+			req.Request.Hints = &ingestv1.Hints{Block: blockHints}
+			return r.response.Send(req)
+		}))
+	}
+	if err = g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// merge all profiles
+	return selectMergeTopFunctions(ctx, responses)
+}
+
 func (q *Querier) blockSelectFromStoreGateway(ctx context.Context, req *ingestv1.BlockMetadataRequest) ([]ResponseFromReplica[[]*typesv1.BlockInfo], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "blockSelect StoreGateway")
 	defer sp.Finish()
@@ -99,12 +99,14 @@ func (r *RecordingRules) UpsertRecordingRule(ctx context.Context, req *connect.R
 	}
 
 	newRule := &settingsv1.RecordingRuleStore{
-		Id:             req.Msg.Id,
-		MetricName:     req.Msg.MetricName,
-		Matchers:       req.Msg.Matchers,
-		GroupBy:        req.Msg.GroupBy,
-		ExternalLabels: req.Msg.ExternalLabels,
-		Generation:     req.Msg.Generation,
+		Id:                 req.Msg.Id,
+		MetricName:         req.Msg.MetricName,
+		Matchers:           req.Msg.Matchers,
+		GroupBy:            req.Msg.GroupBy,
+		ExternalLabels:     req.Msg.ExternalLabels,
+		Generation:         req.Msg.Generation,
+		StackTraceSelector: req.Msg.StackTraceSelector,
+		FunctionName:       req.Msg.FunctionName,
 	}
 	newRule, err = s.Upsert(ctx, newRule)
 	if err != nil {
@@ -194,6 +196,7 @@ func validateUpsert(req *settingsv1.UpsertRecordingRuleRequest) error {
 		req.Generation = 1
 	}
 	req.MetricName = strings.TrimSpace(req.MetricName)
+	req.FunctionName = strings.TrimSpace(req.FunctionName)
 
 	// Validate fields.
 	var errs []error
@@ -234,6 +237,10 @@ func validateUpsert(req *settingsv1.UpsertRecordingRuleRequest) error {
 		}
 	}
 
+	if err := model.ValidateRecordingRuleStackTraceFilter(req.StackTraceSelector, req.FunctionName); err != nil {
+		errs = append(errs, err)
+	}
+
 	if req.Generation < 0 {
 		errs = append(errs, fmt.Errorf("generation must be positive"))
 	}
@@ -257,13 +264,15 @@ func validateDelete(req *settingsv1.DeleteRecordingRuleRequest) error {
 
 func convertRuleToAPI(rule *settingsv1.RecordingRuleStore) *settingsv1.RecordingRule {
 	apiRule := &settingsv1.RecordingRule{
-		Id:             rule.Id,
-		MetricName:     rule.MetricName,
-		ProfileType:    "unknown",
-		Matchers:       rule.Matchers,
-		GroupBy:        rule.GroupBy,
-		ExternalLabels: rule.ExternalLabels,
-		Generation:     rule.Generation,
+		Id:                 rule.Id,
+		MetricName:         rule.MetricName,
+		ProfileType:        "unknown",
+		Matchers:           rule.Matchers,
+		GroupBy:            rule.GroupBy,
+		ExternalLabels:     rule.ExternalLabels,
+		Generation:         rule.Generation,
+		StackTraceSelector: rule.StackTraceSelector,
+		FunctionName:       rule.FunctionName,
 	}
 
 	// Try find the profile type from the matchers.
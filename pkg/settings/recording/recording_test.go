@@ -203,6 +203,60 @@ func Test_validateUpsert(t *testing.T) {
 			},
 			WantErr: "generation must be positive",
 		},
+		{
+			Name: "valid_stack_trace_selector",
+			Req: &settingsv1.UpsertRecordingRuleRequest{
+				Id:         "abcdef",
+				MetricName: "my_metric",
+				StackTraceSelector: &typesv1.StackTraceSelector{
+					CallSite: []*typesv1.Location{{Name: "main"}, {Name: "runtime.mallocgc"}},
+				},
+			},
+			WantErr: "",
+		},
+		{
+			Name: "valid_function_name",
+			Req: &settingsv1.UpsertRecordingRuleRequest{
+				Id:           "abcdef",
+				MetricName:   "my_metric",
+				FunctionName: " runtime.mallocgc ",
+			},
+			WantErr: "",
+		},
+		{
+			Name: "invalid_stack_trace_filter",
+			Req: &settingsv1.UpsertRecordingRuleRequest{
+				Id:           "abcdef",
+				MetricName:   "my_metric",
+				FunctionName: "runtime.mallocgc",
+				StackTraceSelector: &typesv1.StackTraceSelector{
+					CallSite: []*typesv1.Location{{Name: "main"}},
+				},
+			},
+			WantErr: "stack_trace_selector and function_name are mutually exclusive",
+		},
+		{
+			Name: "invalid_stack_trace_selector_location",
+			Req: &settingsv1.UpsertRecordingRuleRequest{
+				Id:         "abcdef",
+				MetricName: "my_metric",
+				StackTraceSelector: &typesv1.StackTraceSelector{
+					CallSite: []*typesv1.Location{{Name: "main"}, {Name: ""}},
+				},
+			},
+			WantErr: "stack_trace_selector call site location 1 has no name",
+		},
+		{
+			Name: "invalid_go_pgo_selector",
+			Req: &settingsv1.UpsertRecordingRuleRequest{
+				Id:         "abcdef",
+				MetricName: "my_metric",
+				StackTraceSelector: &typesv1.StackTraceSelector{
+					GoPgo: &typesv1.GoPGO{KeepLocations: 5},
+				},
+			},
+			WantErr: "go_pgo stack trace selector is not supported",
+		},
 		{
 			Name: "multiple_errors",
 			Req: &settingsv1.UpsertRecordingRuleRequest{
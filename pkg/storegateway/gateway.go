@@ -94,6 +94,12 @@ func NewStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, limits L
 		return nil, errors.Wrap(err, "create KV store client")
 	}
 
+	storageBucket, err = phlareobj.NewCachingBucket(storageBucket, gatewayCfg.BucketStoreConfig.BucketCache,
+		prometheus.WrapRegistererWith(prometheus.Labels{"component": "store-gateway"}, reg))
+	if err != nil {
+		return nil, errors.Wrap(err, "create bucket cache")
+	}
+
 	return newStoreGateway(gatewayCfg, storageBucket, ringStore, limits, logger, reg)
 }
 
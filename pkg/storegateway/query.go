@@ -124,6 +124,26 @@ func (s *StoreGateway) MergeSpanProfile(ctx context.Context, stream *connect.Bid
 	return terminateStream(stream)
 }
 
+func (s *StoreGateway) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
+		return bs.MergeProfilesTopFunctions(ctx, stream)
+	})
+	if err != nil || found {
+		return err
+	}
+	return terminateStream(stream)
+}
+
+func (s *StoreGateway) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
+		return bs.SelectProfileTrees(ctx, stream)
+	})
+	if err != nil || found {
+		return err
+	}
+	return terminateStream(stream)
+}
+
 func (s *StoreGateway) BlockMetadata(ctx context.Context, req *connect.Request[ingestv1.BlockMetadataRequest]) (*connect.Response[ingestv1.BlockMetadataResponse], error) {
 	var res *ingestv1.BlockMetadataResponse
 	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
@@ -218,6 +238,14 @@ func (store *BucketStore) MergeSpanProfile(ctx context.Context, stream *connect.
 	return phlaredb.MergeSpanProfile(ctx, stream, store.openBlocksForReading)
 }
 
+func (store *BucketStore) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return phlaredb.MergeProfilesTopFunctions(ctx, stream, store.openBlocksForReading)
+}
+
+func (store *BucketStore) SelectProfileTrees(ctx context.Context, stream *connect.BidiStream[ingestv1.SelectProfileTreesRequest, ingestv1.SelectProfileTreesResponse]) error {
+	return phlaredb.SelectProfileTrees(ctx, stream, store.openBlocksForReading)
+}
+
 func (s *BucketStore) BlockMetadata(ctx context.Context, req *ingestv1.BlockMetadataRequest) (*ingestv1.BlockMetadataResponse, error) {
 	set := s.blockSet.getFor(model.Time(req.Start), model.Time(req.End))
 	result := &ingestv1.BlockMetadataResponse{
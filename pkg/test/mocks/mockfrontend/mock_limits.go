@@ -297,6 +297,52 @@ func (_c *MockLimits_QueryAnalysisEnabled_Call) RunAndReturn(run func(string) bo
 	return _c
 }
 
+// QueryAnalysisSeriesEnabled provides a mock function with given fields: _a0
+func (_m *MockLimits) QueryAnalysisSeriesEnabled(_a0 string) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryAnalysisSeriesEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockLimits_QueryAnalysisSeriesEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryAnalysisSeriesEnabled'
+type MockLimits_QueryAnalysisSeriesEnabled_Call struct {
+	*mock.Call
+}
+
+// QueryAnalysisSeriesEnabled is a helper method to define mock.On call
+//   - _a0 string
+func (_e *MockLimits_Expecter) QueryAnalysisSeriesEnabled(_a0 interface{}) *MockLimits_QueryAnalysisSeriesEnabled_Call {
+	return &MockLimits_QueryAnalysisSeriesEnabled_Call{Call: _e.mock.On("QueryAnalysisSeriesEnabled", _a0)}
+}
+
+func (_c *MockLimits_QueryAnalysisSeriesEnabled_Call) Run(run func(_a0 string)) *MockLimits_QueryAnalysisSeriesEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLimits_QueryAnalysisSeriesEnabled_Call) Return(_a0 bool) *MockLimits_QueryAnalysisSeriesEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLimits_QueryAnalysisSeriesEnabled_Call) RunAndReturn(run func(string) bool) *MockLimits_QueryAnalysisSeriesEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QuerySplitDuration provides a mock function with given fields: _a0
 func (_m *MockLimits) QuerySplitDuration(_a0 string) time.Duration {
 	ret := _m.Called(_a0)
@@ -343,6 +389,52 @@ func (_c *MockLimits_QuerySplitDuration_Call) RunAndReturn(run func(string) time
 	return _c
 }
 
+// RejectOlderThan provides a mock function with given fields: tenantID
+func (_m *MockLimits) RejectOlderThan(tenantID string) time.Duration {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectOlderThan")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// MockLimits_RejectOlderThan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectOlderThan'
+type MockLimits_RejectOlderThan_Call struct {
+	*mock.Call
+}
+
+// RejectOlderThan is a helper method to define mock.On call
+//   - tenantID string
+func (_e *MockLimits_Expecter) RejectOlderThan(tenantID interface{}) *MockLimits_RejectOlderThan_Call {
+	return &MockLimits_RejectOlderThan_Call{Call: _e.mock.On("RejectOlderThan", tenantID)}
+}
+
+func (_c *MockLimits_RejectOlderThan_Call) Run(run func(tenantID string)) *MockLimits_RejectOlderThan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLimits_RejectOlderThan_Call) Return(_a0 time.Duration) *MockLimits_RejectOlderThan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLimits_RejectOlderThan_Call) RunAndReturn(run func(string) time.Duration) *MockLimits_RejectOlderThan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SymbolizerEnabled provides a mock function with given fields: _a0
 func (_m *MockLimits) SymbolizerEnabled(_a0 string) bool {
 	ret := _m.Called(_a0)
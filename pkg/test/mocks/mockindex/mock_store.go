@@ -69,6 +69,55 @@ func (_c *MockStore_CreateBuckets_Call) RunAndReturn(run func(*bbolt.Tx) error)
 	return _c
 }
 
+// DeleteShard provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockStore) DeleteShard(_a0 *bbolt.Tx, _a1 store.PartitionKey, _a2 string, _a3 uint32) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteShard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*bbolt.Tx, store.PartitionKey, string, uint32) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteShard'
+type MockStore_DeleteShard_Call struct {
+	*mock.Call
+}
+
+// DeleteShard is a helper method to define mock.On call
+//   - _a0 *bbolt.Tx
+//   - _a1 store.PartitionKey
+//   - _a2 string
+//   - _a3 uint32
+func (_e *MockStore_Expecter) DeleteShard(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockStore_DeleteShard_Call {
+	return &MockStore_DeleteShard_Call{Call: _e.mock.On("DeleteShard", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockStore_DeleteShard_Call) Run(run func(_a0 *bbolt.Tx, _a1 store.PartitionKey, _a2 string, _a3 uint32)) *MockStore_DeleteShard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bbolt.Tx), args[1].(store.PartitionKey), args[2].(string), args[3].(uint32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteShard_Call) Return(_a0 error) *MockStore_DeleteShard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteShard_Call) RunAndReturn(run func(*bbolt.Tx, store.PartitionKey, string, uint32) error) *MockStore_DeleteShard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPartitions provides a mock function with given fields: _a0
 func (_m *MockStore) ListPartitions(_a0 *bbolt.Tx) ([]*store.Partition, error) {
 	ret := _m.Called(_a0)
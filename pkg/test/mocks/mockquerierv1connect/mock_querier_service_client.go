@@ -147,6 +147,65 @@ func (_c *MockQuerierServiceClient_Diff_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetProfile provides a mock function with given fields: _a0, _a1
+func (_m *MockQuerierServiceClient) GetProfile(_a0 context.Context, _a1 *connect.Request[querierv1.GetProfileRequest]) (*connect.Response[googlev1.Profile], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *connect.Response[googlev1.Profile]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.GetProfileRequest]) (*connect.Response[googlev1.Profile], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.GetProfileRequest]) *connect.Response[googlev1.Profile]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[googlev1.Profile])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[querierv1.GetProfileRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerierServiceClient_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockQuerierServiceClient_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[querierv1.GetProfileRequest]
+func (_e *MockQuerierServiceClient_Expecter) GetProfile(_a0 interface{}, _a1 interface{}) *MockQuerierServiceClient_GetProfile_Call {
+	return &MockQuerierServiceClient_GetProfile_Call{Call: _e.mock.On("GetProfile", _a0, _a1)}
+}
+
+func (_c *MockQuerierServiceClient_GetProfile_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[querierv1.GetProfileRequest])) *MockQuerierServiceClient_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[querierv1.GetProfileRequest]))
+	})
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_GetProfile_Call) Return(_a0 *connect.Response[googlev1.Profile], _a1 error) *MockQuerierServiceClient_GetProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_GetProfile_Call) RunAndReturn(run func(context.Context, *connect.Request[querierv1.GetProfileRequest]) (*connect.Response[googlev1.Profile], error)) *MockQuerierServiceClient_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProfileStats provides a mock function with given fields: _a0, _a1
 func (_m *MockQuerierServiceClient) GetProfileStats(_a0 context.Context, _a1 *connect.Request[typesv1.GetProfileStatsRequest]) (*connect.Response[typesv1.GetProfileStatsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -383,6 +442,124 @@ func (_c *MockQuerierServiceClient_ProfileTypes_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SelectCallersCallees provides a mock function with given fields: _a0, _a1
+func (_m *MockQuerierServiceClient) SelectCallersCallees(_a0 context.Context, _a1 *connect.Request[querierv1.SelectCallersCalleesRequest]) (*connect.Response[querierv1.SelectCallersCalleesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectCallersCallees")
+	}
+
+	var r0 *connect.Response[querierv1.SelectCallersCalleesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectCallersCalleesRequest]) (*connect.Response[querierv1.SelectCallersCalleesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectCallersCalleesRequest]) *connect.Response[querierv1.SelectCallersCalleesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[querierv1.SelectCallersCalleesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[querierv1.SelectCallersCalleesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerierServiceClient_SelectCallersCallees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectCallersCallees'
+type MockQuerierServiceClient_SelectCallersCallees_Call struct {
+	*mock.Call
+}
+
+// SelectCallersCallees is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[querierv1.SelectCallersCalleesRequest]
+func (_e *MockQuerierServiceClient_Expecter) SelectCallersCallees(_a0 interface{}, _a1 interface{}) *MockQuerierServiceClient_SelectCallersCallees_Call {
+	return &MockQuerierServiceClient_SelectCallersCallees_Call{Call: _e.mock.On("SelectCallersCallees", _a0, _a1)}
+}
+
+func (_c *MockQuerierServiceClient_SelectCallersCallees_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[querierv1.SelectCallersCalleesRequest])) *MockQuerierServiceClient_SelectCallersCallees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[querierv1.SelectCallersCalleesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectCallersCallees_Call) Return(_a0 *connect.Response[querierv1.SelectCallersCalleesResponse], _a1 error) *MockQuerierServiceClient_SelectCallersCallees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectCallersCallees_Call) RunAndReturn(run func(context.Context, *connect.Request[querierv1.SelectCallersCalleesRequest]) (*connect.Response[querierv1.SelectCallersCalleesResponse], error)) *MockQuerierServiceClient_SelectCallersCallees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectHeatmap provides a mock function with given fields: _a0, _a1
+func (_m *MockQuerierServiceClient) SelectHeatmap(_a0 context.Context, _a1 *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectHeatmap")
+	}
+
+	var r0 *connect.Response[querierv1.SelectHeatmapResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectHeatmapRequest]) *connect.Response[querierv1.SelectHeatmapResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[querierv1.SelectHeatmapResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[querierv1.SelectHeatmapRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerierServiceClient_SelectHeatmap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectHeatmap'
+type MockQuerierServiceClient_SelectHeatmap_Call struct {
+	*mock.Call
+}
+
+// SelectHeatmap is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[querierv1.SelectHeatmapRequest]
+func (_e *MockQuerierServiceClient_Expecter) SelectHeatmap(_a0 interface{}, _a1 interface{}) *MockQuerierServiceClient_SelectHeatmap_Call {
+	return &MockQuerierServiceClient_SelectHeatmap_Call{Call: _e.mock.On("SelectHeatmap", _a0, _a1)}
+}
+
+func (_c *MockQuerierServiceClient_SelectHeatmap_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[querierv1.SelectHeatmapRequest])) *MockQuerierServiceClient_SelectHeatmap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[querierv1.SelectHeatmapRequest]))
+	})
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectHeatmap_Call) Return(_a0 *connect.Response[querierv1.SelectHeatmapResponse], _a1 error) *MockQuerierServiceClient_SelectHeatmap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectHeatmap_Call) RunAndReturn(run func(context.Context, *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error)) *MockQuerierServiceClient_SelectHeatmap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectMergeProfile provides a mock function with given fields: _a0, _a1
 func (_m *MockQuerierServiceClient) SelectMergeProfile(_a0 context.Context, _a1 *connect.Request[querierv1.SelectMergeProfileRequest]) (*connect.Response[googlev1.Profile], error) {
 	ret := _m.Called(_a0, _a1)
@@ -560,6 +737,65 @@ func (_c *MockQuerierServiceClient_SelectMergeStacktraces_Call) RunAndReturn(run
 	return _c
 }
 
+// SelectProfileTrees provides a mock function with given fields: _a0, _a1
+func (_m *MockQuerierServiceClient) SelectProfileTrees(_a0 context.Context, _a1 *connect.Request[querierv1.SelectProfileTreesRequest]) (*connect.Response[querierv1.SelectProfileTreesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectProfileTrees")
+	}
+
+	var r0 *connect.Response[querierv1.SelectProfileTreesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectProfileTreesRequest]) (*connect.Response[querierv1.SelectProfileTreesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectProfileTreesRequest]) *connect.Response[querierv1.SelectProfileTreesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[querierv1.SelectProfileTreesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[querierv1.SelectProfileTreesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerierServiceClient_SelectProfileTrees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectProfileTrees'
+type MockQuerierServiceClient_SelectProfileTrees_Call struct {
+	*mock.Call
+}
+
+// SelectProfileTrees is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[querierv1.SelectProfileTreesRequest]
+func (_e *MockQuerierServiceClient_Expecter) SelectProfileTrees(_a0 interface{}, _a1 interface{}) *MockQuerierServiceClient_SelectProfileTrees_Call {
+	return &MockQuerierServiceClient_SelectProfileTrees_Call{Call: _e.mock.On("SelectProfileTrees", _a0, _a1)}
+}
+
+func (_c *MockQuerierServiceClient_SelectProfileTrees_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[querierv1.SelectProfileTreesRequest])) *MockQuerierServiceClient_SelectProfileTrees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[querierv1.SelectProfileTreesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectProfileTrees_Call) Return(_a0 *connect.Response[querierv1.SelectProfileTreesResponse], _a1 error) *MockQuerierServiceClient_SelectProfileTrees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectProfileTrees_Call) RunAndReturn(run func(context.Context, *connect.Request[querierv1.SelectProfileTreesRequest]) (*connect.Response[querierv1.SelectProfileTreesResponse], error)) *MockQuerierServiceClient_SelectProfileTrees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectSeries provides a mock function with given fields: _a0, _a1
 func (_m *MockQuerierServiceClient) SelectSeries(_a0 context.Context, _a1 *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -619,6 +855,65 @@ func (_c *MockQuerierServiceClient_SelectSeries_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SelectTopFunctions provides a mock function with given fields: _a0, _a1
+func (_m *MockQuerierServiceClient) SelectTopFunctions(_a0 context.Context, _a1 *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectTopFunctions")
+	}
+
+	var r0 *connect.Response[querierv1.SelectTopFunctionsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[querierv1.SelectTopFunctionsRequest]) *connect.Response[querierv1.SelectTopFunctionsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[querierv1.SelectTopFunctionsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[querierv1.SelectTopFunctionsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerierServiceClient_SelectTopFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectTopFunctions'
+type MockQuerierServiceClient_SelectTopFunctions_Call struct {
+	*mock.Call
+}
+
+// SelectTopFunctions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[querierv1.SelectTopFunctionsRequest]
+func (_e *MockQuerierServiceClient_Expecter) SelectTopFunctions(_a0 interface{}, _a1 interface{}) *MockQuerierServiceClient_SelectTopFunctions_Call {
+	return &MockQuerierServiceClient_SelectTopFunctions_Call{Call: _e.mock.On("SelectTopFunctions", _a0, _a1)}
+}
+
+func (_c *MockQuerierServiceClient_SelectTopFunctions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[querierv1.SelectTopFunctionsRequest])) *MockQuerierServiceClient_SelectTopFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[querierv1.SelectTopFunctionsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectTopFunctions_Call) Return(_a0 *connect.Response[querierv1.SelectTopFunctionsResponse], _a1 error) *MockQuerierServiceClient_SelectTopFunctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerierServiceClient_SelectTopFunctions_Call) RunAndReturn(run func(context.Context, *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error)) *MockQuerierServiceClient_SelectTopFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Series provides a mock function with given fields: _a0, _a1
 func (_m *MockQuerierServiceClient) Series(_a0 context.Context, _a1 *connect.Request[querierv1.SeriesRequest]) (*connect.Response[querierv1.SeriesResponse], error) {
 	ret := _m.Called(_a0, _a1)
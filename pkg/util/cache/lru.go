@@ -0,0 +1,94 @@
+// Package cache implements an in-memory LRU cache
+// bounded by the total size of the values.
+package cache
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LRU is an LRU cache limited by the total size of the values.
+// Every value expires at the time specified when it is set.
+// The cache is safe for concurrent use.
+type LRU struct {
+	mu        sync.Mutex
+	lru       *lru.LRU[string, item]
+	size      int64
+	maxSize   int64
+	sizeGauge prometheus.Gauge
+}
+
+type item struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewLRU creates a cache of the given maximum size in bytes.
+// If the gauge is not nil, it reports the size of the cache.
+func NewLRU(maxSize int64, sizeGauge prometheus.Gauge) *LRU {
+	c := &LRU{maxSize: maxSize, sizeGauge: sizeGauge}
+	c.lru, _ = lru.NewLRU[string, item](math.MaxInt, func(_ string, item item) {
+		c.size -= int64(len(item.data))
+	})
+	return c
+}
+
+// Get returns the value of the key, unless it has expired.
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if item.expiresAt.Before(time.Now()) {
+		c.lru.Remove(key)
+		c.updateSize()
+		return nil, false
+	}
+	return item.data, true
+}
+
+// Set adds the value to the cache, evicting the least recently used
+// values if the cache size is exceeded. Values larger than the cache
+// are not added.
+func (c *LRU) Set(key string, value []byte, expiresAt time.Time) {
+	if int64(len(value)) > c.maxSize {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Remove(key)
+	c.lru.Add(key, item{data: value, expiresAt: expiresAt})
+	c.size += int64(len(value))
+	for c.size > c.maxSize {
+		c.lru.RemoveOldest()
+	}
+	c.updateSize()
+}
+
+// Delete removes the key from the cache.
+func (c *LRU) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Remove(key)
+	c.updateSize()
+}
+
+// Size returns the total size of the values in bytes.
+func (c *LRU) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// updateSize must be called with the mutex held.
+func (c *LRU) updateSize() {
+	if c.sizeGauge != nil {
+		c.sizeGauge.Set(float64(c.size))
+	}
+}
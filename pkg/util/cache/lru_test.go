@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_Eviction(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "size"})
+	c := NewLRU(10, gauge)
+	expiresAt := time.Now().Add(time.Hour)
+	c.Set("a", []byte("1234"), expiresAt)
+	c.Set("b", []byte("1234"), expiresAt)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	// "b" is the least recently used: it's evicted.
+	c.Set("c", []byte("1234"), expiresAt)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, int64(8), c.Size())
+	assert.Equal(t, float64(8), testutil.ToFloat64(gauge))
+
+	// Too large.
+	c.Set("d", []byte("12345678901"), expiresAt)
+	_, ok = c.Get("d")
+	assert.False(t, ok)
+
+	// Replaced.
+	c.Set("a", []byte("12"), expiresAt)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("12"), v)
+	assert.Equal(t, int64(6), c.Size())
+
+	// Expired.
+	c.Set("a", []byte("12"), time.Now().Add(-time.Second))
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, int64(4), c.Size())
+
+	c.Delete("c")
+	assert.Equal(t, int64(0), c.Size())
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+}
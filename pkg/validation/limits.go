@@ -60,6 +60,9 @@ type Limits struct {
 	IngestionRelabelingRules                RelabelRules         `yaml:"ingestion_relabeling_rules" json:"ingestion_relabeling_rules" category:"advanced"`
 	IngestionRelabelingDefaultRulesPosition RelabelRulesPosition `yaml:"ingestion_relabeling_default_rules_position" json:"ingestion_relabeling_default_rules_position" category:"advanced"`
 
+	// IngestionStackFrameRules allow to rewrite function names and stack traces of profiles before they get ingested.
+	IngestionStackFrameRules StackFrameRules `yaml:"ingestion_stack_frame_rules" json:"ingestion_stack_frame_rules" category:"advanced"`
+
 	// The tenant shard size determines the how many ingesters a particular
 	// tenant will be sharded to. Needs to be specified on distributors for
 	// correct distribution and on ingesters so that the local ingestion limit
@@ -198,6 +201,8 @@ func (l *Limits) RegisterFlags(f *flag.FlagSet) {
 	f.Var(&l.IngestionRelabelingDefaultRulesPosition, "distributor.ingestion-relabeling-default-rules-position", "Position of the default ingestion relabeling rules in relation to relabel rules from overrides. Valid values are 'first', 'last' or 'disabled'.")
 	_ = l.IngestionRelabelingRules.Set("[]")
 	f.Var(&l.IngestionRelabelingRules, "distributor.ingestion-relabeling-rules", "List of ingestion relabel configurations. The relabeling rules work the same way, as those of [Prometheus](https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config). All rules are applied in the order they are specified. Note: In most situations, it is more effective to use relabeling directly in Grafana Alloy.")
+	_ = l.IngestionStackFrameRules.Set("[]")
+	f.Var(&l.IngestionStackFrameRules, "distributor.ingestion-stack-frame-rules", "List of stack frame rewriting rules applied to the functions of ingested profiles. Each rule has an action ('replace', 'drop' or 'truncate') and an anchored regular expression matched against the function name: 'replace' rewrites the function name with the replacement (defaults to '$1'), 'drop' removes the frames of the function from stack traces, and 'truncate' removes all the frames below the first frame of the function. All rules are applied in the order they are specified.")
 
 	f.Var(&l.IngestionArtificialDelay, "distributor.ingestion-artificial-delay", "Target ingestion delay to apply to all tenants. If set to a non-zero value, the distributor will artificially delay ingestion time-frame by the specified duration by computing the difference between actual ingestion and the target. There is no delay on actual ingestion of samples, it is only the response back to the client.")
 
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+type StackFrameRules []*pprof.StackFrameRule
+
+func (p *StackFrameRules) Set(s string) error {
+	v := []*pprof.StackFrameRule{}
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		return err
+	}
+
+	for idx, rule := range v {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule at pos %d is not valid: %w", idx, err)
+		}
+	}
+	*p = v
+	return nil
+}
+
+func (p StackFrameRules) String() string {
+	yamlBytes, err := yaml.Marshal(p)
+	if err != nil {
+		panic(fmt.Errorf("error marshal yaml: %w", err))
+	}
+
+	temp := make([]interface{}, 0, len(p))
+	err = yaml.Unmarshal(yamlBytes, &temp)
+	if err != nil {
+		panic(fmt.Errorf("error unmarshal yaml: %w", err))
+	}
+
+	jsonBytes, err := json.Marshal(temp)
+	if err != nil {
+		panic(fmt.Errorf("error marshal json: %w", err))
+	}
+	return string(jsonBytes)
+}
+
+// ExampleDoc provides an example doc for this config, especially valuable since it's custom-unmarshaled.
+func (r StackFrameRules) ExampleDoc() (comment string, yaml interface{}) {
+	return `This example consists of three rules: the first one strips the suffix of Java lambda function names (e.g. 'Foo$$Lambda$123/0x0000000800c01234.run'), the second one removes frames of reflection calls, and the third one truncates stack traces below the Netty event loop.`,
+		[]map[string]interface{}{
+			{"action": "replace", "regex": `(.*)\$\$Lambda.*`, "replacement": "$1"},
+			{"action": "drop", "regex": `jdk\.internal\.reflect\..*`},
+			{"action": "truncate", "regex": `io\.netty\.channel\.nio\.NioEventLoop\.run`},
+		}
+}
+
+func (o *Overrides) IngestionStackFrameRules(tenantID string) []*pprof.StackFrameRule {
+	return o.getOverridesForTenant(tenantID).IngestionStackFrameRules
+}